@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/acctest/har_utils.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package acctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dnaeon/go-vcr/cassette"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// HarToVcrCassette converts a HAR file recorded with GOOGLE_TERRAFORM_HAR_FILE
+// into a VCR cassette for the test testName under vcrPath, so that a
+// reproduction of a reported issue can be run with VCR_MODE=REPLAYING against
+// the recorded traffic. Requests that received no response are skipped.
+func HarToVcrCassette(harPath, vcrPath, testName string) error {
+	b, err := os.ReadFile(harPath)
+	if err != nil {
+		return err
+	}
+	var har transport_tpg.Har
+	if err := json.Unmarshal(b, &har); err != nil {
+		return fmt.Errorf("parsing HAR file %q: %w", harPath, err)
+	}
+
+	c := cassette.New(filepath.Join(vcrPath, vcrFileName(testName)))
+	for _, e := range har.Log.Entries {
+		if e.Error != "" {
+			continue
+		}
+		req := cassette.Request{
+			Method:  e.Request.Method,
+			URL:     e.Request.Url,
+			Headers: harHeadersToHttp(e.Request.Headers),
+		}
+		if e.Request.PostData != nil {
+			req.Body = e.Request.PostData.Text
+		}
+		c.AddInteraction(&cassette.Interaction{
+			Request: req,
+			Response: cassette.Response{
+				Body:     e.Response.Content.Text,
+				Headers:  harHeadersToHttp(e.Response.Headers),
+				Status:   fmt.Sprintf("%d %s", e.Response.Status, e.Response.StatusText),
+				Code:     e.Response.Status,
+				Duration: time.Duration(e.Time * float64(time.Millisecond)).String(),
+			},
+		})
+	}
+	if err := c.Save(); err != nil {
+		return err
+	}
+
+	// Replaying requires a seed file. Recorded traffic uses the names from the
+	// original configuration, so any seed will do.
+	seedFile := vcrSeedFile(vcrPath, testName)
+	if _, err := os.Stat(seedFile); errors.Is(err, fs.ErrNotExist) {
+		return writeSeedToFile(0, seedFile)
+	}
+	return nil
+}
+
+func harHeadersToHttp(headers []transport_tpg.HarNameValue) http.Header {
+	h := make(http.Header, len(headers))
+	for _, nv := range headers {
+		h.Add(nv.Name, nv.Value)
+	}
+	return h
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/acctest/har_utils_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package acctest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+const testHar = `{"log": {"version": "1.2", "creator": {"name": "terraform-provider-google", "version": "dev"}, "entries": [
+  {"startedDateTime": "2026-01-01T00:00:00Z", "time": 12.5,
+   "request": {"method": "POST", "url": "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json", "httpVersion": "HTTP/1.1",
+     "cookies": [], "headers": [{"name": "Content-Type", "value": "application/json"}], "queryString": [{"name": "alt", "value": "json"}],
+     "postData": {"mimeType": "application/json", "text": "{\"name\":\"n\"}"}, "headersSize": -1, "bodySize": 12},
+   "response": {"status": 200, "statusText": "OK", "httpVersion": "HTTP/1.1", "cookies": [], "headers": [],
+     "content": {"size": 15, "mimeType": "application/json", "text": "{\"name\":\"op-1\"}"}, "redirectURL": "", "headersSize": -1, "bodySize": 15},
+   "cache": {}, "timings": {"send": 0, "wait": 12.5, "receive": 0}},
+  {"startedDateTime": "2026-01-01T00:00:01Z", "time": 1,
+   "request": {"method": "GET", "url": "https://compute.googleapis.com/compute/v1/projects/p/global/networks/n", "httpVersion": "HTTP/1.1",
+     "cookies": [], "headers": [], "queryString": [], "headersSize": -1, "bodySize": 0},
+   "response": {"status": 0, "statusText": "", "httpVersion": "", "cookies": [], "headers": [], "content": {"size": 0, "mimeType": ""}, "redirectURL": "", "headersSize": -1, "bodySize": 0},
+   "cache": {}, "timings": {"send": 0, "wait": 1, "receive": 0}, "_error": "connection reset"}
+]}}`
+
+func TestHarToVcrCassette(t *testing.T) {
+	dir := t.TempDir()
+	harPath := filepath.Join(dir, "requests.har")
+	if err := os.WriteFile(harPath, []byte(testHar), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	vcrPath := filepath.Join(dir, "fixtures")
+	if err := acctest.HarToVcrCassette(harPath, vcrPath, "TestAccRepro/network"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c, err := cassette.Load(filepath.Join(vcrPath, "TestAccRepro_network"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(c.Interactions) != 1 {
+		t.Fatalf("expected requests without a response to be skipped, got %d interactions", len(c.Interactions))
+	}
+	i := c.Interactions[0]
+	if i.Request.Method != "POST" || i.Request.Body != `{"name":"n"}` {
+		t.Errorf("unexpected request: %+v", i.Request)
+	}
+	if i.Response.Code != 200 || i.Response.Body != `{"name":"op-1"}` || i.Response.Status != "200 OK" {
+		t.Errorf("unexpected response: %+v", i.Response)
+	}
+	if _, err := os.Stat(filepath.Join(vcrPath, "TestAccRepro_network.seed")); err != nil {
+		t.Errorf("expected a seed file to be written: %v", err)
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-google/google/envvar"
+	tpgprovider "github.com/hashicorp/terraform-provider-google/google/provider"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
 	googleoauth "golang.org/x/oauth2/google"
@@ -111,8 +112,8 @@ func MuxedProviders(testName string) (func() tfprotov5.ProviderServer, error) {
 	primary := GetSDKProvider(testName)
 
 	providers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider, // sdk provider
-		providerserver.NewProtocol5(NewFrameworkTestProvider(testName, primary)), // framework provider
+		func() tfprotov5.ProviderServer { return tpgprovider.NewGRPCProviderServer(primary) }, // sdk provider
+		providerserver.NewProtocol5(NewFrameworkTestProvider(testName, primary)),              // framework provider
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
@@ -43,12 +43,29 @@ type ProviderModel struct {
 	Zone                                      types.String               `tfsdk:"zone"`
 	Scopes                                    types.List                 `tfsdk:"scopes"`
 	Batching                                  types.List                 `tfsdk:"batching"`
+	Retry                                     types.List                 `tfsdk:"retry"`
+	RetryOn                                   types.List                 `tfsdk:"retry_on"`
+	RequestRateLimits                         types.Map                  `tfsdk:"request_rate_limits"`
+	MaxConcurrentOperations                   types.Int64                `tfsdk:"max_concurrent_operations"`
+	AutoEnableServices                        types.Bool                 `tfsdk:"auto_enable_services"`
+	RequestLogging                            types.String               `tfsdk:"request_logging"`
+	ProxyUrl                                  types.String               `tfsdk:"proxy_url"`
+	CaBundle                                  types.String               `tfsdk:"ca_bundle"`
+	MinTlsVersion                             types.String               `tfsdk:"min_tls_version"`
 	UserProjectOverride                       types.Bool                 `tfsdk:"user_project_override"`
 	RequestTimeout                            types.String               `tfsdk:"request_timeout"`
 	RequestReason                             types.String               `tfsdk:"request_reason"`
 	PollInterval                              types.String               `tfsdk:"poll_interval"`
+	ProductOverrides                          types.List                 `tfsdk:"product_overrides"`
 	UniverseDomain                            types.String               `tfsdk:"universe_domain"`
 	DefaultLabels                             types.Map                  `tfsdk:"default_labels"`
+	DefaultAnnotations                        types.Map                  `tfsdk:"default_annotations"`
+	DefaultTags                               types.Map                  `tfsdk:"default_tags"`
+	LabelPolicy                               types.List                 `tfsdk:"label_policy"`
+	LabelNormalization                        types.Bool                 `tfsdk:"label_normalization"`
+	IgnoreLabels                              types.List                 `tfsdk:"ignore_labels"`
+	DefaultDeletionProtection                 types.Bool                 `tfsdk:"default_deletion_protection"`
+	ProtectedResourceTypes                    types.List                 `tfsdk:"protected_resource_types"`
 	AddTerraformAttributionLabel              types.Bool                 `tfsdk:"add_terraform_attribution_label"`
 	TerraformAttributionLabelAdditionStrategy types.String               `tfsdk:"terraform_attribution_label_addition_strategy"`
 	PreferGlobalEndpoints                     types.Bool                 `tfsdk:"prefer_global_endpoints"`
@@ -237,11 +254,27 @@ type ProviderModel struct {
 type ProviderBatching struct {
 	SendAfter      types.String `tfsdk:"send_after"`
 	EnableBatching types.Bool   `tfsdk:"enable_batching"`
+	MaxItems       types.Int64  `tfsdk:"max_items"`
 }
 
 var ProviderBatchingAttributes = map[string]attr.Type{
 	"send_after":      types.StringType,
 	"enable_batching": types.BoolType,
+	"max_items":       types.Int64Type,
+}
+
+type ProviderRetry struct {
+	MaxAttempts    types.Int64   `tfsdk:"max_attempts"`
+	InitialBackoff types.String  `tfsdk:"initial_backoff"`
+	MaxBackoff     types.String  `tfsdk:"max_backoff"`
+	JitterFactor   types.Float64 `tfsdk:"jitter_factor"`
+}
+
+var ProviderRetryAttributes = map[string]attr.Type{
+	"max_attempts":    types.Int64Type,
+	"initial_backoff": types.StringType,
+	"max_backoff":     types.StringType,
+	"jitter_factor":   types.Float64Type,
 }
 
 // ProviderMetaModel describes the provider meta model
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/fwprovider/framework_protected_resource.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package fwprovider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// refuseProtectedDeletion wraps the resources created by f so that their
+// deletes fail for the provider's protected_resource_types, see
+// transport_tpg.Config.CheckProtectedDeletion. Resources that implement
+// optional interfaces the wrapper can't forward are returned unwrapped.
+func refuseProtectedDeletion(f func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		r := f()
+		var metadata resource.MetadataResponse
+		r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "google"}, &metadata)
+		if !protectable(r) {
+			log.Printf("[WARN] %s implements resource interfaces that protected_resource_types doesn't support, its deletes won't be checked", metadata.TypeName)
+			return r
+		}
+		return protectedResourceFor(&protectedResource{Resource: r, typeName: metadata.TypeName})
+	}
+}
+
+// protectable returns whether r only implements optional resource interfaces
+// that a protectedResource variant implements as well.
+func protectable(r resource.Resource) bool {
+	switch r.(type) {
+	case resource.ResourceWithConfigValidators, resource.ResourceWithModifyPlan, resource.ResourceWithMoveState,
+		resource.ResourceWithValidateConfig, resource.ResourceWithIdentity, resource.ResourceWithUpgradeIdentity:
+		return false
+	}
+	return true
+}
+
+// protectedResourceFor returns the variant of r that implements the same
+// optional resource interfaces as the resource it wraps.
+func protectedResourceFor(r *protectedResource) resource.Resource {
+	i, importable := r.Resource.(resource.ResourceWithImportState)
+	u, upgradable := r.Resource.(resource.ResourceWithUpgradeState)
+	switch {
+	case importable && upgradable:
+		return &protectedResourceWithImportAndUpgradeState{r, i, u}
+	case importable:
+		return &protectedResourceWithImportState{r, i}
+	case upgradable:
+		return &protectedResourceWithUpgradeState{r, u}
+	}
+	return r
+}
+
+// protectedResource is a resource whose deletes are checked against the
+// provider's protected_resource_types.
+type protectedResource struct {
+	resource.Resource
+	typeName string
+	config   *transport_tpg.Config
+}
+
+// stateImporter and stateUpgrader are the methods of
+// resource.ResourceWithImportState and resource.ResourceWithUpgradeState, so
+// that embedding them doesn't conflict with the embedded resource.Resource.
+type stateImporter interface {
+	ImportState(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+}
+
+type stateUpgrader interface {
+	UpgradeState(context.Context) map[int64]resource.StateUpgrader
+}
+
+type protectedResourceWithImportState struct {
+	*protectedResource
+	stateImporter
+}
+
+type protectedResourceWithUpgradeState struct {
+	*protectedResource
+	stateUpgrader
+}
+
+type protectedResourceWithImportAndUpgradeState struct {
+	*protectedResource
+	stateImporter
+	stateUpgrader
+}
+
+var (
+	_ resource.ResourceWithConfigure    = &protectedResource{}
+	_ resource.ResourceWithImportState  = &protectedResourceWithImportState{}
+	_ resource.ResourceWithUpgradeState = &protectedResourceWithUpgradeState{}
+	_ resource.ResourceWithImportState  = &protectedResourceWithImportAndUpgradeState{}
+	_ resource.ResourceWithUpgradeState = &protectedResourceWithImportAndUpgradeState{}
+)
+
+func (r *protectedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*transport_tpg.Config); ok {
+		r.config = config
+	}
+	if c, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		c.Configure(ctx, req, resp)
+	}
+}
+
+func (r *protectedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	req.State.GetAttribute(ctx, path.Root("id"), &id)
+	if err := r.config.CheckProtectedDeletion(r.typeName, id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting protected resource", err.Error())
+		return
+	}
+	r.Resource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/fwprovider/framework_protected_resource_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	_ "github.com/hashicorp/terraform-provider-google/google/provider"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+func TestRefuseProtectedDeletion_keepsResourceInterfaces(t *testing.T) {
+	funcs := registry.FrameworkResourceFuncs()
+	if len(funcs) == 0 {
+		t.Fatal("expected registered framework resources")
+	}
+	for _, f := range funcs {
+		original := f()
+		var metadata resource.MetadataResponse
+		original.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "google"}, &metadata)
+
+		wrapped := refuseProtectedDeletion(f)()
+		switch wrapped.(type) {
+		case *protectedResource, *protectedResourceWithImportState, *protectedResourceWithUpgradeState, *protectedResourceWithImportAndUpgradeState:
+		default:
+			t.Errorf("%s: expected deletes to be checked against protected_resource_types, got %T", metadata.TypeName, wrapped)
+		}
+
+		_, originalImport := original.(resource.ResourceWithImportState)
+		_, wrappedImport := wrapped.(resource.ResourceWithImportState)
+		if originalImport != wrappedImport {
+			t.Errorf("%s: expected ResourceWithImportState to be %t, got %t", metadata.TypeName, originalImport, wrappedImport)
+		}
+		_, originalUpgrade := original.(resource.ResourceWithUpgradeState)
+		_, wrappedUpgrade := wrapped.(resource.ResourceWithUpgradeState)
+		if originalUpgrade != wrappedUpgrade {
+			t.Errorf("%s: expected ResourceWithUpgradeState to be %t, got %t", metadata.TypeName, originalUpgrade, wrappedUpgrade)
+		}
+	}
+}
@@ -21,6 +21,8 @@ import (
 
 	sdk_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -149,6 +151,37 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"request_rate_limits": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"auto_enable_services": schema.BoolAttribute{
+				Optional: true,
+			},
+			"request_logging": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(transport_tpg.RequestLoggingModes...),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional: true,
+			},
+			"min_tls_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.0", "1.1", "1.2", "1.3"),
+				},
+			},
 			"user_project_override": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -176,6 +209,24 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"default_annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"label_normalization": schema.BoolAttribute{
+				Optional: true,
+			},
+			"default_deletion_protection": schema.BoolAttribute{
+				Optional: true,
+			},
+			"protected_resource_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"add_terraform_attribution_label": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -223,6 +274,40 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"label_policy": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"allowed_values": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(transport_tpg.LabelPolicyModes...),
+							},
+						},
+					},
+				},
+			},
+			"ignore_labels": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"key_prefixes": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
 			"batching": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -235,6 +320,111 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 						"enable_batching": schema.BoolAttribute{
 							Optional: true,
 						},
+						"max_items": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"product_overrides": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"product": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.NonEmptyStringValidator(),
+							},
+						},
+						"request_timeout": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"poll_interval": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"create_timeout": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"read_timeout": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"update_timeout": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"delete_timeout": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"initial_backoff": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.NonNegativeDurationValidator(),
+							},
+						},
+						"jitter_factor": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 1),
+							},
+						},
+					},
+				},
+			},
+			"retry_on": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 599),
+							},
+						},
+						"reason": schema.StringAttribute{
+							Optional: true,
+						},
+						"message_regex": schema.StringAttribute{
+							Optional: true,
+						},
+						"product": schema.StringAttribute{
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -312,7 +502,11 @@ func (p *FrameworkProvider) DataSources(_ context.Context) []func() datasource.D
 
 // Resources defines the resources implemented in the provider.
 func (p *FrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
-	return registry.FrameworkResourceFuncs()
+	var resources []func() resource.Resource
+	for _, f := range registry.FrameworkResourceFuncs() {
+		resources = append(resources, refuseProtectedDeletion(f))
+	}
+	return resources
 }
 
 // Functions defines the provider functions implemented in the provider.
@@ -74,7 +74,7 @@ func HandleNotFoundError(ctx context.Context, err error, state *tfsdk.State, res
 		state.RemoveResource(ctx)
 	}
 
-	diags.AddError(fmt.Sprintf("Error when reading or editing %s", resource), err.Error())
+	diags.AddError(fmt.Sprintf("Error when reading or editing %s", resource), transport_tpg.TranslateApiError(err).Error())
 }
 
 var DefaultRequestTimeout = 5 * time.Minute
@@ -150,7 +150,7 @@ func SendRequest(opt SendRequestOptions, diags *diag.Diagnostics) (map[string]in
 			return nil
 		},
 		Timeout:              opt.Timeout,
-		ErrorRetryPredicates: opt.ErrorRetryPredicates,
+		ErrorRetryPredicates: opt.Config.WithRetryOnPredicates(opt.ErrorRetryPredicates, opt.RawURL),
 		ErrorAbortPredicates: opt.ErrorAbortPredicates,
 	})
 	if err != nil {
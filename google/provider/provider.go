@@ -21,10 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-google/google/registry"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
@@ -140,10 +143,113 @@ func Provider() *schema.Provider {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"max_items": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"initial_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"jitter_factor": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+						},
 					},
 				},
 			},
 
+			"retry_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"message_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"product": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"request_rate_limits": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+
+			"max_concurrent_operations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"auto_enable_services": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"request_logging": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      transport_tpg.RequestLoggingRedacted,
+				ValidateFunc: validation.StringInSlice(transport_tpg.RequestLoggingModes, false),
+			},
+
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+			},
+
+			"ca_bundle": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+			},
+
 			"user_project_override": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -159,6 +265,50 @@ func Provider() *schema.Provider {
 				Optional: true,
 			},
 
+			"product_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"request_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"create_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"read_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"update_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"delete_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+					},
+				},
+			},
+
 			"request_reason": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -170,6 +320,79 @@ func Provider() *schema.Provider {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"default_annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"label_normalization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"label_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_values": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(transport_tpg.LabelPolicyModes, false),
+						},
+					},
+				},
+			},
+
+			"ignore_labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"key_prefixes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"default_deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"protected_resource_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"add_terraform_attribution_label": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -230,6 +453,19 @@ func Provider() *schema.Provider {
 		ResourcesMap:   registry.ResourceMap(),
 	}
 
+	registerSensitiveFieldsOnce.Do(func() {
+		registerSensitiveFields(provider.ResourcesMap)
+		registerSensitiveFields(provider.DataSourcesMap)
+	})
+
+	resources, configureProductTimeouts := productTimeouts(provider.ResourcesMap)
+	provider.ResourcesMap = refuseProtectedDeletion(defaultDeletionProtection(uncoalescedChanges(limitOperations(resumeOperations(bindDefaultTags(translateResourceErrors(resources))))), provider.Meta))
+
+	if transport_tpg.TracingEnabled() {
+		provider.DataSourcesMap = traceResources(provider.DataSourcesMap, "data.")
+		provider.ResourcesMap = traceResources(provider.ResourcesMap, "")
+	}
+
 	for _, p := range registry.ListProducts() {
 		provider.Schema[p.CustomEndpointField] = &schema.Schema{
 			Type:         schema.TypeString,
@@ -239,11 +475,36 @@ func Provider() *schema.Provider {
 	}
 
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return ProviderConfigure(ctx, d, provider)
+		meta, diags := ProviderConfigure(ctx, d, provider)
+		if !diags.HasError() {
+			configureProductTimeouts(meta)
+		}
+		return meta, diags
 	}
 	return provider
 }
 
+var registerSensitiveFieldsOnce sync.Once
+
+// registerSensitiveFields redacts fields marked as Sensitive or WriteOnly in
+// any resource from request logs.
+func registerSensitiveFields(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		registerSensitiveSchemaFields(r.SchemaMap())
+	}
+}
+
+func registerSensitiveSchemaFields(s map[string]*schema.Schema) {
+	for k, v := range s {
+		if v.Sensitive || v.WriteOnly {
+			transport_tpg.RegisterSensitiveFields(k)
+		}
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			registerSensitiveSchemaFields(elem.SchemaMap())
+		}
+	}
+}
+
 // Compatibility shim for diff-processor.
 func ResourceMap() map[string]*schema.Resource {
 	return registry.ResourceMap()
@@ -377,6 +638,24 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.DefaultLabels[k] = v.(string)
 	}
 
+	config.DefaultAnnotations = make(map[string]string)
+	for k, v := range d.Get("default_annotations").(map[string]interface{}) {
+		config.DefaultAnnotations[k] = v.(string)
+	}
+
+	config.LabelNormalization = d.Get("label_normalization").(bool)
+	config.IgnoreLabels = transport_tpg.ExpandProviderIgnoreLabels(d.Get("ignore_labels"))
+
+	config.DefaultDeletionProtection = d.Get("default_deletion_protection").(bool)
+	for _, t := range d.Get("protected_resource_types").([]interface{}) {
+		config.ProtectedResourceTypes = append(config.ProtectedResourceTypes, t.(string))
+	}
+
+	config.DefaultTags = make(map[string]string)
+	for k, v := range d.Get("default_tags").(map[string]interface{}) {
+		config.DefaultTags[k] = v.(string)
+	}
+
 	config.AddTerraformAttributionLabel = d.Get("add_terraform_attribution_label").(bool)
 	if config.AddTerraformAttributionLabel {
 		config.TerraformAttributionLabelAdditionStrategy = transport_tpg.CreateOnlyAttributionStrategy
@@ -396,6 +675,49 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 	config.BatchingConfig = batchCfg
 
+	retryCfg, err := transport_tpg.ExpandProviderRetryConfig(d.Get("retry"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if retryJitterFactorUnset(d) {
+		retryCfg.JitterFactor = transport_tpg.DefaultRetryJitterFactor
+	}
+	config.RetryConfig = retryCfg
+
+	retryOn, err := transport_tpg.ExpandProviderRetryOn(d.Get("retry_on"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RetryOn = retryOn
+
+	rateLimits, err := transport_tpg.ExpandProviderRequestRateLimits(d.Get("request_rate_limits"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RequestRateLimits = rateLimits
+
+	if v, ok := d.GetOk("max_concurrent_operations"); ok {
+		config.MaxConcurrentOperations = v.(int)
+	}
+
+	config.AutoEnableServices = d.Get("auto_enable_services").(bool)
+	config.RequestLoggingMode = d.Get("request_logging").(string)
+	config.ProxyUrl = d.Get("proxy_url").(string)
+	config.CaBundle = d.Get("ca_bundle").(string)
+	config.MinTlsVersion = d.Get("min_tls_version").(string)
+
+	productOverrides, err := transport_tpg.ExpandProviderProductOverrides(d.Get("product_overrides"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.ProductOverrides = productOverrides
+
+	labelPolicy, err := transport_tpg.ExpandProviderLabelPolicy(d.Get("label_policy"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.LabelPolicy = labelPolicy
+
 	// Registered products
 	config.AccessApprovalBasePath = transport_tpg.BaseUrl(registry.GetProduct("accessapproval"), &config)
 	config.AccessContextManagerBasePath = transport_tpg.BaseUrl(registry.GetProduct("accesscontextmanager"), &config)
@@ -596,3 +918,18 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 
 	return &config, nil
 }
+
+// retryJitterFactorUnset reports whether jitter_factor was left out of the
+// retry block. The field has no schema default so that it matches the
+// framework provider schema, and reading it from d returns 0 when unset.
+func retryJitterFactorUnset(d *schema.ResourceData) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return true
+	}
+	retry := raw.GetAttr("retry")
+	if retry.IsNull() || !retry.IsKnown() || retry.LengthInt() == 0 {
+		return true
+	}
+	return retry.Index(cty.NumberIntVal(0)).GetAttr("jitter_factor").IsNull()
+}
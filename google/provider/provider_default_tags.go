@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_default_tags.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/services/tags"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// tagsParentFunc returns the full resource name of a resource, which tags are
+// bound to, and the location its tag bindings are managed in, or "" if
+// they're global.
+type tagsParentFunc func(d *schema.ResourceData, config *transport_tpg.Config) (parent, location string, err error)
+
+// defaultTagsParents are the resources that default_tags are bound to.
+var defaultTagsParents = map[string]tagsParentFunc{
+	"google_project": func(d *schema.ResourceData, config *transport_tpg.Config) (string, string, error) {
+		return fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", d.Get("number")), "", nil
+	},
+	"google_storage_bucket": func(d *schema.ResourceData, config *transport_tpg.Config) (string, string, error) {
+		return fmt.Sprintf("//storage.googleapis.com/projects/_/buckets/%s", d.Get("name")), strings.ToLower(d.Get("location").(string)), nil
+	},
+	"google_compute_instance": func(d *schema.ResourceData, config *transport_tpg.Config) (string, string, error) {
+		project, err := tpgresource.GetProject(d, config)
+		if err != nil {
+			return "", "", err
+		}
+		zone := tpgresource.GetResourceNameFromSelfLink(d.Get("zone").(string))
+		return fmt.Sprintf("//compute.googleapis.com/projects/%s/zones/%s/instances/%s", project, zone, d.Get("instance_id")), zone, nil
+	},
+	"google_sql_database_instance": func(d *schema.ResourceData, config *transport_tpg.Config) (string, string, error) {
+		project, err := tpgresource.GetProject(d, config)
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("//sqladmin.googleapis.com/projects/%s/instances/%s", project, d.Get("name")), d.Get("region").(string), nil
+	},
+}
+
+// bindDefaultTags returns a copy of resources in which the resources listed in
+// defaultTagsParents have the tags in default_tags bound to them through the
+// Tags API, as google_tags_location_tag_binding would. Their tags are shown
+// in a computed effective_tags attribute, keyed by the namespaced names of
+// the tag keys, which plans show default_tags being merged into as
+// tpgresource.SetLabelsDiff does for effective_labels.
+//
+// Tag keys already bound to the resource, e.g. by google_tags_*_binding
+// resources, keep their values, with a warning if default_tags has another.
+// Tags are left bound when removed from default_tags.
+func bindDefaultTags(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	bound := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		bound[name] = r
+	}
+	for name, parentFunc := range defaultTagsParents {
+		r, ok := bound[name]
+		if !ok {
+			continue
+		}
+		copied := *r
+		copied.Schema = make(map[string]*schema.Schema, len(r.Schema)+1)
+		for k, v := range r.Schema {
+			copied.Schema[k] = v
+		}
+		copied.Schema["effective_tags"] = &schema.Schema{
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: `All of the Resource Manager tags bound to the resource, including those in the provider's default_tags, keyed by the namespaced names of their tag keys.`,
+		}
+
+		if r.CustomizeDiff != nil {
+			copied.CustomizeDiff = customdiff.All(r.CustomizeDiff, setTagsDiff)
+		} else {
+			copied.CustomizeDiff = setTagsDiff
+		}
+		copied.CreateContext = applyDefaultTagsFunc(schema.TimeoutCreate, parentFunc, copied.CreateContext)
+		copied.UpdateContext = applyDefaultTagsFunc(schema.TimeoutUpdate, parentFunc, copied.UpdateContext)
+		copied.ReadContext = readEffectiveTagsFunc(parentFunc, copied.ReadContext)
+
+		bound[name] = &copied
+	}
+	return bound
+}
+
+// setTagsDiff is the CustomizeDiff func that merges default_tags into
+// effective_tags, for the tag keys the resource doesn't have bound yet.
+func setTagsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*transport_tpg.Config)
+	if !ok || len(config.DefaultTags) == 0 {
+		return nil
+	}
+
+	effectiveTags := make(map[string]interface{})
+	for k, v := range d.Get("effective_tags").(map[string]interface{}) {
+		effectiveTags[k] = v
+	}
+	changed := false
+	for k, v := range config.DefaultTags {
+		if _, ok := effectiveTags[k]; !ok {
+			effectiveTags[k] = v
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := d.SetNew("effective_tags", effectiveTags); err != nil {
+		return fmt.Errorf("error setting new effective_tags diff: %w", err)
+	}
+	return nil
+}
+
+func applyDefaultTagsFunc(timeoutKey string, parentFunc tagsParentFunc, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		config, ok := meta.(*transport_tpg.Config)
+		if diags.HasError() || !ok || d.Id() == "" || len(config.DefaultTags) == 0 {
+			return diags
+		}
+		return append(diags, applyDefaultTags(d, config, parentFunc, d.Timeout(timeoutKey))...)
+	}
+}
+
+// tagsTarget is a resource whose tag bindings are read or changed.
+type tagsTarget struct {
+	parent, location, billingProject, userAgent string
+}
+
+func newTagsTarget(d *schema.ResourceData, config *transport_tpg.Config, parentFunc tagsParentFunc) (*tagsTarget, error) {
+	parent, location, err := parentFunc(d, config)
+	if err != nil {
+		return nil, err
+	}
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+	t := &tagsTarget{parent: parent, location: location, userAgent: userAgent}
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		t.billingProject = bp
+	}
+	return t, nil
+}
+
+// bound returns the values of the tags bound to the resource, rather than
+// inherited from its ancestors, keyed by tag key.
+func (t *tagsTarget) bound(config *transport_tpg.Config) (map[string]string, error) {
+	effectiveTags, err := tags.ListEffectiveTags(config, t.parent, t.location, t.billingProject, t.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	bound := make(map[string]string, len(effectiveTags))
+	for _, e := range effectiveTags {
+		if !e.Inherited {
+			bound[e.Key] = e.Value
+		}
+	}
+	return bound, nil
+}
+
+// applyDefaultTags binds the tags in default_tags whose keys aren't bound to
+// the resource yet, and sets effective_tags. A resource can only have one
+// value of each tag key bound, and the bindings of other values may be
+// managed elsewhere, so they're left in place with a warning.
+func applyDefaultTags(d *schema.ResourceData, config *transport_tpg.Config, parentFunc tagsParentFunc, timeout time.Duration) diag.Diagnostics {
+	t, err := newTagsTarget(d, config, parentFunc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bound, err := t.bound(config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keys := make([]string, 0, len(config.DefaultTags))
+	for k := range config.DefaultTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var diags diag.Diagnostics
+	for _, k := range keys {
+		v := config.DefaultTags[k]
+		if value, ok := bound[k]; ok {
+			if value != v {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("default_tags value of %s not bound", k),
+					Detail:   fmt.Sprintf("%s already has the value %q of tag key %s bound, which is left in place instead of the value %q from default_tags.", t.parent, value, k, v),
+				})
+			}
+			continue
+		}
+		if err := tags.CreateTagBinding(config, t.parent, t.location, k, v, t.billingProject, t.userAgent, timeout); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		bound[k] = v
+	}
+
+	if err := d.Set("effective_tags", bound); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func readEffectiveTagsFunc(parentFunc tagsParentFunc, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		config, ok := meta.(*transport_tpg.Config)
+		if diags.HasError() || !ok || d.Id() == "" {
+			return diags
+		}
+		// Resources that have never had default tags aren't read.
+		if len(config.DefaultTags) == 0 && len(d.Get("effective_tags").(map[string]interface{})) == 0 {
+			return diags
+		}
+
+		t, err := newTagsTarget(d, config, parentFunc)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		bound, err := t.bound(config)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := d.Set("effective_tags", bound); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
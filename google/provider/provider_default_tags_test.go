@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_default_tags_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestBindDefaultTags(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"google_project":      {Schema: map[string]*schema.Schema{"number": {Type: schema.TypeString, Computed: true}}},
+		"google_pubsub_topic": {Schema: map[string]*schema.Schema{}},
+	}
+	bound := bindDefaultTags(resources)
+	if _, ok := bound["google_project"].Schema["effective_tags"]; !ok {
+		t.Errorf("expected google_project to have effective_tags")
+	}
+	if bound["google_project"].CustomizeDiff == nil {
+		t.Errorf("expected google_project to merge default_tags into effective_tags")
+	}
+	if _, ok := bound["google_pubsub_topic"].Schema["effective_tags"]; ok {
+		t.Errorf("expected google_pubsub_topic not to have effective_tags")
+	}
+	if _, ok := resources["google_project"].Schema["effective_tags"]; ok {
+		t.Errorf("expected the registered resource to be left untouched")
+	}
+}
+
+func TestApplyDefaultTags(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		request := r.Method + " " + r.URL.Path
+		switch r.Method {
+		case "GET":
+			if got := r.URL.Query().Get("parent"); got != "//cloudresourcemanager.googleapis.com/projects/123" {
+				t.Errorf("unexpected parent %q", got)
+			}
+			if r.URL.Path != "/v3/effectiveTags" {
+				t.Errorf("unexpected path %q", r.URL.Path)
+			}
+			fmt.Fprint(w, `{"effectiveTags": [
+				{"tagValue": "tagValues/1", "namespacedTagValue": "456/env/dev", "namespacedTagKey": "456/env"},
+				{"tagValue": "tagValues/2", "namespacedTagValue": "456/team/data", "namespacedTagKey": "456/team"},
+				{"tagValue": "tagValues/3", "namespacedTagValue": "456/owner/org", "namespacedTagKey": "456/owner", "inherited": true}
+			]}`)
+			return
+		case "POST":
+			b, _ := io.ReadAll(r.Body)
+			var body map[string]interface{}
+			if err := json.Unmarshal(b, &body); err != nil {
+				t.Error(err)
+			}
+			request += " " + body["tagValueNamespacedName"].(string)
+		}
+		requests = append(requests, request)
+		fmt.Fprint(w, `{"name": "operations/op", "done": true}`)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Client:       ts.Client(),
+		TagsBasePath: ts.URL + "/v3/",
+		DefaultTags:  map[string]string{"456/env": "prod", "456/team": "data", "456/owner": "me"},
+	}
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"number":         {Type: schema.TypeString, Computed: true},
+		"effective_tags": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}}
+	d := r.TestResourceData()
+	d.SetId("my-project")
+	if err := d.Set("number", "123"); err != nil {
+		t.Fatal(err)
+	}
+
+	diags := applyDefaultTags(d, config, defaultTagsParents["google_project"], time.Minute)
+	if diags.HasError() {
+		t.Fatalf("unexpected error applying default tags: %v", diags)
+	}
+	// The value of 456/env bound by other means is kept, with a warning.
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning about 456/env, got %v", diags)
+	}
+
+	expectedRequests := []string{
+		"POST /v3/tagBindings 456/owner/me",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("expected requests %v, got %v", expectedRequests, requests)
+	}
+	expectedTags := map[string]interface{}{"456/env": "dev", "456/team": "data", "456/owner": "me"}
+	if got := d.Get("effective_tags"); !reflect.DeepEqual(got, expectedTags) {
+		t.Errorf("expected effective_tags %v, got %v", expectedTags, got)
+	}
+}
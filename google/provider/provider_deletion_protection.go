@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_deletion_protection.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// protectiveDefault is the value of a field that keeps resources from being
+// deleted.
+type protectiveDefault struct {
+	typ   schema.ValueType
+	value interface{}
+	// resources are the resources the value applies to, or nil if it
+	// applies to every resource with the field.
+	resources map[string]bool
+}
+
+// protectiveDefaults are the top level fields that default to protecting
+// resources for default_deletion_protection. deletion_policy only defaults to
+// PREVENT for the resources that accept it.
+var protectiveDefaults = map[string]protectiveDefault{
+	"deletion_protection": {typ: schema.TypeBool, value: true},
+	"deletion_policy": {
+		typ:   schema.TypeString,
+		value: "PREVENT",
+		resources: map[string]bool{
+			"google_firestore_index":                  true,
+			"google_project":                          true,
+			"google_secure_source_manager_instance":   true,
+			"google_secure_source_manager_repository": true,
+		},
+	},
+}
+
+// defaultDeletionProtection returns a copy of resources in which the fields
+// in protectiveDefaults default to protecting the resource when
+// default_deletion_protection is set in the provider configuration returned
+// by meta. Fields of other types, and fields that are computed or force
+// replacement, are left as they are. The registered resources are left
+// untouched.
+func defaultDeletionProtection(resources map[string]*schema.Resource, meta func() interface{}) map[string]*schema.Resource {
+	protected := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		protected[name] = r
+		for field, d := range protectiveDefaults {
+			s, ok := r.Schema[field]
+			if !ok || s.Type != d.typ || !s.Optional || s.Computed || s.ForceNew || s.Default == d.value {
+				continue
+			}
+			if d.resources != nil && !d.resources[name] {
+				continue
+			}
+			if protected[name] == r {
+				copied := *r
+				copied.Schema = maps.Clone(r.Schema)
+				protected[name] = &copied
+			}
+			withDefault := *s
+			withDefault.Default, withDefault.DefaultFunc = nil, protectiveDefaultFunc(s, d.value, meta)
+			protected[name].Schema[field] = &withDefault
+		}
+	}
+	return protected
+}
+
+// protectiveDefaultFunc returns the default of the field s, which is value
+// if default_deletion_protection is set.
+func protectiveDefaultFunc(s *schema.Schema, value interface{}, meta func() interface{}) schema.SchemaDefaultFunc {
+	return func() (interface{}, error) {
+		if config, ok := meta().(*transport_tpg.Config); ok && config.DefaultDeletionProtection {
+			return value, nil
+		}
+		return s.DefaultValue()
+	}
+}
+
+// refuseProtectedDeletion returns a copy of resources whose deletes fail for
+// the provider's protected_resource_types, see
+// transport_tpg.Config.CheckProtectedDeletion. The registered resources are
+// left untouched.
+func refuseProtectedDeletion(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	protected := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		copied := *r
+		copied.DeleteContext = refuseProtectedDeletionFunc(name, copied.DeleteContext)
+		copied.DeleteWithoutTimeout = refuseProtectedDeletionFunc(name, copied.DeleteWithoutTimeout)
+		protected[name] = &copied
+	}
+	return protected
+}
+
+func refuseProtectedDeletionFunc(name string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if config, ok := meta.(*transport_tpg.Config); ok {
+			if err := config.CheckProtectedDeletion(name, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return f(ctx, d, meta)
+	}
+}
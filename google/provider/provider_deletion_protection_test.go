@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_deletion_protection_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestDefaultDeletionProtection(t *testing.T) {
+	registered := map[string]*schema.Resource{
+		"google_sql_database_instance": {Schema: map[string]*schema.Schema{
+			"deletion_protection": {Type: schema.TypeBool, Optional: true, Default: false},
+		}},
+		"google_firestore_index": {Schema: map[string]*schema.Schema{
+			"deletion_policy": {Type: schema.TypeString, Optional: true, Default: "DELETE", ValidateFunc: validation.StringInSlice([]string{"DELETE", "PREVENT", ""}, false)},
+		}},
+		"google_sql_user": {Schema: map[string]*schema.Schema{
+			"deletion_policy": {Type: schema.TypeString, Optional: true, ValidateFunc: validation.StringInSlice([]string{"ABANDON", ""}, false)},
+		}},
+		"google_sql_database": {Schema: map[string]*schema.Schema{
+			"deletion_policy": {Type: schema.TypeString, Optional: true, Default: "DELETE", Description: `Possible values are: "ABANDON", "DELETE".`},
+		}},
+		"google_backup_dr_backup_vault": {Schema: map[string]*schema.Schema{
+			"deletion_protection": {Type: schema.TypeBool, Optional: true, ForceNew: true},
+		}},
+	}
+	config := &transport_tpg.Config{}
+	resources := defaultDeletionProtection(registered, func() interface{} { return config })
+
+	defaults := func() map[string]interface{} {
+		values := make(map[string]interface{})
+		for name, r := range resources {
+			for field, s := range r.Schema {
+				v, err := s.DefaultValue()
+				if err != nil {
+					t.Fatal(err)
+				}
+				values[name+"."+field] = v
+			}
+		}
+		return values
+	}
+
+	unset := map[string]interface{}{
+		"google_sql_database_instance.deletion_protection":  false,
+		"google_firestore_index.deletion_policy":            "DELETE",
+		"google_sql_user.deletion_policy":                   nil,
+		"google_sql_database.deletion_policy":               "DELETE",
+		"google_backup_dr_backup_vault.deletion_protection": nil,
+	}
+	if got := defaults(); !reflect.DeepEqual(got, unset) {
+		t.Errorf("expected the defaults to be unchanged without default_deletion_protection, got %v", got)
+	}
+
+	config.DefaultDeletionProtection = true
+	expected := map[string]interface{}{
+		"google_sql_database_instance.deletion_protection":  true,
+		"google_firestore_index.deletion_policy":            "PREVENT",
+		"google_sql_user.deletion_policy":                   nil,
+		"google_sql_database.deletion_policy":               "DELETE",
+		"google_backup_dr_backup_vault.deletion_protection": nil,
+	}
+	if got := defaults(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if registered["google_sql_database_instance"].Schema["deletion_protection"].Default != false {
+		t.Errorf("expected the registered resource to be left untouched")
+	}
+}
+
+func TestRefuseProtectedDeletion(t *testing.T) {
+	deleted := false
+	resources := refuseProtectedDeletion(map[string]*schema.Resource{
+		"google_sql_database_instance": {
+			Schema: map[string]*schema.Schema{},
+			DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				deleted = true
+				return nil
+			},
+		},
+	})
+	d := resources["google_sql_database_instance"].TestResourceData()
+	d.SetId("db")
+	config := &transport_tpg.Config{ProtectedResourceTypes: []string{"google_sql_database_instance"}}
+
+	t.Setenv(transport_tpg.AllowProtectedDeletionEnvVar, "")
+	if diags := resources["google_sql_database_instance"].DeleteContext(context.Background(), d, config); !diags.HasError() || deleted {
+		t.Errorf("expected deleting a protected resource type to fail without deleting it")
+	}
+
+	t.Setenv(transport_tpg.AllowProtectedDeletionEnvVar, "true")
+	if diags := resources["google_sql_database_instance"].DeleteContext(context.Background(), d, config); diags.HasError() || !deleted {
+		t.Errorf("expected %s to allow deleting a protected resource type, got %v", transport_tpg.AllowProtectedDeletionEnvVar, diags)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_error_diagnostics.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// translateResourceErrors returns a copy of resources whose CRUD functions
+// returning an error render the details of the Google API error it wraps
+// readably, with field violations reported against the attributes they
+// refer to. See transport_tpg.ApiErrorDiagnostics. The registered resources
+// are left untouched.
+//
+// Functions returning an error are replaced by ones returning diagnostics,
+// as only diagnostics can refer to attributes. Functions returning
+// diagnostics already lost the type of the error, and are expected to use
+// transport_tpg.ApiErrorDiagnostics themselves.
+func translateResourceErrors(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	translated := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		copied := *r
+		if copied.Create != nil {
+			copied.CreateContext, copied.Create = errorDiagsFunc(name, r, copied.Create), nil
+		}
+		if copied.Read != nil {
+			copied.ReadContext, copied.Read = errorDiagsFunc(name, r, copied.Read), nil
+		}
+		if copied.Update != nil {
+			copied.UpdateContext, copied.Update = errorDiagsFunc(name, r, copied.Update), nil
+		}
+		if copied.Delete != nil {
+			copied.DeleteContext, copied.Delete = errorDiagsFunc(name, r, copied.Delete), nil
+		}
+
+		translated[name] = &copied
+	}
+	return translated
+}
+
+// errorDiagsFunc returns f as a function returning diagnostics.
+func errorDiagsFunc(name string, r *schema.Resource, f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return transport_tpg.ApiErrorDiagnostics(f(d, meta), name, r)
+	}
+}
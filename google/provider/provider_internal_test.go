@@ -21,9 +21,11 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/acctest"
 	"github.com/hashicorp/terraform-provider-google/google/provider"
+	"github.com/hashicorp/terraform-provider-google/google/registry"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
@@ -1772,3 +1774,62 @@ func TestProvider_ProviderConfigure_batching(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_ProviderConfigure_productOverrides(t *testing.T) {
+	ctx := context.Background()
+	acctest.UnsetTestProviderConfigEnvs(t)
+	p := provider.Provider()
+	d := tpgresource.SetupTestResourceDataFromConfigMap(t, p.Schema, map[string]interface{}{
+		"credentials":   transport_tpg.TestFakeCredentialsPath,
+		"poll_interval": "10s",
+		"product_overrides": []interface{}{
+			map[string]interface{}{
+				"product":        "dns",
+				"poll_interval":  "2s",
+				"create_timeout": "1h",
+				"read_timeout":   "1h",
+			},
+		},
+	})
+
+	c, diags := p.ConfigureContextFunc(ctx, d)
+	if diags.HasError() {
+		t.Fatalf("unexpected error(s): %#v", diags)
+	}
+	config := c.(*transport_tpg.Config)
+
+	if got := config.ProductPollInterval("dns"); got != 2*time.Second {
+		t.Errorf("expected dns operations to be polled every 2s, got %s", got)
+	}
+	if got := config.ProductPollInterval("compute"); got != 10*time.Second {
+		t.Errorf("expected compute operations to be polled every 10s, got %s", got)
+	}
+
+	timeouts := p.ResourcesMap["google_dns_managed_zone"].Timeouts
+	if got := *timeouts.Create; got != time.Hour {
+		t.Errorf("expected google_dns_managed_zone create timeout to be 1h, got %s", got)
+	}
+	if got := *timeouts.Update; got != 20*time.Minute {
+		t.Errorf("expected google_dns_managed_zone update timeout to be unchanged, got %s", got)
+	}
+	if timeouts.Read != nil {
+		t.Errorf("expected google_dns_managed_zone read timeout to stay unset, got %s", *timeouts.Read)
+	}
+	if got := *registry.Resource("google_dns_managed_zone").Timeouts.Create; got != 20*time.Minute {
+		t.Errorf("expected registered google_dns_managed_zone create timeout to be unchanged, got %s", got)
+	}
+	if got := *provider.Provider().ResourcesMap["google_dns_managed_zone"].Timeouts.Create; got != 20*time.Minute {
+		t.Errorf("expected another provider's google_dns_managed_zone create timeout to be unchanged, got %s", got)
+	}
+
+	// Configuring the provider again without the override restores the default.
+	d = tpgresource.SetupTestResourceDataFromConfigMap(t, p.Schema, map[string]interface{}{
+		"credentials": transport_tpg.TestFakeCredentialsPath,
+	})
+	if _, diags := p.ConfigureContextFunc(ctx, d); diags.HasError() {
+		t.Fatalf("unexpected error(s): %#v", diags)
+	}
+	if got := *p.ResourcesMap["google_dns_managed_zone"].Timeouts.Create; got != 20*time.Minute {
+		t.Errorf("expected google_dns_managed_zone create timeout to be reset to 20m, got %s", got)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_operation_slots.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// limitOperations returns a copy of resources whose creates, updates and
+// deletes each get their own max_concurrent_operations slots, see
+// transport_tpg.Config.WithOperationSlots. Slots a function still holds when
+// it returns, e.g. because it failed before waiting on its operation, are
+// released then.
+func limitOperations(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	limited := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		copied := *r
+
+		copied.Create = limitOperationsFunc(copied.Create)
+		copied.Update = limitOperationsFunc(copied.Update)
+		copied.Delete = limitOperationsFunc(copied.Delete)
+
+		copied.CreateContext = limitOperationsContextFunc(copied.CreateContext)
+		copied.UpdateContext = limitOperationsContextFunc(copied.UpdateContext)
+		copied.DeleteContext = limitOperationsContextFunc(copied.DeleteContext)
+
+		copied.CreateWithoutTimeout = limitOperationsContextFunc(copied.CreateWithoutTimeout)
+		copied.UpdateWithoutTimeout = limitOperationsContextFunc(copied.UpdateWithoutTimeout)
+		copied.DeleteWithoutTimeout = limitOperationsContextFunc(copied.DeleteWithoutTimeout)
+
+		limited[name] = &copied
+	}
+	return limited
+}
+
+func limitOperationsFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		meta, release := operationSlotsMeta(meta)
+		defer release()
+		return f(d, meta)
+	}
+}
+
+func limitOperationsContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, release := operationSlotsMeta(meta)
+		defer release()
+		return f(ctx, d, meta)
+	}
+}
+
+func operationSlotsMeta(meta interface{}) (interface{}, func()) {
+	if config, ok := meta.(*transport_tpg.Config); ok {
+		return config.WithOperationSlots()
+	}
+	return meta, func() {}
+}
@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_pending_operations.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// pendingOperationPrivateKey is the key of the operation a resource was still
+// waiting on when its last apply failed in the resource's private state.
+const pendingOperationPrivateKey = "google_pending_operation"
+
+// NewGRPCProviderServer returns the server for the SDKv2 provider p. Apart
+// from what schema.Provider.GRPCProvider does, the operation a resource was
+// still waiting on when an apply failed is kept in the resource's private
+// state, so that the next refresh or apply resumes waiting on it, see
+// resumeOperations.
+//
+// The SDK doesn't let resource functions write private state, and drops it
+// when refreshing or planning changes, so this is done here rather than
+// through schema.ResourceData.
+//
+// Warnings raised while planning with tpgresource.AddPlanWarning are also
+// added to the plan's diagnostics here, as CustomizeDiff funcs can't return
+// them.
+func NewGRPCProviderServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &pendingOperationServer{schema.NewGRPCProviderServer(p)}
+}
+
+type pendingOperationServer struct {
+	*schema.GRPCProviderServer
+}
+
+// pendingOperationContextKey is the key in the context of CRUD functions of
+// the *resourceOperation for the resource being applied.
+type pendingOperationContextKey struct{}
+
+type resourceOperation struct {
+	pending *transport_tpg.PendingOperation
+}
+
+func (s *pendingOperationServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := tpgresource.ContextWithPlanWarnings(ctx)
+	resp, err := s.GRPCProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	for _, w := range warnings.Warnings() {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  w.Summary,
+			Detail:   w.Detail,
+		})
+	}
+	if pending := pendingOperationFromPrivate(req.PriorPrivate); pending != nil {
+		resp.PlannedPrivate = privateWithPendingOperation(resp.PlannedPrivate, pending)
+	}
+	return resp, nil
+}
+
+func (s *pendingOperationServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	op := &resourceOperation{pending: pendingOperationFromPrivate(req.Private)}
+	resp, err := s.GRPCProviderServer.ReadResource(context.WithValue(ctx, pendingOperationContextKey{}, op), req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Private = privateWithPendingOperation(resp.Private, op.pending)
+	return resp, nil
+}
+
+func (s *pendingOperationServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	op := &resourceOperation{pending: pendingOperationFromPrivate(req.PlannedPrivate)}
+	resp, err := s.GRPCProviderServer.ApplyResourceChange(context.WithValue(ctx, pendingOperationContextKey{}, op), req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	resp.Private = privateWithPendingOperation(resp.Private, op.pending)
+	return resp, nil
+}
+
+func pendingOperationFromPrivate(private []byte) *transport_tpg.PendingOperation {
+	if len(private) == 0 {
+		return nil
+	}
+	var meta struct {
+		Pending *transport_tpg.PendingOperation `json:"google_pending_operation"`
+	}
+	if err := json.Unmarshal(private, &meta); err != nil {
+		log.Printf("[WARN] Unable to read pending operation from private state: %s", err)
+		return nil
+	}
+	return meta.Pending
+}
+
+// privateWithPendingOperation returns private with its pending operation
+// replaced by pending, or removed if pending is nil.
+func privateWithPendingOperation(private []byte, pending *transport_tpg.PendingOperation) []byte {
+	if len(private) == 0 && pending == nil {
+		return private
+	}
+	meta := make(map[string]interface{})
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &meta); err != nil {
+			log.Printf("[WARN] Unable to update pending operation in private state: %s", err)
+			return private
+		}
+	}
+	if pending == nil {
+		if _, ok := meta[pendingOperationPrivateKey]; !ok {
+			return private
+		}
+		delete(meta, pendingOperationPrivateKey)
+	} else {
+		meta[pendingOperationPrivateKey] = pending
+	}
+	b, err := json.Marshal(meta)
+	if err != nil {
+		log.Printf("[WARN] Unable to update pending operation in private state: %s", err)
+		return private
+	}
+	return b
+}
+
+// resumeOperations returns a copy of resources whose creates, updates and
+// deletes keep the operation they were waiting on if they fail before it is
+// done, and whose refreshes, creates, updates and deletes first wait on an
+// operation kept by a previous apply. Operations are recorded by
+// tpgresource.OperationWait for waiters that implement
+// tpgresource.ResumableWaiter.
+//
+// A create that fails while its operation is still running is reported with
+// warnings rather than errors, so that the resource is kept in state without
+// being tainted, and the next refresh waits on the operation and reads the
+// resource instead of it being created again. An operation that is cancelled
+// because Terraform was interrupted isn't kept.
+func resumeOperations(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	resumed := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		copied := *r
+		read := copied.ReadContext
+		if read == nil {
+			read = copied.ReadWithoutTimeout
+		}
+
+		copied.CreateContext = resumeOperationFunc(schema.TimeoutCreate, read, copied.CreateContext)
+		copied.ReadContext = resumeOperationFunc(schema.TimeoutRead, read, copied.ReadContext)
+		copied.UpdateContext = resumeOperationFunc(schema.TimeoutUpdate, read, copied.UpdateContext)
+		copied.DeleteContext = resumeOperationFunc(schema.TimeoutDelete, read, copied.DeleteContext)
+
+		copied.CreateWithoutTimeout = resumeOperationFunc(schema.TimeoutCreate, read, copied.CreateWithoutTimeout)
+		copied.ReadWithoutTimeout = resumeOperationFunc(schema.TimeoutRead, read, copied.ReadWithoutTimeout)
+		copied.UpdateWithoutTimeout = resumeOperationFunc(schema.TimeoutUpdate, read, copied.UpdateWithoutTimeout)
+		copied.DeleteWithoutTimeout = resumeOperationFunc(schema.TimeoutDelete, read, copied.DeleteWithoutTimeout)
+
+		resumed[name] = &copied
+	}
+	return resumed
+}
+
+func resumeOperationFunc(timeoutKey string, read, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		op, _ := ctx.Value(pendingOperationContextKey{}).(*resourceOperation)
+		config, ok := meta.(*transport_tpg.Config)
+		if op == nil || !ok {
+			return f(ctx, d, meta)
+		}
+
+		if pending := op.pending; pending != nil {
+			log.Printf("[INFO] Resuming wait on operation %q left pending by a previous apply", pending.Name)
+			recorder := transport_tpg.NewOperationRecorder(d.Id)
+			err := tpgresource.ResumeOperationWait(config.WithOperationRecorder(recorder).WithResourceContext(ctx), pending, fmt.Sprintf("operation %s", pending.Name), d.Timeout(timeoutKey))
+			if recorder.Pending() != nil {
+				// Waiting stopped again before the operation was done, so
+				// it's kept for the next refresh or apply.
+				diags := diag.Diagnostics{pendingOperationWarning(pending)}
+				if timeoutKey != schema.TimeoutRead {
+					return append(diags, diag.Errorf("Error waiting on operation %q left pending by a previous apply: %s", pending.Name, err)...)
+				}
+				return append(diags, f(ctx, d, meta)...)
+			}
+			op.pending = nil
+
+			if err != nil {
+				// The operation failed, so the change is made again.
+				log.Printf("[WARN] Operation %q left pending by a previous apply failed: %s", pending.Name, err)
+			} else {
+				switch timeoutKey {
+				case schema.TimeoutCreate:
+					// The resource was created by the operation, and would
+					// fail to be created again as it already exists.
+					if d.Id() == "" {
+						d.SetId(pending.ResourceId)
+					}
+					return read(ctx, d, meta)
+				case schema.TimeoutDelete:
+					return nil
+				}
+			}
+		}
+
+		recorder := transport_tpg.NewOperationRecorder(d.Id)
+		diags := f(ctx, d, config.WithOperationRecorder(recorder).WithResourceContext(ctx))
+		pending := recorder.Pending()
+		if pending == nil || !diags.HasError() || timeoutKey == schema.TimeoutRead {
+			return diags
+		}
+
+		if timeoutKey == schema.TimeoutCreate {
+			if pending.ResourceId == "" {
+				return diags
+			}
+			// Terraform taints resources whose create fails, which would
+			// replace the resource rather than wait on its operation.
+			d.SetId(pending.ResourceId)
+			diags = asWarnings(diags)
+		}
+		log.Printf("[INFO] Keeping operation %q, which is still pending, for the next refresh or apply", pending.Name)
+		op.pending = pending
+		return append(diags, pendingOperationWarning(pending))
+	}
+}
+
+// pendingOperationWarning explains that the operation pending is still
+// running and will be waited on again.
+func pendingOperationWarning(pending *transport_tpg.PendingOperation) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Operation %s is still running", pending.Name),
+		Detail: "Terraform stopped waiting on the operation before it was done. It has been kept in the " +
+			"resource's state, and the next refresh or apply waits on it again.",
+	}
+}
+
+// asWarnings returns diags with its errors turned into warnings.
+func asWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	warnings := make(diag.Diagnostics, len(diags))
+	for i, d := range diags {
+		d.Severity = diag.Warning
+		warnings[i] = d
+	}
+	return warnings
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_pending_operations_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestResumeOperations(t *testing.T) {
+	var done atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name": "operations/op", "done": %t}`, done.Load())
+	}))
+	defer ts.Close()
+
+	creates, reads := 0, 0
+	resources := resumeOperations(map[string]*schema.Resource{
+		"google_channel": {
+			Schema: map[string]*schema.Schema{},
+			CreateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				creates++
+				d.SetId("channels/c")
+				w, err := tpgresource.NewOperationWaiter(meta.(*transport_tpg.Config), tpgresource.AipOperation, map[string]interface{}{"name": "operations/op"}, ts.URL+"/v1/", "my-project", "")
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if err := tpgresource.OperationWait(w, "Creating Channel", time.Second, time.Millisecond); err != nil {
+					d.SetId("")
+					return diag.FromErr(err)
+				}
+				return nil
+			},
+			ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				reads++
+				return nil
+			},
+		},
+	})
+	r := resources["google_channel"]
+	config := &transport_tpg.Config{Client: ts.Client()}
+
+	// A create that times out keeps the resource, with only warnings so that
+	// it isn't tainted, and its operation for the next refresh or apply.
+	op := &resourceOperation{}
+	ctx := context.WithValue(context.Background(), pendingOperationContextKey{}, op)
+	d := r.TestResourceData()
+	diags := r.CreateContext(ctx, d, config)
+	if diags.HasError() {
+		t.Fatalf("expected only warnings, got %#v", diags)
+	}
+	if d.Id() != "channels/c" {
+		t.Errorf("expected the resource to be kept, got ID %q", d.Id())
+	}
+	if op.pending == nil || op.pending.Name != "operations/op" || op.pending.ResourceId != "channels/c" {
+		t.Fatalf("expected the operation to be kept, got %+v", op.pending)
+	}
+
+	// Once the operation is done, the resource is read rather than created
+	// again.
+	done.Store(true)
+	d = r.TestResourceData()
+	if diags := r.CreateContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if creates != 1 || reads != 1 {
+		t.Errorf("expected 1 create and 1 read, got %d and %d", creates, reads)
+	}
+	if d.Id() != "channels/c" || op.pending != nil {
+		t.Errorf("expected the resource to be read and the operation to be dropped, got ID %q and %+v", d.Id(), op.pending)
+	}
+
+	// Refreshes resume waiting on the operation too.
+	op.pending = &transport_tpg.PendingOperation{Kind: "aip", Name: "operations/op", Url: ts.URL + "/v1/operations/op", ResourceId: "channels/c"}
+	d = r.TestResourceData()
+	d.SetId("channels/c")
+	if diags := r.ReadContext(ctx, d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+	if reads != 2 || op.pending != nil {
+		t.Errorf("expected the resource to be read and the operation to be dropped, got %d reads and %+v", reads, op.pending)
+	}
+}
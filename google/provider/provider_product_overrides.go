@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_product_overrides.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// productTimeouts returns a copy of resources in which the resources with
+// default timeouts have timeouts of their own, and a func that sets them from
+// the product_overrides of the provider configuration meta, falling back to
+// the resources' defaults. The SDK reads a resource's default timeouts when
+// planning, so the func is called when the provider is configured. The
+// registered resources are left untouched. Only timeouts a resource already
+// supports are changed.
+func productTimeouts(resources map[string]*schema.Resource) (map[string]*schema.Resource, func(meta interface{})) {
+	overridable := make(map[string]*schema.Resource, len(resources))
+	defaults := make(map[string]schema.ResourceTimeout)
+	for name, r := range resources {
+		overridable[name] = r
+		if r.Timeouts == nil {
+			continue
+		}
+		defaults[name] = *r.Timeouts
+
+		copied := *r
+		copied.Timeouts = &schema.ResourceTimeout{
+			Create:  copyTimeout(r.Timeouts.Create),
+			Read:    copyTimeout(r.Timeouts.Read),
+			Update:  copyTimeout(r.Timeouts.Update),
+			Delete:  copyTimeout(r.Timeouts.Delete),
+			Default: copyTimeout(r.Timeouts.Default),
+		}
+		overridable[name] = &copied
+	}
+
+	configure := func(meta interface{}) {
+		config, ok := meta.(*transport_tpg.Config)
+		if !ok {
+			return
+		}
+		for name, d := range defaults {
+			o, ok := config.ProductOverrides[registry.ResourceProductName(name)]
+			if !ok {
+				o = &transport_tpg.ProductOverride{}
+			}
+			timeouts := overridable[name].Timeouts
+			overrideTimeout(timeouts.Create, d.Create, o.CreateTimeout)
+			overrideTimeout(timeouts.Read, d.Read, o.ReadTimeout)
+			overrideTimeout(timeouts.Update, d.Update, o.UpdateTimeout)
+			overrideTimeout(timeouts.Delete, d.Delete, o.DeleteTimeout)
+		}
+	}
+	return overridable, configure
+}
+
+func copyTimeout(t *time.Duration) *time.Duration {
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+// overrideTimeout sets timeout to override, or to its default if override
+// isn't set.
+func overrideTimeout(timeout, defaultTimeout *time.Duration, override time.Duration) {
+	if timeout == nil {
+		return
+	}
+	if override > 0 {
+		*timeout = override
+		return
+	}
+	*timeout = *defaultTimeout
+}
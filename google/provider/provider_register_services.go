@@ -18,6 +18,7 @@ package provider
 
 import (
 	// Register all services by importing them.
+	_ "github.com/hashicorp/terraform-provider-google/google/services"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/accessapproval"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/accesscontextmanager"
 	_ "github.com/hashicorp/terraform-provider-google/google/services/activedirectory"
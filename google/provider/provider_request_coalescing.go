@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_request_coalescing.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// uncoalescedChanges returns a copy of resources whose creates, updates and
+// deletes always send their GET requests, so that identical requests are only
+// coalesced while planning and refreshing, see
+// transport_tpg.Config.WithoutRequestCoalescing. A resource then never reads
+// back a state cached before its own change, e.g. while its operation was
+// still running.
+func uncoalescedChanges(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	uncoalesced := make(map[string]*schema.Resource, len(resources))
+	for name, r := range resources {
+		copied := *r
+
+		copied.Create = uncoalescedFunc(copied.Create)
+		copied.Update = uncoalescedFunc(copied.Update)
+		copied.Delete = uncoalescedFunc(copied.Delete)
+
+		copied.CreateContext = uncoalescedContextFunc(copied.CreateContext)
+		copied.UpdateContext = uncoalescedContextFunc(copied.UpdateContext)
+		copied.DeleteContext = uncoalescedContextFunc(copied.DeleteContext)
+
+		copied.CreateWithoutTimeout = uncoalescedContextFunc(copied.CreateWithoutTimeout)
+		copied.UpdateWithoutTimeout = uncoalescedContextFunc(copied.UpdateWithoutTimeout)
+		copied.DeleteWithoutTimeout = uncoalescedContextFunc(copied.DeleteWithoutTimeout)
+
+		uncoalesced[name] = &copied
+	}
+	return uncoalesced
+}
+
+func uncoalescedFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, uncoalescedMeta(meta))
+	}
+}
+
+func uncoalescedContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, uncoalescedMeta(meta))
+	}
+}
+
+func uncoalescedMeta(meta interface{}) interface{} {
+	if config, ok := meta.(*transport_tpg.Config); ok {
+		return config.WithoutRequestCoalescing()
+	}
+	return meta
+}
@@ -44,6 +44,23 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
 
+// Resources with effective_annotations need terraform_annotations next to it,
+// as tpgresource.SetAnnotationsDiff and SetMetadataAnnotationsDiff track the
+// annotations managed by Terraform in it.
+func TestProvider_terraformAnnotations(t *testing.T) {
+	for name, r := range provider.Provider().ResourcesMap {
+		s := r.Schema
+		if metadata, ok := s["metadata"]; ok {
+			if elem, ok := metadata.Elem.(*schema.Resource); ok && elem.Schema["effective_annotations"] != nil {
+				s = elem.Schema
+			}
+		}
+		if s["effective_annotations"] != nil && s["terraform_annotations"] == nil {
+			t.Errorf("%s has effective_annotations but no terraform_annotations", name)
+		}
+	}
+}
+
 func TestAccProviderBasePath_setBasePath(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/provider/provider_tracing.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// traceResources returns a copy of resources whose CRUD functions each run
+// in an OpenTelemetry span. The registered resources are left untouched.
+func traceResources(resources map[string]*schema.Resource, prefix string) map[string]*schema.Resource {
+	traced := make(map[string]*schema.Resource, len(resources))
+	for key, r := range resources {
+		copied := *r
+		name := prefix + key
+
+		copied.Create = traceFunc(name, "Create", copied.Create)
+		copied.Read = traceFunc(name, "Read", copied.Read)
+		copied.Update = traceFunc(name, "Update", copied.Update)
+		copied.Delete = traceFunc(name, "Delete", copied.Delete)
+
+		copied.CreateContext = traceContextFunc(name, "Create", copied.CreateContext)
+		copied.ReadContext = traceContextFunc(name, "Read", copied.ReadContext)
+		copied.UpdateContext = traceContextFunc(name, "Update", copied.UpdateContext)
+		copied.DeleteContext = traceContextFunc(name, "Delete", copied.DeleteContext)
+
+		copied.CreateWithoutTimeout = traceContextFunc(name, "Create", copied.CreateWithoutTimeout)
+		copied.ReadWithoutTimeout = traceContextFunc(name, "Read", copied.ReadWithoutTimeout)
+		copied.UpdateWithoutTimeout = traceContextFunc(name, "Update", copied.UpdateWithoutTimeout)
+		copied.DeleteWithoutTimeout = traceContextFunc(name, "Delete", copied.DeleteWithoutTimeout)
+
+		traced[key] = &copied
+	}
+	return traced
+}
+
+func traceFunc(name, op string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ctx, span := startResourceSpan(context.Background(), name, op, d)
+		err := f(d, traceMeta(ctx, meta))
+		transport_tpg.EndSpan(span, err)
+		return err
+	}
+}
+
+func traceContextFunc(name, op string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := startResourceSpan(ctx, name, op, d)
+		diags := f(ctx, d, traceMeta(ctx, meta))
+		var err error
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				err = errors.New(diagnostic.Summary)
+				break
+			}
+		}
+		transport_tpg.EndSpan(span, err)
+		return diags
+	}
+}
+
+func startResourceSpan(ctx context.Context, name, op string, d *schema.ResourceData) (context.Context, trace.Span) {
+	return transport_tpg.StartSpan(ctx, name+" "+op,
+		attribute.String("terraform.resource.type", name),
+		attribute.String("terraform.operation", op),
+		attribute.String("terraform.resource.id", d.Id()),
+	)
+}
+
+// traceMeta returns the provider meta to pass to a traced function, so that
+// requests it makes are traced as children of the span in ctx.
+func traceMeta(ctx context.Context, meta interface{}) interface{} {
+	if config, ok := meta.(*transport_tpg.Config); ok {
+		return config.WithTraceContext(ctx)
+	}
+	return meta
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/registry/metadata.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package registry
+
+import (
+	"sync"
+)
+
+// FieldMetadata maps a field of a resource's API representation to its
+// Terraform attribute, as listed in the resource's `_meta.yaml` file. Only
+// fields whose attribute isn't the snake case form of the API field are
+// registered.
+type FieldMetadata struct {
+	// ApiField is the dot-separated path of the field in the API, e.g.
+	// "destination.cloudRun.service".
+	ApiField string
+	// Field is the dot-separated path of the Terraform attribute, e.g.
+	// "destination.cloud_run_service.service".
+	Field string
+}
+
+var resourceFields = struct {
+	sync.RWMutex
+	fields map[string][]FieldMetadata
+}{fields: make(map[string][]FieldMetadata)}
+
+// RegisterResourceFields adds fields to the field metadata of the given
+// resource returned by ResourceFields.
+func RegisterResourceFields(resource string, fields []FieldMetadata) {
+	resourceFields.Lock()
+	defer resourceFields.Unlock()
+	resourceFields.fields[resource] = append(resourceFields.fields[resource], fields...)
+}
+
+// ResourceFields returns the field metadata of the given resource, or nil if
+// no metadata is registered for it.
+func ResourceFields(name string) []FieldMetadata {
+	resourceFields.RLock()
+	defer resourceFields.RUnlock()
+	return resourceFields.fields[name]
+}
@@ -70,6 +70,15 @@ func GetProduct(name string) Product {
 	return p
 }
 
+// LookupProduct returns the product information for the given product name and whether it
+// is registered.
+func LookupProduct(name string) (Product, bool) {
+	products.RLock()
+	defer products.RUnlock()
+	p, ok := products.m[name]
+	return p, ok
+}
+
 func ListProducts() []Product {
 	l := slices.Collect(maps.Values(products.m))
 	slices.SortFunc(l, func(a, b Product) int {
@@ -165,6 +174,14 @@ func ResourceMap() map[string]*schema.Resource {
 	return ret
 }
 
+// ResourceProductName returns the name of the product the resource is associated with, or
+// an empty string if the resource isn't registered.
+func ResourceProductName(name string) string {
+	schemas.RLock()
+	defer schemas.RUnlock()
+	return schemas.r[name].ProductName
+}
+
 // DataSource returns the Terraform schema for the requested data source. The function panics
 // if the requested data source is not registered. This function is called during provider
 // intitialization when the absence of a data source is an unrecoverable error.
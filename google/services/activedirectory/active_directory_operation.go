@@ -18,67 +18,18 @@
 package activedirectory
 
 import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
-
-	"google.golang.org/api/googleapi"
-)
-
-var (
-	_ = json.Marshal
-	_ = errors.New
-	_ = fmt.Sprintf
-	_ = log.Print
-	_ = strings.Trim
-	_ = time.Now
-	_ = tpgresource.SetLabels
-	_ = transport_tpg.Config{}
-	_ = googleapi.Error{}
 )
 
-type ActiveDirectoryOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	Project   string
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *ActiveDirectoryOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	// Returns the proper get.
-	url := fmt.Sprintf(
-		"%s%s",
-		transport_tpg.BaseUrl(Product, w.Config),
-		fmt.Sprintf("%s", w.CommonOperationWaiter.Op.Name),
-	)
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:               w.Config,
-		Method:               "GET",
-		Project:              w.Project,
-		RawURL:               url,
-		UserAgent:            w.UserAgent,
-		ErrorAbortPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429QuotaError},
-	})
-}
-
-func createActiveDirectoryWaiter(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string) (*ActiveDirectoryOperationWaiter, error) {
-	w := &ActiveDirectoryOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-		Project:   project,
-	}
-	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+func createActiveDirectoryWaiter(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string) (*tpgresource.OperationWaiter, error) {
+	w, err := tpgresource.NewOperationWaiter(config, tpgresource.AipOperation, op, transport_tpg.BaseUrl(Product, config), project, userAgent)
+	if err != nil {
 		return nil, err
 	}
+	w.ErrorAbortPredicates = []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429QuotaError}
 	return w, nil
 }
 
@@ -88,14 +39,10 @@ func ActiveDirectoryOperationWaitTimeWithResponse(config *transport_tpg.Config,
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
-	rawResponse := []byte(w.CommonOperationWaiter.Op.Response)
-	if len(rawResponse) == 0 {
-		return errors.New("`resource` not set in operation response")
-	}
-	return json.Unmarshal(rawResponse, response)
+	return w.Response(response)
 }
 
 func ActiveDirectoryOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string, timeout time.Duration) error {
@@ -108,5 +55,5 @@ func ActiveDirectoryOperationWaitTime(config *transport_tpg.Config, op map[strin
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
@@ -18,67 +18,14 @@
 package alloydb
 
 import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"log"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
-
-	"google.golang.org/api/googleapi"
-)
-
-var (
-	_ = json.Marshal
-	_ = errors.New
-	_ = fmt.Sprintf
-	_ = log.Print
-	_ = strings.Trim
-	_ = time.Now
-	_ = tpgresource.SetLabels
-	_ = transport_tpg.Config{}
-	_ = googleapi.Error{}
 )
 
-type AlloydbOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	Project   string
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *AlloydbOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	// Returns the proper get.
-	url := fmt.Sprintf(
-		"%s%s",
-		transport_tpg.BaseUrl(Product, w.Config),
-		fmt.Sprintf("%s", w.CommonOperationWaiter.Op.Name),
-	)
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    w.Config,
-		Method:    "GET",
-		Project:   w.Project,
-		RawURL:    url,
-		UserAgent: w.UserAgent,
-	})
-}
-
-func createAlloydbWaiter(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string) (*AlloydbOperationWaiter, error) {
-	w := &AlloydbOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-		Project:   project,
-	}
-	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
-		return nil, err
-	}
-	return w, nil
+func createAlloydbWaiter(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string) (*tpgresource.OperationWaiter, error) {
+	return tpgresource.NewOperationWaiter(config, tpgresource.AipOperation, op, transport_tpg.BaseUrl(Product, config), project, userAgent)
 }
 
 // nolint: deadcode,unused
@@ -87,14 +34,10 @@ func AlloydbOperationWaitTimeWithResponse(config *transport_tpg.Config, op map[s
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
-	rawResponse := []byte(w.CommonOperationWaiter.Op.Response)
-	if len(rawResponse) == 0 {
-		return errors.New("`resource` not set in operation response")
-	}
-	return json.Unmarshal(rawResponse, response)
+	return w.Response(response)
 }
 
 func AlloydbOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, project, activity, userAgent string, timeout time.Duration) error {
@@ -107,5 +50,5 @@ func AlloydbOperationWaitTime(config *transport_tpg.Config, op map[string]interf
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
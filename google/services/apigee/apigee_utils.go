@@ -109,6 +109,7 @@ func sendRequestRawBodyWithTimeout(config *transport_tpg.Config, method, project
 		},
 		Timeout:              timeout,
 		ErrorRetryPredicates: errorRetryPredicates,
+		RetryOn:              config.RetryOn,
 	})
 	if err != nil {
 		return nil, err
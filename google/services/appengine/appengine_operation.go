@@ -65,7 +65,7 @@ func AppEngineOperationWaitTimeWithResponse(config *transport_tpg.Config, res in
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
 	return json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), response)
@@ -86,5 +86,5 @@ func AppEngineOperationWaitTime(config *transport_tpg.Config, res interface{}, a
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
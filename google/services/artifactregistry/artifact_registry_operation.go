@@ -18,73 +18,20 @@
 package artifactregistry
 
 import (
-	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
-
-	"google.golang.org/api/googleapi"
-)
-
-var (
-	_ = json.Marshal
-	_ = errors.New
-	_ = fmt.Sprintf
-	_ = log.Print
-	_ = strings.Trim
-	_ = time.Now
-	_ = tpgresource.SetLabels
-	_ = transport_tpg.Config{}
-	_ = googleapi.Error{}
 )
 
-type ArtifactRegistryOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	Project   string
-	Location  string
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *ArtifactRegistryOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	// Returns the proper get.
-	url := fmt.Sprintf(
-		"%s%s",
-		transport_tpg.BaseUrl(Product, w.Config),
-		fmt.Sprintf("%s", w.CommonOperationWaiter.Op.Name),
-	)
-	if strings.Contains(url, "{{location}}") && w.Location == "" {
-		return nil, fmt.Errorf("failed to find location for a resource with a regionalized endpoint %s", url)
+func createArtifactRegistryWaiter(config *transport_tpg.Config, op map[string]interface{}, project, location, activity, userAgent string) (*tpgresource.OperationWaiter, error) {
+	baseUrl := transport_tpg.BaseUrl(Product, config)
+	if strings.Contains(baseUrl, "{{location}}") && location == "" {
+		return nil, fmt.Errorf("failed to find location for a resource with a regionalized endpoint %s", baseUrl)
 	}
-	url = strings.ReplaceAll(url, "{{location}}", w.Location)
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    w.Config,
-		Method:    "GET",
-		Project:   w.Project,
-		RawURL:    url,
-		UserAgent: w.UserAgent,
-	})
-}
-
-func createArtifactRegistryWaiter(config *transport_tpg.Config, op map[string]interface{}, project, location, activity, userAgent string) (*ArtifactRegistryOperationWaiter, error) {
-	w := &ArtifactRegistryOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-		Project:   project,
-		Location:  location,
-	}
-	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
-		return nil, err
-	}
-	return w, nil
+	return tpgresource.NewOperationWaiter(config, tpgresource.AipOperation, op, tpgresource.LocationalBaseUrl(baseUrl, location), project, userAgent)
 }
 
 // nolint: deadcode,unused
@@ -93,14 +40,10 @@ func ArtifactRegistryOperationWaitTimeWithResponse(config *transport_tpg.Config,
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
-	rawResponse := []byte(w.CommonOperationWaiter.Op.Response)
-	if len(rawResponse) == 0 {
-		return errors.New("`resource` not set in operation response")
-	}
-	return json.Unmarshal(rawResponse, response)
+	return w.Response(response)
 }
 
 func ArtifactRegistryOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, project, location, activity, userAgent string, timeout time.Duration) error {
@@ -113,5 +56,5 @@ func ArtifactRegistryOperationWaitTime(config *transport_tpg.Config, op map[stri
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
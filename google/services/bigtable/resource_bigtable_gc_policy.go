@@ -301,6 +301,7 @@ func resourceBigtableGCPolicyUpsert(d *schema.ResourceData, meta interface{}) er
 		Timeout:              timeout,
 		PollInterval:         pollInterval,
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsBigTableRetryableError},
+		RetryOn:              config.RetryOn,
 	})
 	if err != nil {
 		return err
@@ -493,6 +494,7 @@ func resourceBigtableGCPolicyDestroy(d *schema.ResourceData, meta interface{}) e
 		Timeout:              timeout,
 		PollInterval:         pollInterval,
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsBigTableRetryableError},
+		RetryOn:              config.RetryOn,
 	})
 	if err != nil {
 		return err
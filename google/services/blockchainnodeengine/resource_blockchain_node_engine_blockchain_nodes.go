@@ -720,18 +720,7 @@ func flattenBlockchainNodeEngineBlockchainNodesUpdateTime(v interface{}, d *sche
 }
 
 func flattenBlockchainNodeEngineBlockchainNodesLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenBlockchainNodeEngineBlockchainNodesConnectionInfo(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -903,18 +892,7 @@ func flattenBlockchainNodeEngineBlockchainNodesBlockchainType(v interface{}, d *
 }
 
 func flattenBlockchainNodeEngineBlockchainNodesTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenBlockchainNodeEngineBlockchainNodesEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -113,6 +113,15 @@ func ResourceClouddeployAutomation() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceClouddeployAutomationResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceClouddeployAutomationUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -436,6 +445,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -960,18 +975,7 @@ func flattenClouddeployAutomationAnnotations(v interface{}, d *schema.ResourceDa
 }
 
 func flattenClouddeployAutomationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenClouddeployAutomationEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1256,18 +1260,7 @@ func flattenClouddeployAutomationEffectiveAnnotations(v interface{}, d *schema.R
 }
 
 func flattenClouddeployAutomationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenClouddeployAutomationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1825,3 +1818,338 @@ func ResourceClouddeployAutomationFlatten(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+func resourceClouddeployAutomationResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"delivery_pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The delivery_pipeline for the resource`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location for the resource`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Name of the 'Automation'.`,
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Required. List of Automation rules associated with the Automation resource. Must have at least one rule and limited to 250 rules per Delivery Pipeline. Note: the order of the rules here is not the same as the order of execution.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advance_rollout_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Optional. The 'AdvanceRolloutRule' will automatically advance a successful Rollout.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. ID of the rule. This id must be unique in the 'Automation' resource to which this rule belongs. The format is 'a-z{0,62}'.`,
+									},
+									"source_phases": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Optional. Proceeds only after phase name matched any one in the list. This value must consist of lower-case letters, numbers, and hyphens, start with a letter and end with a letter or a number, and have a max length of 63 characters. In other words, it must match the following regex: '^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$'.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"wait": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Optional. How long to wait after a rollout is finished.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{},
+						},
+						"promote_release_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Optional. 'PromoteReleaseRule' will automatically promote a release from the current target to a specified target.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. ID of the rule. This id must be unique in the 'Automation' resource to which this rule belongs. The format is 'a-z{0,62}'.`,
+									},
+									"destination_phase": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Optional. The starting phase of the rollout created by this operation. Default to the first phase.`,
+									},
+									"destination_target_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Optional. The ID of the stage in the pipeline to which this 'Release' is deploying. If unspecified, default it to the next stage in the promotion flow. The value of this field could be one of the following: * The last segment of a target name. It only needs the ID to determine if the target is one of the stages in the promotion sequence defined in the pipeline. * "@next", the next target in the promotion sequence.`,
+									},
+									"wait": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Optional. How long the release need to be paused until being promoted to the next target.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{},
+						},
+						"repair_rollout_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Optional. The RepairRolloutRule will automatically repair a failed rollout.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. ID of the rule. This id must be unique in the 'Automation' resource to which this rule belongs. The format is 'a-z{0,62}'.`,
+									},
+									"jobs": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Optional. Jobs to repair. Proceeds only after job name matched any one in the list, or for all jobs if unspecified or empty. The phase that includes the job must match the phase ID specified in sourcePhase. This value must consist of lower-case letters, numbers, and hyphens, start with a letter and end with a letter or a number, and have a max length of 63 characters. In other words, it must match the following regex: ^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"phases": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Optional. Phases within which jobs are subject to automatic repair actions on failure. Proceeds only after phase name matched any one in the list, or for all phases if unspecified. This value must consist of lower-case letters, numbers, and hyphens, start with a letter and end with a letter or a number, and have a max length of 63 characters. In other words, it must match the following regex: ^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"repair_phases": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Optional. Proceeds only after phase name matched any one in the list. This value must consist of lower-case letters, numbers, and hyphens, start with a letter and end with a letter or a number, and have a max length of 63 characters. In other words, it must match the following regex: '^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$'.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retry": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. Retries a failed job.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"attempts": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `Required. Total number of retries. Retry is skipped if set to 0; The minimum value is 1, and the maximum value is 10.`,
+															},
+															"backoff_mode": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: verify.ValidateEnum([]string{"BACKOFF_MODE_UNSPECIFIED", "BACKOFF_MODE_LINEAR", "BACKOFF_MODE_EXPONENTIAL", ""}),
+																Description:  `Optional. The pattern of how wait time will be increased. Default is linear. Backoff mode will be ignored if wait is 0. Possible values: ["BACKOFF_MODE_UNSPECIFIED", "BACKOFF_MODE_LINEAR", "BACKOFF_MODE_EXPONENTIAL"]`,
+															},
+															"wait": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. How long to wait for the first retry. Default is 0, and the maximum value is 14d. A duration in seconds with up to nine fractional digits, ending with 's'. Example: '3.5s'.`,
+															},
+														},
+													},
+													ExactlyOneOf: []string{},
+												},
+												"rollback": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. Rolls back a Rollout.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"destination_phase": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. The starting phase ID for the Rollout. If unspecified, the Rollout will start in the stable phase.`,
+															},
+															"disable_rollback_if_rollout_pending": {
+																Type:        schema.TypeBool,
+																Optional:    true,
+																Description: `Optional. If pending rollout exists on the target, the rollback operation will be aborted.`,
+															},
+														},
+													},
+													ExactlyOneOf: []string{},
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{},
+						},
+						"timed_promote_release_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Optional. The 'TimedPromoteReleaseRule' will automatically promote a release from the current target(s) to the specified target(s) on a configured schedule.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. ID of the rule. This id must be unique in the 'Automation' resource to which this rule belongs. The format is 'a-z{0,62}'.`,
+									},
+									"schedule": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. Schedule in crontab format. e.g. '0 9 * * 1' for every Monday at 9am.`,
+									},
+									"time_zone": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Required. The time zone in IANA format IANA Time Zone Database (e.g. America/New_York).`,
+									},
+									"destination_phase": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Optional. The starting phase of the rollout created by this rule. Default to the first phase.`,
+									},
+									"destination_target_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Optional. The ID of the stage in the pipeline to which this Release is deploying. If unspecified, default it to the next stage in the promotion flow. The value of this field could be one of the following:
+  - The last segment of a target name
+  - "@next", the next target in the promotion sequence"`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{},
+						},
+					},
+				},
+			},
+			"selector": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Required. Selected resources to which the automation will be applied.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"targets": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Contains attributes about a target.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `ID of the 'Target'. The value of this field could be one of the following: * The last segment of a target name. It only needs the ID to determine which target is being referred to * "*", all targets in a location.`,
+									},
+									"labels": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Optional:    true,
+										Description: `Target labels.`,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Required. Email address of the user-managed IAM service account that creates Cloud Deploy release and rollout resources.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy. Annotations must meet the following constraints: * Annotations are key/value pairs. * Valid annotation keys have two segments: an optional prefix and name, separated by a slash ('/'). * The name segment is required and must be 63 characters or less, beginning and ending with an alphanumeric character ('[a-z0-9A-Z]') with dashes ('-'), underscores ('_'), dots ('.'), and alphanumerics between. * The prefix is optional. If specified, the prefix must be a DNS subdomain: a series of DNS labels separated by dots('.'), not longer than 253 characters in total, followed by a slash ('/'). See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/#syntax-and-character-set for more details.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Optional. Description of the 'Automation'. Max length is 255 characters.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. Labels are attributes that can be set and used by both the user and by Cloud Deploy. Labels must meet the following constraints: * Keys and values can contain only lowercase letters, numeric characters, underscores, and dashes. * All characters must use UTF-8 encoding, and international characters are allowed. * Keys must start with a lowercase letter or international character. * Each resource is limited to a maximum of 64 labels. Both keys and values are additionally constrained to be <= 63 characters.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"suspended": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Optional. When Suspended, automation is deactivated from execution.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Time at which the automation was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Optional. The weak etag of the 'Automation' resource. This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Unique identifier of the 'Automation'.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Time at which the automation was updated.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceClouddeployAutomationUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
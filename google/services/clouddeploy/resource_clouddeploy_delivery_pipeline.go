@@ -49,6 +49,16 @@ func ResourceClouddeployDeliveryPipeline() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceClouddeployDeliveryPipelineResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceClouddeployDeliveryPipelineUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetLabelsDiff,
@@ -81,6 +91,11 @@ func ResourceClouddeployDeliveryPipeline() *schema.Resource {
 				Computed:    true,
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+			},
 
 			"effective_labels": {
 				Type:        schema.TypeMap,
@@ -2302,3 +2317,119 @@ func init() {
 		Schema:      ResourceClouddeployDeliveryPipeline(),
 	}.Register()
 }
+
+func resourceClouddeployDeliveryPipelineResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location for the resource",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the `DeliveryPipeline`. Format is `[a-z]([a-z0-9-]{0,61}[a-z0-9])?`.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the `DeliveryPipeline`. Max length is 255 characters.",
+			},
+
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
+			},
+
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.",
+			},
+
+			"project": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      "The project for the resource",
+			},
+
+			"serial_pipeline": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "SerialPipeline defines a sequential set of stages for a `DeliveryPipeline`.",
+				MaxItems:    1,
+				Elem:        ClouddeployDeliveryPipelineSerialPipelineSchema(),
+			},
+
+			"suspended": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When suspended, no new releases or rollouts can be created, but in-progress ones will complete.",
+			},
+
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "User annotations. These attributes can only be set and used by the user, and not by Google Cloud Deploy. See https://google.aip.dev/128#annotations for more details such as format and size limitations.\n\n**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.\nPlease refer to the field `effective_annotations` for all of the annotations present on the resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"condition": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Output only. Information around the state of the Delivery Pipeline.",
+				Elem:        ClouddeployDeliveryPipelineConditionSchema(),
+			},
+
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. Time at which the pipeline was created.",
+			},
+
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.",
+			},
+
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Labels are attributes that can be set and used by both the user and by Google Cloud Deploy. Labels must meet the following constraints: * Keys and values can contain only lowercase letters, numeric characters, underscores, and dashes. * All characters must use UTF-8 encoding, and international characters are allowed. * Keys must start with a lowercase letter or international character. * Each resource is limited to a maximum of 64 labels. Both keys and values are additionally constrained to be <= 128 bytes.\n\n**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.\nPlease refer to the field `effective_labels` for all of the labels present on the resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"terraform_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of labels configured directly on the resource and default labels configured on the provider.",
+			},
+
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. Unique identifier of the `DeliveryPipeline`.",
+			},
+
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. Most recent time at which the pipeline was updated.",
+			},
+		},
+	}
+}
+
+func ResourceClouddeployDeliveryPipelineUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceClouddeployDeployPolicy() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceClouddeployDeployPolicyResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceClouddeployDeployPolicyUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -503,6 +512,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -995,18 +1010,7 @@ func flattenClouddeployDeployPolicyAnnotations(v interface{}, d *schema.Resource
 }
 
 func flattenClouddeployDeployPolicyLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenClouddeployDeployPolicyEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1680,18 +1684,7 @@ func flattenClouddeployDeployPolicyEffectiveAnnotations(v interface{}, d *schema
 }
 
 func flattenClouddeployDeployPolicyTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenClouddeployDeployPolicyEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2444,3 +2437,409 @@ func ResourceClouddeployDeployPolicyFlatten(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceClouddeployDeployPolicyResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location for the resource`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Name of the 'DeployPolicy'.`,
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Rules to apply. At least one rule must be present.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rollout_restriction": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Rollout restrictions.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `ID of the rule. This id must be unique in the 'DeployPolicy' resource to which this rule belongs. The format is 'a-z{0,62}'.`,
+									},
+									"actions": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Rollout actions to be restricted as part of the policy. If left empty, all actions will be restricted. Possible values: ["ADVANCE", "APPROVE", "CANCEL", "CREATE", "IGNORE_JOB", "RETRY_JOB", "ROLLBACK", "TERMINATE_JOBRUN"]`,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidateEnum([]string{"ADVANCE", "APPROVE", "CANCEL", "CREATE", "IGNORE_JOB", "RETRY_JOB", "ROLLBACK", "TERMINATE_JOBRUN"}),
+										},
+									},
+									"invokers": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `What invoked the action. If left empty, all invoker types will be restricted. Possible values: ["USER", "DEPLOY_AUTOMATION"]`,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidateEnum([]string{"USER", "DEPLOY_AUTOMATION"}),
+										},
+									},
+									"time_windows": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Time window within which actions are restricted.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"time_zone": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The time zone in IANA format IANA Time Zone Database (e.g. America/New_York).`,
+												},
+												"one_time_windows": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `One-time windows within which actions are restricted.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"end_date": {
+																Type:        schema.TypeList,
+																Required:    true,
+																Description: `End date.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"day": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Day of a month. Must be from 1 to 31 and valid for the year and month.`,
+																		},
+																		"month": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Month of a year. Must be from 1 to 12.`,
+																		},
+																		"year": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Year of the date. Must be from 1 to 9999.`,
+																		},
+																	},
+																},
+															},
+															"end_time": {
+																Type:        schema.TypeList,
+																Required:    true,
+																Description: `End time (exclusive). You may use 24:00 for the end of the day.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"hours": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Hours of a day in 24 hour format. Must be greater than or equal to 0 and typically must be less than or equal to 23. An API may choose to allow the value "24:00:00" for scenarios like business closing time.`,
+																		},
+																		"minutes": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Minutes of an hour. Must be greater than or equal to 0 and less than or equal to 59.`,
+																		},
+																		"nanos": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Fractions of seconds, in nanoseconds. Must be greater than or equal to 0 and less than or equal to 999,999,999.`,
+																		},
+																		"seconds": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Seconds of a minute. Must be greater than or equal to 0 and typically must be less than or equal to 59. An API may allow the value 60 if it allows leap-seconds.`,
+																		},
+																	},
+																},
+															},
+															"start_date": {
+																Type:        schema.TypeList,
+																Required:    true,
+																Description: `Start date.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"day": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Day of a month. Must be from 1 to 31 and valid for the year and month, or 0 to specify a year by itself or a year and month where the day isn't significant.`,
+																		},
+																		"month": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Month of a year. Must be from 1 to 12, or 0 to specify a year without a month and day.`,
+																		},
+																		"year": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Year of the date. Must be from 1 to 9999, or 0 to specify a date without a year.`,
+																		},
+																	},
+																},
+															},
+															"start_time": {
+																Type:        schema.TypeList,
+																Required:    true,
+																Description: `Start time (inclusive). Use 00:00 for the beginning of the day.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"hours": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Hours of a day in 24 hour format. Must be greater than or equal to 0 and typically must be less than or equal to 23. An API may choose to allow the value "24:00:00" for scenarios like business closing time.`,
+																		},
+																		"minutes": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Minutes of an hour. Must be greater than or equal to 0 and less than or equal to 59.`,
+																		},
+																		"nanos": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Fractions of seconds, in nanoseconds. Must be greater than or equal to 0 and less than or equal to 999,999,999.`,
+																		},
+																		"seconds": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Seconds of a minute. Must be greater than or equal to 0 and typically must be less than or equal to 59. An API may allow the value 60 if it allows leap-seconds.`,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"weekly_windows": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Recurring weekly windows within which actions are restricted.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"days_of_week": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `Days of week. If left empty, all days of the week will be included. Possible values: ["MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"]`,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidateEnum([]string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}),
+																},
+															},
+															"end_time": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `End time (exclusive). Use 24:00 to indicate midnight. If you specify endTime you must also specify startTime. If left empty, this will block for the entire day for the days specified in daysOfWeek.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"hours": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Hours of a day in 24 hour format. Must be greater than or equal to 0 and typically must be less than or equal to 23. An API may choose to allow the value "24:00:00" for scenarios like business closing time.`,
+																		},
+																		"minutes": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Minutes of an hour. Must be greater than or equal to 0 and less than or equal to 59.`,
+																		},
+																		"nanos": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Fractions of seconds, in nanoseconds. Must be greater than or equal to 0 and less than or equal to 999,999,999.`,
+																		},
+																		"seconds": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Seconds of a minute. Must be greater than or equal to 0 and typically must be less than or equal to 59. An API may allow the value 60 if it allows leap-seconds.`,
+																		},
+																	},
+																},
+															},
+															"start_time": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `Start time (inclusive). Use 00:00 for the beginning of the day. If you specify startTime you must also specify endTime. If left empty, this will block for the entire day for the days specified in daysOfWeek.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"hours": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Hours of a day in 24 hour format. Must be greater than or equal to 0 and typically must be less than or equal to 23. An API may choose to allow the value "24:00:00" for scenarios like business closing time.`,
+																		},
+																		"minutes": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Minutes of an hour. Must be greater than or equal to 0 and less than or equal to 59.`,
+																		},
+																		"nanos": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Fractions of seconds, in nanoseconds. Must be greater than or equal to 0 and less than or equal to 999,999,999.`,
+																		},
+																		"seconds": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: `Seconds of a minute. Must be greater than or equal to 0 and typically must be less than or equal to 59. An API may allow the value 60 if it allows leap-seconds.`,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"selectors": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Selected resources to which the policy will be applied. At least one selector is required. If one selector matches the resource the policy applies. For example, if there are two selectors and the action being attempted matches one of them, the policy will apply to that action.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delivery_pipeline": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Contains attributes about a delivery pipeline.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `ID of the DeliveryPipeline. The value of this field could be one of the following:
+- The last segment of a pipeline name
+- "*", all delivery pipelines in a location`,
+									},
+									"labels": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Optional:    true,
+										Description: `DeliveryPipeline labels.`,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"target": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Contains attributes about a target.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `ID of the 'Target'. The value of this field could be one of the following: * The last segment of a target name. It only needs the ID to determine which target is being referred to * "*", all targets in a location.`,
+									},
+									"labels": {
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Optional:    true,
+										Description: `Target labels.`,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `User annotations. These attributes can only be set and used by the user, and not by Cloud Deploy. Annotations must meet the following constraints: * Annotations are key/value pairs. * Valid annotation keys have two segments: an optional prefix and name, separated by a slash ('/'). * The name segment is required and must be 63 characters or less, beginning and ending with an alphanumeric character ('[a-z0-9A-Z]') with dashes ('-'), underscores ('_'), dots ('.'), and alphanumerics between. * The prefix is optional. If specified, the prefix must be a DNS subdomain: a series of DNS labels separated by dots('.'), not longer than 253 characters in total, followed by a slash ('/'). See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/#syntax-and-character-set for more details.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Description of the 'DeployPolicy'. Max length is 255 characters.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Labels are attributes that can be set and used by both the user and by Cloud Deploy. Labels must meet the following constraints: * Keys and values can contain only lowercase letters, numeric characters, underscores, and dashes. * All characters must use UTF-8 encoding, and international characters are allowed. * Keys must start with a lowercase letter or international character. * Each resource is limited to a maximum of 64 labels. Both keys and values are additionally constrained to be <= 63 characters.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"suspended": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `When suspended, the policy will not prevent actions from occurring, even if the action violates the policy.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Time at which the DeployPolicy was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The weak etag of the 'DeployPolicy' resource. This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Unique identifier of the 'DeployPolicy'.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. Time at which the DeployPolicy was updated.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceClouddeployDeployPolicyUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
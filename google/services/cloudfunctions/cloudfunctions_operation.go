@@ -44,7 +44,7 @@ func CloudFunctionsOperationWait(config *transport_tpg.Config, op *cloudfunction
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
 func IsCloudFunctionsSourceCodeError(err error) (bool, string) {
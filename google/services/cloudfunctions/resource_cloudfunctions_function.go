@@ -715,6 +715,7 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 		},
 		Timeout:              d.Timeout(schema.TimeoutCreate),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{IsCloudFunctionsSourceCodeError},
+		RetryOn:              config.RetryOn,
 	})
 	if rerr != nil {
 		return rerr
@@ -766,10 +767,10 @@ func resourceCloudFunctionsRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("ingress_settings", function.IngressSettings); err != nil {
 		return fmt.Errorf("Error setting ingress_settings: %s", err)
 	}
-	if err := tpgresource.SetLabels(function.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(function.Labels, d, "labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
-	if err := tpgresource.SetLabels(function.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(function.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", function.Labels); err != nil {
@@ -1070,6 +1071,7 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 					d.Timeout(schema.TimeoutUpdate))
 			},
 			Timeout: d.Timeout(schema.TimeoutUpdate),
+			RetryOn: config.RetryOn,
 		})
 		if rerr != nil {
 			return fmt.Errorf("Error while updating cloudfunction configuration: %s", rerr)
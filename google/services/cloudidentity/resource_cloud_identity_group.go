@@ -719,7 +719,7 @@ func flattenCloudIdentityGroupUpdateTime(v interface{}, d *schema.ResourceData,
 }
 
 func flattenCloudIdentityGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func expandCloudIdentityGroupGroupKey(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
@@ -126,7 +126,7 @@ func testAccCloudIdentityGroup_cloudIdentityGroupsBasicExampleTest(t *testing.T)
 				ResourceName:            "google_cloud_identity_group.cloud_identity_group_basic",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"initial_group_config"},
+				ImportStateVerifyIgnore: []string{"initial_group_config", "labels"},
 			},
 		},
 	})
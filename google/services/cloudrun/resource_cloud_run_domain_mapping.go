@@ -121,7 +121,7 @@ func ResourceCloudRunDomainMapping() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -129,6 +129,11 @@ func ResourceCloudRunDomainMapping() *schema.Resource {
 				Upgrade: ResourceCloudRunDomainMappingUpgradeV0,
 				Version: 0,
 			},
+			{
+				Type:    resourceCloudRunDomainMappingResourceV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceCloudRunDomainMappingUpgradeV1,
+				Version: 1,
+			},
 		},
 		CustomizeDiff: customdiff.All(
 			hasMetadata,
@@ -261,6 +266,13 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 							Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"terraform_annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"effective_labels": {
 							Type:        schema.TypeMap,
 							Computed:    true,
@@ -876,18 +888,7 @@ func flattenCloudRunDomainMappingMetadata(v interface{}, d *schema.ResourceData,
 	return []interface{}{transformed}
 }
 func flattenCloudRunDomainMappingMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("metadata.0.labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "metadata.0.labels", config)
 }
 
 func flattenCloudRunDomainMappingMetadataGeneration(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -939,18 +940,7 @@ func flattenCloudRunDomainMappingMetadataAnnotations(v interface{}, d *schema.Re
 }
 
 func flattenCloudRunDomainMappingMetadataTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("metadata.0.terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "metadata.0.terraform_labels", config)
 }
 
 func flattenCloudRunDomainMappingMetadataEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1435,3 +1425,248 @@ func ResourceCloudRunDomainMappingFlatten(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+func resourceCloudRunDomainMappingResourceV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the cloud run instance. eg us-central1`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Name should be a [verified](https://support.google.com/webmasters/answer/9008080) domain`,
+			},
+			"spec": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The spec for this DomainMapping.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"route_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+							Description: `The name of the Cloud Run Service that this DomainMapping applies to.
+The route must exist.`,
+						},
+						"certificate_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"NONE", "AUTOMATIC", ""}),
+							Description:  `The mode of the certificate. Default value: "AUTOMATIC" Possible values: ["NONE", "AUTOMATIC"]`,
+							Default:      "AUTOMATIC",
+						},
+						"force_override": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Description: `If set, the mapping will override any mapping set before this spec was set.
+It is recommended that the user leaves this empty to receive an error
+warning about a potential conflict and only set it once the respective UI
+has given such a warning.`,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Metadata associated with this DomainMapping.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `In Cloud Run the namespace must be equal to either the
+project ID or project number.`,
+						},
+						"annotations": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Description: `Annotations is a key value map stored with a resource that
+may be set by external tools to store and retrieve arbitrary metadata.
+More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations
+
+**Note**: The Cloud Run API may add additional annotations that were not provided in your config.
+If terraform plan shows a diff where a server-side annotation is added, you can add it to your config
+or apply the lifecycle.ignore_changes rule to the metadata.0.annotations field.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Description: `Map of string keys and values that can be used to organize and categorize
+(scope and select) objects. May match selectors of replication controllers
+and routes.
+More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"effective_annotations": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"effective_labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							ForceNew:    true,
+							Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"generation": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `A sequence number representing a specific generation of the desired state.`,
+						},
+						"resource_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `An opaque value that represents the internal version of this object that
+can be used by clients to determine when objects have changed. May be used
+for optimistic concurrency, change detection, and the watch operation on a
+resource or set of resources. They may only be valid for a
+particular resource or set of resources.
+
+More info:
+https://git.k8s.io/community/contributors/devel/api-conventions.md#concurrency-control-and-consistency`,
+						},
+						"self_link": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `SelfLink is a URL representing this object.`,
+						},
+						"terraform_labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							ForceNew: true,
+							Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `UID is a unique id generated by the server on successful creation of a resource and is not
+allowed to change on PUT operations.
+
+More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names#uids`,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The current status of the DomainMapping.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_records": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `The resource records required to configure this domain mapping. These
+records must be added to the domain's DNS configuration in order to
+serve the application via this domain mapping.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"A", "AAAA", "CNAME", ""}),
+										Description:  `Resource record type. Example: 'AAAA'. Possible values: ["A", "AAAA", "CNAME"]`,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Relative name of the object affected by this record. Only applicable for
+'CNAME' records. Example: 'www'.`,
+									},
+									"rrdata": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Data for this record. Values vary by record type, as defined in RFC 1035
+(section 5) and RFC 1034 (section 3.6.1).`,
+									},
+								},
+							},
+						},
+						"conditions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: `Array of observed DomainMappingConditions, indicating the current state
+of the DomainMapping.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Human readable message indicating details about the current status.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `One-word CamelCase reason for the condition's current status.`,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Status of the condition, one of True, False, Unknown.`,
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Type of domain mapping condition.`,
+									},
+								},
+							},
+						},
+						"mapped_route_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the route that the mapping currently points to.`,
+						},
+						"observed_generation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: `ObservedGeneration is the 'Generation' of the DomainMapping that
+was last processed by the controller.`,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceCloudRunDomainMappingUpgradeV1(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceCloudRunV2WorkerPool() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceCloudRunV2WorkerPoolResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceCloudRunV2WorkerPoolUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.SetAnnotationsDiff,
@@ -980,6 +989,12 @@ A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to n
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -1665,18 +1680,7 @@ func flattenCloudRunV2WorkerPoolGeneration(v interface{}, d *schema.ResourceData
 }
 
 func flattenCloudRunV2WorkerPoolLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenCloudRunV2WorkerPoolAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -3047,18 +3051,7 @@ func flattenCloudRunV2WorkerPoolEtag(v interface{}, d *schema.ResourceData, conf
 }
 
 func flattenCloudRunV2WorkerPoolTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenCloudRunV2WorkerPoolEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -4708,3 +4701,1011 @@ func ResourceCloudRunV2WorkerPoolFlatten(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+func resourceCloudRunV2WorkerPoolResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the cloud run worker pool`,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `Name of the WorkerPool.`,
+			},
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `The template used to create revisions for this WorkerPool.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"annotations": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Description: `Unstructured key value map that may be set by external tools to store and arbitrary metadata. They are not queryable and should be preserved when modifying objects.
+
+Cloud Run API v2 does not support annotations with 'run.googleapis.com', 'cloud.googleapis.com', 'serving.knative.dev', or 'autoscaling.knative.dev' namespaces, and they will be rejected.
+All system annotations in v1 now have a corresponding field in v2 WorkerPoolRevisionTemplate.
+
+This field follows Kubernetes annotations' namespacing, limits, and rules.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"containers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Holds the containers that define the unit of execution for this WorkerPool.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `URL of the Container image in Google Container Registry or Google Artifact Registry. More info: https://kubernetes.io/docs/concepts/containers/images`,
+									},
+									"args": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references are not supported in Cloud Run.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"command": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"depends_on": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Names of the containers that must start before this container.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"env": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Description: `List of environment variables to set in the container.`,
+										Elem:        cloudrunv2WorkerPoolTemplateContainersContainersEnvSchema(),
+										// Default schema.HashSchema is used.
+									},
+									"liveness_probe": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Periodic probe of container liveness. Container will be restarted if the probe fails.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"failure_threshold": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.`,
+													Default:     3,
+												},
+												"grpc": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. GRPC specifies an action involving a gRPC port. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number of the gRPC service. Number must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+															"service": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. Service is the name of the service to place in the gRPC HealthCheckRequest (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md ). If this is not specified, the default behavior is defined by gRPC`,
+															},
+														},
+													},
+												},
+												"http_get": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. HTTPGet specifies the http request to perform. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"http_headers": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `Optional. Custom headers to set in the request. HTTP allows repeated headers.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"port": {
+																			Type:        schema.TypeInt,
+																			Required:    true,
+																			Description: `Required. The header field name`,
+																		},
+																		"value": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Optional. The header field value`,
+																		},
+																	},
+																},
+															},
+															"path": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. Path to access on the HTTP server. Defaults to '/'.`,
+															},
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number to access on the container. Must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+														},
+													},
+												},
+												"initial_delay_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Number of seconds after the container has started before the probe is initiated. Defaults to 0 seconds. Minimum value is 0. Maximum value for liveness probe is 3600. Maximum value for startup probe is 240.`,
+													Default:     0,
+												},
+												"period_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1. Maximum value for liveness probe is 3600. Maximum value for startup probe is 240. Must be greater or equal than timeout_seconds.`,
+													Default:     10,
+												},
+												"tcp_socket": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. TCPSocket specifies an action involving a TCP port. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number to access on the container. Must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+														},
+													},
+												},
+												"timeout_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. Maximum value is 3600. Must be smaller than period_seconds.`,
+													Default:     1,
+												},
+											},
+										},
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Name of the container specified as a DNS_LABEL.`,
+									},
+									"resources": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Optional:    true,
+										Description: `Compute Resource requirements by this container. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"limits": {
+													Type:        schema.TypeMap,
+													Computed:    true,
+													Optional:    true,
+													Description: `Only memory, CPU, and nvidia.com/gpu are supported. Use key 'cpu' for CPU limit, 'memory' for memory limit, 'nvidia.com/gpu' for gpu limit. Note: The only supported values for CPU are '1', '2', '4', '6', and '8'. Setting 4 CPU requires at least 2Gi of memory, setting 6 or more CPU requires at least 4Gi of memory. The values of the map is string form of the 'quantity' k8s type: https://github.com/kubernetes/kubernetes/blob/master/staging/src/k8s.io/apimachinery/pkg/api/resource/quantity.go`,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"startup_probe": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Startup probe of application within the container. All other probes are disabled if a startup probe is provided, until it succeeds. Container will not be added to service endpoints if the probe fails.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"failure_threshold": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.`,
+													Default:     3,
+												},
+												"grpc": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. GRPC specifies an action involving a gRPC port. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number of the gRPC service. Number must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+															"service": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. Service is the name of the service to place in the gRPC HealthCheckRequest (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md ). If this is not specified, the default behavior is defined by gRPC`,
+															},
+														},
+													},
+												},
+												"http_get": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. HTTPGet specifies the http request to perform. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"http_headers": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `Optional. Custom headers to set in the request. HTTP allows repeated headers.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"port": {
+																			Type:        schema.TypeInt,
+																			Required:    true,
+																			Description: `Required. The header field name`,
+																		},
+																		"value": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Optional. The header field value`,
+																		},
+																	},
+																},
+															},
+															"path": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Optional. Path to access on the HTTP server. Defaults to '/'.`,
+															},
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number to access on the container. Must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+														},
+													},
+												},
+												"initial_delay_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Number of seconds after the container has started before the probe is initiated. Defaults to 0 seconds. Minimum value is 0. Maximum value for liveness probe is 3600. Maximum value for startup probe is 240.`,
+													Default:     0,
+												},
+												"period_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1. Maximum value for liveness probe is 3600. Maximum value for startup probe is 240. Must be greater or equal than timeout_seconds.`,
+													Default:     10,
+												},
+												"tcp_socket": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Optional. TCPSocket specifies an action involving a TCP port. Exactly one of httpGet, tcpSocket, or grpc must be specified.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"port": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Optional. Port number to access on the container. Must be in the range 1 to 65535. If not specified, defaults to the exposed port of the container, which is the value of container.ports[0].containerPort.`,
+															},
+														},
+													},
+												},
+												"timeout_seconds": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Optional. Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. Maximum value is 3600. Must be smaller than period_seconds.`,
+													Default:     1,
+												},
+											},
+										},
+									},
+									"volume_mounts": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Volume to mount into the container's filesystem.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mount_path": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `Path within the container at which the volume should be mounted. Must not contain ':'. For Cloud SQL volumes, it can be left empty, or must otherwise be /cloudsql. All instances defined in the Volume will be available as /cloudsql/[instance]. For more information on Cloud SQL volumes, visit https://cloud.google.com/sql/docs/mysql/connect-run`,
+												},
+												"name": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `This must match the Name of a Volume.`,
+												},
+												"sub_path": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `Path within the volume from which the container's volume should be mounted.`,
+												},
+											},
+										},
+									},
+									"working_dir": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image.`,
+									},
+								},
+							},
+						},
+						"encryption_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `A reference to a customer managed encryption key (CMEK) to use to encrypt this container image. For more information, go to https://cloud.google.com/run/docs/securing/using-cmek`,
+						},
+						"encryption_key_revocation_action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"PREVENT_NEW", "SHUTDOWN", ""}),
+							Description:  `The action to take if the encryption key is revoked. Possible values: ["PREVENT_NEW", "SHUTDOWN"]`,
+						},
+						"encryption_key_shutdown_duration": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `If encryptionKeyRevocationAction is SHUTDOWN, the duration before shutting down all instances. The minimum increment is 1 hour.
+
+A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+						},
+						"gpu_zonal_redundancy_disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `True if GPU zonal redundancy is disabled on this revision.`,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Description: `Unstructured key value map that can be used to organize and categorize objects. User-provided labels are shared with Google's billing system, so they can be used to filter, or break down billing charges by team, component, environment, state, etc.
+For more information, visit https://docs.cloud.google.com/resource-manager/docs/creating-managing-labels or https://cloud.google.com/run/docs/configuring/labels.
+
+Cloud Run API v2 does not support labels with 'run.googleapis.com', 'cloud.googleapis.com', 'serving.knative.dev', or 'autoscaling.knative.dev' namespaces, and they will be rejected.
+All system labels in v1 now have a corresponding field in v2 WorkerPoolRevisionTemplate.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"node_selector": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Node Selector describes the hardware requirements of the resources.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accelerator": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The GPU to attach to an instance. See https://cloud.google.com/run/docs/configuring/services/gpu for configuring GPU.`,
+									},
+								},
+							},
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The unique name for the revision. If this field is omitted, it will be automatically generated based on the WorkerPool name.`,
+						},
+						"service_account": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Email address of the IAM service account associated with the revision of the WorkerPool. The service account represents the identity of the running revision, and determines what permissions the revision has. If not provided, the revision will use the project's default service account.`,
+						},
+						"volumes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A list of Volumes to make available to containers.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Volume's name.`,
+									},
+									"cloud_sql_instance": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `For Cloud SQL volumes, contains the specific instances that should be mounted. Visit https://cloud.google.com/sql/docs/mysql/connect-run for more information on how to connect Cloud SQL and Cloud Run.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instances": {
+													Type:        schema.TypeSet,
+													Optional:    true,
+													Description: `The Cloud SQL instance connection names, as can be found in https://console.cloud.google.com/sql/instances. Visit https://cloud.google.com/sql/docs/mysql/connect-run for more information on how to connect Cloud SQL and Cloud Run. Format: {project}:{location}:{instance}`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+													Set: schema.HashString,
+												},
+											},
+										},
+									},
+									"empty_dir": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Ephemeral storage used as a shared volume.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"medium": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidateEnum([]string{"MEMORY", "DISK", ""}),
+													Description:  `The different types of medium supported for EmptyDir. Default value: "MEMORY" Possible values: ["MEMORY", "DISK"]`,
+													Default:      "MEMORY",
+												},
+												"size_limit": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `Limit on the storage usable by this EmptyDir volume. The size limit is also applicable for memory medium. The maximum usage on memory medium EmptyDir would be the minimum value between the SizeLimit specified here and the sum of memory limits of all containers in a pod. This field's values are of the 'Quantity' k8s type: https://kubernetes.io/docs/reference/kubernetes-api/common-definitions/quantity/. The default is nil which means that the limit is undefined. More info: https://kubernetes.io/docs/concepts/storage/volumes/#emptydir.`,
+												},
+											},
+										},
+									},
+									"gcs": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Cloud Storage bucket mounted as a volume using GCSFuse. This feature is only supported in the gen2 execution environment.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `GCS Bucket name`,
+												},
+												"mount_options": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Description: `A list of flags to pass to the gcsfuse command for configuring this volume.
+Flags should be passed without leading dashes.`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"read_only": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `If true, mount the GCS bucket as read-only`,
+												},
+											},
+										},
+									},
+									"nfs": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Represents an NFS mount.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"path": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `Path that is exported by the NFS server.`,
+												},
+												"server": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `Hostname or IP address of the NFS server`,
+												},
+												"read_only": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `If true, mount the NFS volume as read only`,
+												},
+											},
+										},
+									},
+									"secret": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Secret represents a secret that should populate this volume. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"secret": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the secret in Cloud Secret Manager. Format: {secret} if the secret is in the same project. projects/{project}/secrets/{secret} if the secret is in a different project.`,
+												},
+												"default_mode": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: `Integer representation of mode bits to use on created files by default. Must be a value between 0000 and 0777 (octal), defaulting to 0444. Directories within the path are not affected by this setting.`,
+												},
+												"items": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `If unspecified, the volume will expose a file whose name is the secret, relative to VolumeMount.mount_path. If specified, the key will be used as the version to fetch from Cloud Secret Manager and the path will be the name of the file exposed in the volume. When items are defined, they must specify a path and a version.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `The relative path of the secret in the container.`,
+															},
+															"mode": {
+																Type:        schema.TypeInt,
+																Optional:    true,
+																Description: `Integer octal mode bits to use on this file, must be a value between 01 and 0777 (octal). If 0 or not set, the Volume's default mode will be used.`,
+															},
+															"version": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `The Cloud Secret Manager secret version. Can be 'latest' for the latest value or an integer for a specific version`,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"vpc_access": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `VPC Access configuration to use for this Revision. For more information, visit https://cloud.google.com/run/docs/configuring/connecting-vpc.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"connector": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `VPC Access connector name. Format: projects/{project}/locations/{location}/connectors/{connector}, where {project} can be project id or number.`,
+									},
+									"egress": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"ALL_TRAFFIC", "PRIVATE_RANGES_ONLY", ""}),
+										Description:  `Traffic VPC egress settings. Possible values: ["ALL_TRAFFIC", "PRIVATE_RANGES_ONLY"]`,
+									},
+									"network_interfaces": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Direct VPC egress settings. Currently only single network interface is supported.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"network": {
+													Type:     schema.TypeString,
+													Computed: true,
+													Optional: true,
+													Description: `The VPC network that the Cloud Run resource will be able to send traffic to. At least one of network or subnetwork must be specified. If both
+network and subnetwork are specified, the given VPC subnetwork must belong to the given VPC network. If network is not specified, it will be
+looked up from the subnetwork.`,
+												},
+												"subnetwork": {
+													Type:     schema.TypeString,
+													Computed: true,
+													Optional: true,
+													Description: `The VPC subnetwork that the Cloud Run resource will get IPs from. At least one of network or subnetwork must be specified. If both
+network and subnetwork are specified, the given VPC subnetwork must belong to the given VPC network. If subnetwork is not specified, the
+subnetwork with the same name with the network will be used.`,
+												},
+												"tags": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `Network tags applied to this Cloud Run WorkerPool.`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Unstructured key value map that may be set by external tools to store and arbitrary metadata. They are not queryable and should be preserved when modifying objects.
+
+Cloud Run API v2 does not support annotations with 'run.googleapis.com', 'cloud.googleapis.com', 'serving.knative.dev', or 'autoscaling.knative.dev' namespaces, and they will be rejected in new resources.
+All system annotations in v1 now have a corresponding field in v2 WorkerPool.
+
+This field follows Kubernetes annotations' namespacing, limits, and rules.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"binary_authorization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Settings for the Binary Authorization feature.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"breakglass_justification": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `If present, indicates to use Breakglass using this justification. If useDefault is False, then it must be empty. For more information on breakglass, see https://cloud.google.com/binary-authorization/docs/using-breakglass`,
+						},
+						"policy": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Description:   `The path to a binary authorization policy. Format: projects/{project}/platforms/cloudRun/{policy-name}`,
+							ConflictsWith: []string{},
+						},
+						"use_default": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Description:   `If True, indicates to use the default project's binary authorization policy. If False, binary authorization will be disabled.`,
+							ConflictsWith: []string{},
+						},
+					},
+				},
+			},
+			"client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Arbitrary identifier for the API client.`,
+			},
+			"client_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Arbitrary version identifier for the API client.`,
+			},
+			"custom_audiences": {
+				Type:       schema.TypeList,
+				Optional:   true,
+				Deprecated: "`custom_audiences` is deprecated since it is not applicable to WorkerPool resource and will be removed in a future major release.",
+				Description: `One or more custom audiences that you want this worker pool to support. Specify each custom audience as the full URL in a string. The custom audiences are encoded in the token and used to authenticate requests.
+For more information, see https://cloud.google.com/run/docs/configuring/custom-audiences.`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `User-provided description of the WorkerPool. This field currently has a 512-character limit.`,
+			},
+			"instance_splits": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Specifies how to distribute instances over a collection of Revisions belonging to the WorkerPool. If instance split is empty or not provided, defaults to 100% instances assigned to the latest Ready Revision.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Optional:    true,
+							Description: `Specifies percent of the instance split to this Revision. This defaults to zero if unspecified.`,
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Revision to which to assign this portion of instances, if split allocation is by revision.`,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"INSTANCE_SPLIT_ALLOCATION_TYPE_LATEST", "INSTANCE_SPLIT_ALLOCATION_TYPE_REVISION", ""}),
+							Description:  `The allocation type for this instance split. Possible values: ["INSTANCE_SPLIT_ALLOCATION_TYPE_LATEST", "INSTANCE_SPLIT_ALLOCATION_TYPE_REVISION"]`,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Unstructured key value map that can be used to organize and categorize objects. User-provided labels are shared with Google's billing system, so they can be used to filter, or break down billing charges by team, component,
+environment, state, etc. For more information, visit https://docs.cloud.google.com/resource-manager/docs/creating-managing-labels or https://cloud.google.com/run/docs/configuring/labels.
+
+Cloud Run API v2 does not support labels with  'run.googleapis.com', 'cloud.googleapis.com', 'serving.knative.dev', or 'autoscaling.knative.dev' namespaces, and they will be rejected.
+All system labels in v1 now have a corresponding field in v2 WorkerPool.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"launch_stage": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"UNIMPLEMENTED", "PRELAUNCH", "EARLY_ACCESS", "ALPHA", "BETA", "GA", "DEPRECATED", ""}),
+				Description: `The launch stage as defined by [Google Cloud Platform Launch Stages](https://cloud.google.com/products#product-launch-stages). Cloud Run supports ALPHA, BETA, and GA.
+If no value is specified, GA is assumed. Set the launch stage to a preview stage on input to allow use of preview features in that stage. On read (or output), describes whether the resource uses preview features.
+
+For example, if ALPHA is provided as input, but only BETA and GA-level features are used, this field will be BETA on output. Possible values: ["UNIMPLEMENTED", "PRELAUNCH", "EARLY_ACCESS", "ALPHA", "BETA", "GA", "DEPRECATED"]`,
+			},
+			"scaling": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Scaling settings that apply to the worker pool.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manual_instance_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The total number of instances in manual scaling mode.`,
+						},
+						"max_instance_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The maximum count of instances distributed among revisions based on the specified instance split percentages.`,
+						},
+						"min_instance_count": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The minimum count of instances distributed among revisions based on the specified instance split percentages.`,
+						},
+						"scaling_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"AUTOMATIC", "MANUAL", ""}),
+							Description:  `The scaling mode for the worker pool. It defaults to MANUAL. Possible values: ["AUTOMATIC", "MANUAL"]`,
+						},
+					},
+				},
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The Conditions of all other associated sub-resources. They contain additional diagnostics information in case the WorkerPool does not reach its Serving state. See comments in reconciling for additional information on reconciliation process in Cloud Run.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A reason for the execution condition.`,
+						},
+						"last_transition_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Last time the condition transitioned from one status to another.
+
+A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to nine fractional digits. Examples: "2014-10-02T15:01:23Z" and "2014-10-02T15:01:23.045123456Z".`,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Human readable message indicating details about the current status.`,
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A common (workerPool-level) reason for this condition.`,
+						},
+						"revision_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A reason for the revision condition.`,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `How to interpret failures of this condition, one of Error, Warning, Info`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `State of the condition.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `type is used to communicate the status of the reconciliation process. See also: https://github.com/knative/serving/blob/main/docs/spec/errors.md#error-conditions-and-reporting Types common to all resources include: * "Ready": True when the Resource is ready.`,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time.`,
+			},
+			"creator": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Email address of the authenticated creator.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The deletion time.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A system-generated fingerprint for this version of the resource. May be used to detect modification conflict during updates.`,
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `For a deleted resource, the time after which it will be permanently deleted.`,
+			},
+			"generation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A number that monotonically increases every time the user modifies the desired state. Please note that unlike v1, this is an int64 value. As with most Google APIs, its JSON representation will be a string instead of an integer.`,
+			},
+			"instance_split_statuses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Detailed status information for corresponding instance splits. See comments in reconciling for additional information on reconciliation process in Cloud Run.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Specifies percent of the instance split to this Revision.`,
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Revision to which this instance split is assigned.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The allocation type for this instance split.`,
+						},
+					},
+				},
+			},
+			"last_modifier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Email address of the last authenticated modifier.`,
+			},
+			"latest_created_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Name of the last created revision. See comments in reconciling for additional information on reconciliation process in Cloud Run.`,
+			},
+			"latest_ready_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Name of the latest revision that is serving traffic. See comments in reconciling for additional information on reconciliation process in Cloud Run.`,
+			},
+			"observed_generation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The generation of this WorkerPool currently serving traffic. See comments in reconciling for additional information on reconciliation process in Cloud Run. Please note that unlike v1, this is an int64 value. As with most Google APIs, its JSON representation will be a string instead of an integer.`,
+			},
+			"reconciling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: `Returns true if the WorkerPool is currently being acted upon by the system to bring it into the desired state.
+
+When a new WorkerPool is created, or an existing one is updated, Cloud Run will asynchronously perform all necessary steps to bring the WorkerPool to the desired serving state. This process is called reconciliation. While reconciliation is in process, observedGeneration, latest_ready_revison, trafficStatuses, and uri will have transient values that might mismatch the intended state: Once reconciliation is over (and this field is false), there are two possible outcomes: reconciliation succeeded and the serving state matches the WorkerPool, or there was an error, and reconciliation failed. This state can be found in terminalCondition.state.
+
+If reconciliation succeeded, the following fields will match: traffic and trafficStatuses, observedGeneration and generation, latestReadyRevision and latestCreatedRevision.
+
+If reconciliation failed, trafficStatuses, observedGeneration, and latestReadyRevision will have the state of the last serving revision, or empty for newly created WorkerPools. Additional information on the failure can be found in terminalCondition and conditions.`,
+			},
+			"terminal_condition": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The Condition of this WorkerPool, containing its readiness status, and detailed error information in case it did not reach a serving state. See comments in reconciling for additional information on reconciliation process in Cloud Run.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A reason for the execution condition.`,
+						},
+						"last_transition_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Last time the condition transitioned from one status to another.`,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Human readable message indicating details about the current status.`,
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A common (workerPool-level) reason for this condition.`,
+						},
+						"revision_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A reason for the revision condition.`,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `How to interpret failures of this condition, one of Error, Warning, Info`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `State of the condition.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `type is used to communicate the status of the reconciliation process. See also: https://github.com/knative/serving/blob/main/docs/spec/errors.md#error-conditions-and-reporting Types common to all resources include: * "Ready": True when the Resource is ready.`,
+						},
+					},
+				},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Server assigned unique identifier for the trigger. The value is a UUID4 string and guaranteed to remain unchanged until the resource is deleted.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The last-modified time.`,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: `Whether Terraform will be prevented from destroying the service. Defaults to true.
+When a'terraform destroy' or 'terraform apply' would delete the service,
+the command will fail if this field is not set to false in Terraform state.
+When the field is set to true or unset in Terraform state, a 'terraform apply'
+or 'terraform destroy' that would delete the WorkerPool will fail.
+When the field is set to false, deleting the WorkerPool is allowed.`,
+				Default: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceCloudRunV2WorkerPoolUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -66,7 +66,7 @@ func RunAdminV2OperationWaitTimeWithResponse(config *transport_tpg.Config, op *r
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
 	return json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), response)
@@ -80,5 +80,5 @@ func RunAdminV2OperationWaitTime(config *transport_tpg.Config, op *run.GoogleLon
 	if err != nil {
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
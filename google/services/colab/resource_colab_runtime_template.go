@@ -1004,18 +1004,7 @@ func flattenColabRuntimeTemplateNetworkSpecSubnetwork(v interface{}, d *schema.R
 }
 
 func flattenColabRuntimeTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenColabRuntimeTemplateIdleShutdownConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1181,18 +1170,7 @@ func flattenColabRuntimeTemplateSoftwareConfigColabImageReleaseName(v interface{
 }
 
 func flattenColabRuntimeTemplateTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenColabRuntimeTemplateEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -17,7 +17,6 @@
 package composer
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
@@ -26,24 +25,10 @@ import (
 	"google.golang.org/api/composer/v1"
 )
 
-type ComposerOperationWaiter struct {
-	Service *composer.ProjectsLocationsService
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *ComposerOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	return w.Service.Operations.Get(w.Op.Name).Do()
-}
-
 func ComposerOperationWaitTime(config *transport_tpg.Config, op *composer.Operation, project, activity, userAgent string, timeout time.Duration) error {
-	w := &ComposerOperationWaiter{
-		Service: NewClient(config, userAgent).Projects.Locations,
-	}
-	if err := w.SetOp(op); err != nil {
+	w, err := tpgresource.NewOperationWaiter(config, tpgresource.AipOperation, op, transport_tpg.BaseUrl(Product, config), project, userAgent)
+	if err != nil {
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
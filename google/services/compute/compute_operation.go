@@ -18,161 +18,94 @@ package compute
 
 import (
 	"bytes"
-	"context"
-	"errors"
 	"fmt"
 	"io"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
-type ComputeOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	Op        map[string]interface{}
-	Context   context.Context
-	Project   string
-	Parent    string
-}
-
-func (w *ComputeOperationWaiter) State() string {
-	if w == nil || w.Op == nil {
-		return "<nil>"
-	}
-
-	status, _ := w.Op["status"].(string)
-	return status
-}
-
-func (w *ComputeOperationWaiter) Error() error {
-	if w != nil && w.Op != nil {
-		if opErr, ok := w.Op["error"]; ok && opErr != nil {
-			errMap, ok := opErr.(map[string]interface{})
-			if !ok {
-				return fmt.Errorf("operation error: %v", opErr)
-			}
-			return ComputeOperationError(errMap)
+// ComputeOperation is the kind of Compute Engine operations, which are
+// zonal, regional or global, and can't be cancelled.
+var ComputeOperation = &tpgresource.OperationKind{
+	Name:          "compute",
+	PendingStates: []string{"PENDING", "RUNNING"},
+	TargetStates:  []string{"DONE"},
+	State:         tpgresource.OperationStatus,
+	Error: func(op map[string]interface{}) error {
+		opErr, ok := op["error"]
+		if !ok || opErr == nil {
+			return nil
 		}
-	}
-	return nil
-}
-
-func (w *ComputeOperationWaiter) IsRetryable(err error) bool {
-	if oe, ok := err.(ComputeOperationError); ok {
-		if rawErrors, ok := oe["errors"].([]interface{}); ok {
-			for _, rawErr := range rawErrors {
-				if errMap, ok := rawErr.(map[string]interface{}); ok {
-					if code, _ := errMap["code"].(string); code == "RESOURCE_NOT_READY" {
-						return true
+		errMap, ok := opErr.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("operation error: %v", opErr)
+		}
+		return ComputeOperationError(errMap)
+	},
+	IsRetryable: func(err error) bool {
+		if oe, ok := err.(ComputeOperationError); ok {
+			if rawErrors, ok := oe["errors"].([]interface{}); ok {
+				for _, rawErr := range rawErrors {
+					if errMap, ok := rawErr.(map[string]interface{}); ok {
+						if code, _ := errMap["code"].(string); code == "RESOURCE_NOT_READY" {
+							return true
+						}
 					}
 				}
 			}
 		}
-	}
-	return false
+		return false
+	},
+	Url: computeOperationUrl,
+	Progress: func(op map[string]interface{}) tpgresource.OperationProgress {
+		p := tpgresource.OperationProgress{Percent: -1}
+		p.Stage, _ = op["statusMessage"].(string)
+		if v, ok := op["progress"].(float64); ok && v > 0 {
+			p.Percent = int(v)
+		}
+		return p
+	},
 }
 
-func (w *ComputeOperationWaiter) SetOp(op interface{}) error {
-	var ok bool
-	w.Op, ok = op.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("Unable to set operation. Bad type!")
-	}
-	return nil
+func init() {
+	tpgresource.RegisterOperationKind(ComputeOperation)
 }
 
-func (w *ComputeOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil || w.Op == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	if w.Context != nil {
-		select {
-		case <-w.Context.Done():
-			log.Println("[WARN] request has been cancelled early")
-			return w.Op, errors.New("unable to finish polling, context has been cancelled")
-		default:
-			// default must be here to keep the previous case from blocking
-		}
-	}
-	opName, _ := w.Op["name"].(string)
-	var url string
+// computeOperationUrl returns the URL of a zonal, regional or global
+// operation, or of an organization operation if the waiter has a Parent.
+func computeOperationUrl(w *tpgresource.OperationWaiter) string {
+	opName := w.OpName()
 	if zone, ok := w.Op["zone"].(string); ok && zone != "" {
 		zoneName := tpgresource.GetResourceNameFromSelfLink(zone)
-		url = fmt.Sprintf("%sprojects/%s/zones/%s/operations/%s", transport_tpg.BaseUrl(Product, w.Config), w.Project, zoneName, opName)
-	} else if region, ok := w.Op["region"].(string); ok && region != "" {
+		return fmt.Sprintf("%sprojects/%s/zones/%s/operations/%s", w.BaseUrl, w.Project, zoneName, opName)
+	}
+	if region, ok := w.Op["region"].(string); ok && region != "" {
 		regionName := tpgresource.GetResourceNameFromSelfLink(region)
-		url = fmt.Sprintf("%sprojects/%s/regions/%s/operations/%s", transport_tpg.BaseUrl(Product, w.Config), w.Project, regionName, opName)
-	} else if w.Parent != "" {
-		url = fmt.Sprintf("%slocations/global/operations/%s?parentId=%s", transport_tpg.BaseUrl(Product, w.Config), opName, w.Parent)
-	} else {
-		url = fmt.Sprintf("%sprojects/%s/global/operations/%s", transport_tpg.BaseUrl(Product, w.Config), w.Project, opName)
+		return fmt.Sprintf("%sprojects/%s/regions/%s/operations/%s", w.BaseUrl, w.Project, regionName, opName)
 	}
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    w.Config,
-		Method:    "GET",
-		Project:   w.Project,
-		RawURL:    url,
-		UserAgent: w.UserAgent,
-	})
-}
-
-func (w *ComputeOperationWaiter) OpName() string {
-	if w == nil || w.Op == nil {
-		return "<nil> Compute Op"
+	if w.Parent != "" {
+		return fmt.Sprintf("%slocations/global/operations/%s?parentId=%s", w.BaseUrl, opName, w.Parent)
 	}
-
-	name, _ := w.Op["name"].(string)
-	return name
-}
-
-func (w *ComputeOperationWaiter) PendingStates() []string {
-	return []string{"PENDING", "RUNNING"}
-}
-
-func (w *ComputeOperationWaiter) TargetStates() []string {
-	return []string{"DONE"}
+	return fmt.Sprintf("%sprojects/%s/global/operations/%s", w.BaseUrl, w.Project, opName)
 }
 
 func ComputeOperationWaitTime(config *transport_tpg.Config, res interface{}, project, activity, userAgent string, timeout time.Duration) error {
-	op, err := tpgresource.ConvertToMap(res)
+	w, err := tpgresource.NewOperationWaiter(config, ComputeOperation, res, transport_tpg.BaseUrl(Product, config), project, userAgent)
 	if err != nil {
 		return err
 	}
-
-	w := &ComputeOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-		Context:   config.Context,
-		Op:        op,
-		Project:   project,
-	}
-
-	if err := w.SetOp(op); err != nil {
-		return err
-	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
 func ComputeOrgOperationWaitTimeWithResponse(config *transport_tpg.Config, res interface{}, response *map[string]interface{}, parent, activity, userAgent string, timeout time.Duration) error {
-	op, err := tpgresource.ConvertToMap(res)
+	w, err := tpgresource.NewOperationWaiter(config, ComputeOperation, res, transport_tpg.BaseUrl(Product, config), "", userAgent)
 	if err != nil {
 		return err
 	}
-
-	w := &ComputeOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-		Op:        op,
-		Parent:    parent,
-	}
-
-	if err := w.SetOp(op); err != nil {
-		return err
-	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	w.Parent = parent
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
 	*response = w.Op
@@ -1963,11 +1963,11 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if err := tpgresource.SetLabels(instance.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(instance.Labels, d, "labels", config); err != nil {
 		return err
 	}
 
-	if err := tpgresource.SetLabels(instance.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(instance.Labels, d, "terraform_labels", config); err != nil {
 		return err
 	}
 
@@ -2225,6 +2225,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				return nil
 			},
+			RetryOn: config.RetryOn,
 		})
 
 		if err != nil {
@@ -2267,6 +2268,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				return nil
 			},
+			RetryOn: config.RetryOn,
 		})
 
 		if err != nil {
@@ -2335,6 +2337,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				return nil
 			},
+			RetryOn: config.RetryOn,
 		})
 
 		if err != nil {
@@ -2837,6 +2840,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 				return nil
 			},
+			RetryOn: config.RetryOn,
 		})
 
 		if err != nil {
@@ -3033,6 +3037,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 					return nil
 				},
+				RetryOn: config.RetryOn,
 			})
 
 			if err != nil {
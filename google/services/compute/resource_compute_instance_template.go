@@ -1931,11 +1931,11 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 		}
 	}
 	if instanceTemplate.Properties.Labels != nil {
-		if err := tpgresource.SetLabels(instanceTemplate.Properties.Labels, d, "labels"); err != nil {
+		if err := tpgresource.SetLabels(instanceTemplate.Properties.Labels, d, "labels", config); err != nil {
 			return fmt.Errorf("Error setting labels: %s", err)
 		}
 	}
-	if err := tpgresource.SetLabels(instanceTemplate.Properties.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(instanceTemplate.Properties.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", instanceTemplate.Properties.Labels); err != nil {
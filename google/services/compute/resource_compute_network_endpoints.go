@@ -208,10 +208,10 @@ func init() {
 
 func ResourceComputeNetworkEndpoints() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeNetworkEndpointsCreate,
-		Read:   resourceComputeNetworkEndpointsRead,
-		Update: resourceComputeNetworkEndpointsUpdate,
-		Delete: resourceComputeNetworkEndpointsDelete,
+		CreateContext: resourceComputeNetworkEndpointsCreate,
+		Read:          resourceComputeNetworkEndpointsRead,
+		UpdateContext: resourceComputeNetworkEndpointsUpdate,
+		DeleteContext: resourceComputeNetworkEndpointsDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeNetworkEndpointsImport,
@@ -315,36 +315,38 @@ with the type of 'GCE_VM_IP'`,
 	}
 }
 
-func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkEndpointsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := make(map[string]interface{})
 	networkEndpointsProp, err := expandComputeNetworkEndpointsNetworkEndpoints(d.Get("network_endpoints"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("network_endpoints"); !tpgresource.IsEmptyValue(reflect.ValueOf(networkEndpointsProp)) && (ok || !reflect.DeepEqual(v, networkEndpointsProp)) {
 		obj["networkEndpoints"] = networkEndpointsProp
 	}
 
 	obj, err = resourceComputeNetworkEndpointsEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "networkEndpoint/{{project}}/{{zone}}/{{network_endpoint_group}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_network_endpoints", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{network_endpoint_group}}/attachNetworkEndpoints"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Creating new NetworkEndpoints: %#v", obj)
@@ -352,7 +354,7 @@ func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interfac
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for NetworkEndpoints: %s", err)
+		return diag.Errorf("Error fetching project for NetworkEndpoints: %s", err)
 	}
 	billingProject = project
 
@@ -370,7 +372,7 @@ func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interfac
 	lastPage, err := networkEndpointsPaginatedMutate(d, endpoints, config, userAgent, url, project, billingProject, chunkSize, true)
 	if err != nil {
 		// networkEndpointsPaginatedMutate already adds error description
-		return err
+		return diag.FromErr(err)
 	}
 	obj["networkEndpoints"] = lastPage
 	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
@@ -384,13 +386,13 @@ func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interfac
 		Headers:   headers,
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating NetworkEndpoints: %s", err)
+		return diag.Errorf("Error creating NetworkEndpoints: %s", err)
 	}
 
 	// Store the ID now
 	id, err := tpgresource.ReplaceVars(d, config, "{{project}}/{{zone}}/{{network_endpoint_group}}")
 	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
+		return diag.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
 
@@ -401,7 +403,7 @@ func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		// The resource didn't actually create
 		d.SetId("")
-		return fmt.Errorf("Error waiting to create NetworkEndpoints: %s", err)
+		return diag.Errorf("Error waiting to create NetworkEndpoints: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished creating NetworkEndpoints %q: %#v", d.Id(), res)
@@ -410,24 +412,24 @@ func resourceComputeNetworkEndpointsCreate(d *schema.ResourceData, meta interfac
 	if err == nil && identity != nil {
 		if zoneValue, ok := d.GetOk("zone"); ok && zoneValue.(string) != "" {
 			if err = identity.Set("zone", zoneValue.(string)); err != nil {
-				return fmt.Errorf("Error setting zone: %s", err)
+				return diag.Errorf("Error setting zone: %s", err)
 			}
 		}
 		if networkEndpointGroupValue, ok := d.GetOk("network_endpoint_group"); ok && networkEndpointGroupValue.(string) != "" {
 			if err = identity.Set("network_endpoint_group", networkEndpointGroupValue.(string)); err != nil {
-				return fmt.Errorf("Error setting network_endpoint_group: %s", err)
+				return diag.Errorf("Error setting network_endpoint_group: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
 		log.Printf("[DEBUG] (Create) identity not set: %s", err)
 	}
 
-	return resourceComputeNetworkEndpointsRead(d, meta)
+	return diag.FromErr(resourceComputeNetworkEndpointsRead(d, meta))
 }
 
 func resourceComputeNetworkEndpointsRead(d *schema.ResourceData, meta interface{}) error {
@@ -525,27 +527,27 @@ func resourceComputeNetworkEndpointsRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceComputeNetworkEndpointsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkEndpointsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	identity, err := d.Identity()
 	if err == nil && identity != nil {
 		if zoneValue, ok := d.GetOk("zone"); ok && zoneValue.(string) != "" {
 			if err = identity.Set("zone", zoneValue.(string)); err != nil {
-				return fmt.Errorf("Error setting zone: %s", err)
+				return diag.Errorf("Error setting zone: %s", err)
 			}
 		}
 		if networkEndpointGroupValue, ok := d.GetOk("network_endpoint_group"); ok && networkEndpointGroupValue.(string) != "" {
 			if err = identity.Set("network_endpoint_group", networkEndpointGroupValue.(string)); err != nil {
-				return fmt.Errorf("Error setting network_endpoint_group: %s", err)
+				return diag.Errorf("Error setting network_endpoint_group: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
@@ -556,33 +558,35 @@ func resourceComputeNetworkEndpointsUpdate(d *schema.ResourceData, meta interfac
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for NetworkEndpoints: %s", err)
+		return diag.Errorf("Error fetching project for NetworkEndpoints: %s", err)
 	}
 	billingProject = project
 
 	obj := make(map[string]interface{})
 	networkEndpointsProp, err := expandComputeNetworkEndpointsNetworkEndpoints(d.Get("network_endpoints"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("network_endpoints"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, networkEndpointsProp)) {
 		obj["networkEndpoints"] = networkEndpointsProp
 	}
 
 	obj, err = resourceComputeNetworkEndpointsEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "networkEndpoint/{{project}}/{{zone}}/{{network_endpoint_group}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_network_endpoints", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{network_endpoint_group}}/attachNetworkEndpoints"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating NetworkEndpoints %q: %#v", d.Id(), obj)
@@ -636,17 +640,17 @@ func resourceComputeNetworkEndpointsUpdate(d *schema.ResourceData, meta interfac
 	_, err = networkEndpointsPaginatedMutate(d, endpointsToDetach, config, userAgent, detachUrl, project, billingProject, chunkSize, false)
 	if err != nil {
 		// networkEndpointsPaginatedMutate already adds error description
-		return err
+		return diag.FromErr(err)
 	}
 
 	lastPage, err := networkEndpointsPaginatedMutate(d, endpointsToAttach, config, userAgent, url, project, billingProject, chunkSize, true)
 	if err != nil {
 		// networkEndpointsPaginatedMutate already adds error description
-		return err
+		return diag.FromErr(err)
 	}
 
 	if len(lastPage) == 0 {
-		return resourceComputeNetworkEndpointsRead(d, meta)
+		return diag.FromErr(resourceComputeNetworkEndpointsRead(d, meta))
 	}
 
 	obj = map[string]interface{}{
@@ -670,7 +674,7 @@ func resourceComputeNetworkEndpointsUpdate(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error updating NetworkEndpoints %q: %s", d.Id(), err)
+		return diag.Errorf("Error updating NetworkEndpoints %q: %s", d.Id(), err)
 	} else {
 		log.Printf("[DEBUG] Finished updating NetworkEndpoints %q: %#v", d.Id(), res)
 	}
@@ -680,36 +684,38 @@ func resourceComputeNetworkEndpointsUpdate(d *schema.ResourceData, meta interfac
 		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeNetworkEndpointsRead(d, meta)
+	return diag.FromErr(resourceComputeNetworkEndpointsRead(d, meta))
 }
 
-func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkEndpointsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for NetworkEndpoints: %s", err)
+		return diag.Errorf("Error fetching project for NetworkEndpoints: %s", err)
 	}
 	billingProject = project
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "networkEndpoint/{{project}}/{{zone}}/{{network_endpoint_group}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_network_endpoints", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/zones/{{zone}}/networkEndpointGroups/{{network_endpoint_group}}/detachNetworkEndpoints"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var obj map[string]interface{}
@@ -729,7 +735,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 		toDelete := make(map[string]interface{})
 		instanceProp, err := expandNestedComputeNetworkEndpointInstance(endpoint["instance"], d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if instanceProp != "" {
 			toDelete["instance"] = instanceProp
@@ -737,7 +743,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 
 		portProp, err := expandNestedComputeNetworkEndpointPort(endpoint["port"], d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if portProp != 0 {
 			toDelete["port"] = portProp
@@ -745,7 +751,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 
 		ipAddressProp, err := expandNestedComputeNetworkEndpointIpAddress(endpoint["ip_address"], d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		toDelete["ipAddress"] = ipAddressProp
 		endpointsToDelete = append(endpointsToDelete, toDelete)
@@ -755,7 +761,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 	lastPage, err := networkEndpointsPaginatedMutate(d, endpointsToDelete, config, userAgent, url, project, billingProject, chunkSize, true)
 	if err != nil {
 		// networkEndpointsPaginatedMutate already adds error description
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj = map[string]interface{}{
@@ -774,7 +780,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 		Headers:   headers,
 	})
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "NetworkEndpoints")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "NetworkEndpoints"))
 	}
 
 	err = ComputeOperationWaitTime(
@@ -782,7 +788,7 @@ func resourceComputeNetworkEndpointsDelete(d *schema.ResourceData, meta interfac
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting NetworkEndpoints %q: %#v", d.Id(), res)
@@ -17,6 +17,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -24,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/api/googleapi"
 
@@ -39,10 +41,10 @@ const peerNetworkLinkRegex = "projects/(" + verify.ProjectRegex + ")/global/netw
 
 func ResourceComputeNetworkPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeNetworkPeeringCreate,
-		Read:   resourceComputeNetworkPeeringRead,
-		Update: resourceComputeNetworkPeeringUpdate,
-		Delete: resourceComputeNetworkPeeringDelete,
+		CreateContext: resourceComputeNetworkPeeringCreate,
+		Read:          resourceComputeNetworkPeeringRead,
+		UpdateContext: resourceComputeNetworkPeeringUpdate,
+		DeleteContext: resourceComputeNetworkPeeringDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeNetworkPeeringImport,
 		},
@@ -139,20 +141,20 @@ func ResourceComputeNetworkPeering() *schema.Resource {
 	}
 }
 
-func resourceComputeNetworkPeeringCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	networkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	peerNetworkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("peer_network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request := &compute.NetworksAddPeeringRequest{}
@@ -162,23 +164,25 @@ func resourceComputeNetworkPeeringCreate(d *schema.ResourceData, meta interface{
 	// Lock on both networks, sorted so we don't deadlock for A <--> B peering pairs.
 	peeringLockNames := sortedNetworkPeeringMutexKeys(networkFieldValue, peerNetworkFieldValue)
 	for _, kn := range peeringLockNames {
-		transport_tpg.MutexStore.Lock(kn)
+		if err := transport_tpg.MutexStore.LockWithContext(ctx, kn, tpgresource.LockHolder("google_compute_network_peering", d)); err != nil {
+			return diag.FromErr(err)
+		}
 		defer transport_tpg.MutexStore.Unlock(kn)
 	}
 
 	addOp, err := NewClient(config, userAgent).Networks.AddPeering(networkFieldValue.Project, networkFieldValue.Name, request).Do()
 	if err != nil {
-		return fmt.Errorf("Error adding network peering: %s", err)
+		return diag.Errorf("Error adding network peering: %s", err)
 	}
 
 	err = ComputeOperationWaitTime(config, addOp, networkFieldValue.Project, "Adding Network Peering", userAgent, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", networkFieldValue.Name, d.Get("name").(string)))
 
-	return resourceComputeNetworkPeeringRead(d, meta)
+	return diag.FromErr(resourceComputeNetworkPeeringRead(d, meta))
 }
 
 func resourceComputeNetworkPeeringRead(d *schema.ResourceData, meta interface{}) error {
@@ -241,20 +245,20 @@ func resourceComputeNetworkPeeringRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceComputeNetworkPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	networkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	peerNetworkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("peer_network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request := &compute.NetworksUpdatePeeringRequest{}
@@ -264,39 +268,41 @@ func resourceComputeNetworkPeeringUpdate(d *schema.ResourceData, meta interface{
 	// Lock on both networks, sorted so we don't deadlock for A <--> B peering pairs.
 	peeringLockNames := sortedNetworkPeeringMutexKeys(networkFieldValue, peerNetworkFieldValue)
 	for _, kn := range peeringLockNames {
-		transport_tpg.MutexStore.Lock(kn)
+		if err := transport_tpg.MutexStore.LockWithContext(ctx, kn, tpgresource.LockHolder("google_compute_network_peering", d)); err != nil {
+			return diag.FromErr(err)
+		}
 		defer transport_tpg.MutexStore.Unlock(kn)
 	}
 
 	updateOp, err := NewClient(config, userAgent).Networks.UpdatePeering(networkFieldValue.Project, networkFieldValue.Name, request).Do()
 	if err != nil {
-		return fmt.Errorf("Error updating network peering: %s", err)
+		return diag.Errorf("Error updating network peering: %s", err)
 	}
 
 	err = ComputeOperationWaitTime(config, updateOp, networkFieldValue.Project, "Updating Network Peering", userAgent, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeNetworkPeeringRead(d, meta)
+	return diag.FromErr(resourceComputeNetworkPeeringRead(d, meta))
 }
 
-func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeNetworkPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Remove the `network` to `peer_network` peering
 	name := d.Get("name").(string)
 	networkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	peerNetworkFieldValue, err := tpgresource.ParseNetworkFieldValue(d.Get("peer_network").(string), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	request := &compute.NetworksRemovePeeringRequest{
@@ -307,7 +313,9 @@ func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 	// Lock on both networks, sorted so we don't deadlock for A <--> B peering pairs.
 	peeringLockNames := sortedNetworkPeeringMutexKeys(networkFieldValue, peerNetworkFieldValue)
 	for _, kn := range peeringLockNames {
-		transport_tpg.MutexStore.Lock(kn)
+		if err := transport_tpg.MutexStore.LockWithContext(ctx, kn, tpgresource.LockHolder("google_compute_network_peering", d)); err != nil {
+			return diag.FromErr(err)
+		}
 		defer transport_tpg.MutexStore.Unlock(kn)
 	}
 
@@ -316,12 +324,12 @@ func resourceComputeNetworkPeeringDelete(d *schema.ResourceData, meta interface{
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
 			log.Printf("[WARN] Peering `%s` already removed from network `%s`", name, networkFieldValue.Name)
 		} else {
-			return fmt.Errorf("Error removing peering `%s` from network `%s`: %s", name, networkFieldValue.Name, err)
+			return diag.Errorf("Error removing peering `%s` from network `%s`: %s", name, networkFieldValue.Name, err)
 		}
 	} else {
 		err = ComputeOperationWaitTime(config, removeOp, networkFieldValue.Project, "Removing Network Peering", userAgent, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -116,10 +116,10 @@ func init() {
 
 func ResourceComputeRouter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeRouterCreate,
-		Read:   resourceComputeRouterRead,
-		Update: resourceComputeRouterUpdate,
-		Delete: resourceComputeRouterDelete,
+		CreateContext: resourceComputeRouterCreate,
+		Read:          resourceComputeRouterRead,
+		UpdateContext: resourceComputeRouterUpdate,
+		DeleteContext: resourceComputeRouterDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterImport,
@@ -354,73 +354,75 @@ CIDR-formatted string.`,
 	}
 }
 
-func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := make(map[string]interface{})
 	nameProp, err := expandComputeRouterName(d.Get("name"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
 	descriptionProp, err := expandComputeRouterDescription(d.Get("description"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("description"); ok || !reflect.DeepEqual(v, descriptionProp) {
 		obj["description"] = descriptionProp
 	}
 	networkProp, err := expandComputeRouterNetwork(d.Get("network"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("network"); !tpgresource.IsEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
 		obj["network"] = networkProp
 	}
 	bgpProp, err := expandComputeRouterBgp(d.Get("bgp"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("bgp"); ok || !reflect.DeepEqual(v, bgpProp) {
 		obj["bgp"] = bgpProp
 	}
 	encryptedInterconnectRouterProp, err := expandComputeRouterEncryptedInterconnectRouter(d.Get("encrypted_interconnect_router"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("encrypted_interconnect_router"); !tpgresource.IsEmptyValue(reflect.ValueOf(encryptedInterconnectRouterProp)) && (ok || !reflect.DeepEqual(v, encryptedInterconnectRouterProp)) {
 		obj["encryptedInterconnectRouter"] = encryptedInterconnectRouterProp
 	}
 	md5AuthenticationKeysProp, err := expandComputeRouterMd5AuthenticationKeys(d.Get("md5_authentication_keys"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("md5_authentication_keys"); !tpgresource.IsEmptyValue(reflect.ValueOf(md5AuthenticationKeysProp)) && (ok || !reflect.DeepEqual(v, md5AuthenticationKeysProp)) {
 		obj["md5AuthenticationKeys"] = md5AuthenticationKeysProp
 	}
 	paramsProp, err := expandComputeRouterParams(d.Get("params"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("params"); !tpgresource.IsEmptyValue(reflect.ValueOf(paramsProp)) && (ok || !reflect.DeepEqual(v, paramsProp)) {
 		obj["params"] = paramsProp
 	}
 	regionProp, err := expandComputeRouterRegion(d.Get("region"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("region"); !tpgresource.IsEmptyValue(reflect.ValueOf(regionProp)) && (ok || !reflect.DeepEqual(v, regionProp)) {
 		obj["region"] = regionProp
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{name}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Creating new Router: %#v", obj)
@@ -428,7 +430,7 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for Router: %s", err)
+		return diag.Errorf("Error fetching project for Router: %s", err)
 	}
 	billingProject = project
 
@@ -449,13 +451,13 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 		Headers:   headers,
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating Router: %s", err)
+		return diag.Errorf("Error creating Router: %s", err)
 	}
 
 	// Store the ID now
 	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/regions/{{region}}/routers/{{name}}")
 	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
+		return diag.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
 
@@ -466,7 +468,7 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		// The resource didn't actually create
 		d.SetId("")
-		return fmt.Errorf("Error waiting to create Router: %s", err)
+		return diag.Errorf("Error waiting to create Router: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished creating Router %q: %#v", d.Id(), res)
@@ -475,24 +477,24 @@ func resourceComputeRouterCreate(d *schema.ResourceData, meta interface{}) error
 	if err == nil && identity != nil {
 		if nameValue, ok := d.GetOk("name"); ok && nameValue.(string) != "" {
 			if err = identity.Set("name", nameValue.(string)); err != nil {
-				return fmt.Errorf("Error setting name: %s", err)
+				return diag.Errorf("Error setting name: %s", err)
 			}
 		}
 		if regionValue, ok := d.GetOk("region"); ok && regionValue.(string) != "" {
 			if err = identity.Set("region", regionValue.(string)); err != nil {
-				return fmt.Errorf("Error setting region: %s", err)
+				return diag.Errorf("Error setting region: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
 		log.Printf("[DEBUG] (Create) identity not set: %s", err)
 	}
 
-	return resourceComputeRouterRead(d, meta)
+	return diag.FromErr(resourceComputeRouterRead(d, meta))
 }
 
 func resourceComputeRouterRead(d *schema.ResourceData, meta interface{}) error {
@@ -571,27 +573,27 @@ func resourceComputeRouterRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceComputeRouterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	identity, err := d.Identity()
 	if err == nil && identity != nil {
 		if nameValue, ok := d.GetOk("name"); ok && nameValue.(string) != "" {
 			if err = identity.Set("name", nameValue.(string)); err != nil {
-				return fmt.Errorf("Error setting name: %s", err)
+				return diag.Errorf("Error setting name: %s", err)
 			}
 		}
 		if regionValue, ok := d.GetOk("region"); ok && regionValue.(string) != "" {
 			if err = identity.Set("region", regionValue.(string)); err != nil {
-				return fmt.Errorf("Error setting region: %s", err)
+				return diag.Errorf("Error setting region: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
@@ -602,40 +604,42 @@ func resourceComputeRouterUpdate(d *schema.ResourceData, meta interface{}) error
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for Router: %s", err)
+		return diag.Errorf("Error fetching project for Router: %s", err)
 	}
 	billingProject = project
 
 	obj := make(map[string]interface{})
 	descriptionProp, err := expandComputeRouterDescription(d.Get("description"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("description"); ok || !reflect.DeepEqual(v, descriptionProp) {
 		obj["description"] = descriptionProp
 	}
 	bgpProp, err := expandComputeRouterBgp(d.Get("bgp"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("bgp"); ok || !reflect.DeepEqual(v, bgpProp) {
 		obj["bgp"] = bgpProp
 	}
 	md5AuthenticationKeysProp, err := expandComputeRouterMd5AuthenticationKeys(d.Get("md5_authentication_keys"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("md5_authentication_keys"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, md5AuthenticationKeysProp)) {
 		obj["md5AuthenticationKeys"] = md5AuthenticationKeysProp
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{name}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{name}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating Router %q: %#v", d.Id(), obj)
@@ -658,7 +662,7 @@ func resourceComputeRouterUpdate(d *schema.ResourceData, meta interface{}) error
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error updating Router %q: %s", d.Id(), err)
+		return diag.Errorf("Error updating Router %q: %s", d.Id(), err)
 	} else {
 		log.Printf("[DEBUG] Finished updating Router %q: %#v", d.Id(), res)
 	}
@@ -668,36 +672,38 @@ func resourceComputeRouterUpdate(d *schema.ResourceData, meta interface{}) error
 		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeRouterRead(d, meta)
+	return diag.FromErr(resourceComputeRouterRead(d, meta))
 }
 
-func resourceComputeRouterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for Router: %s", err)
+		return diag.Errorf("Error fetching project for Router: %s", err)
 	}
 	billingProject = project
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{name}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{name}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var obj map[string]interface{}
@@ -721,7 +727,7 @@ func resourceComputeRouterDelete(d *schema.ResourceData, meta interface{}) error
 		Headers:   headers,
 	})
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "Router")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "Router"))
 	}
 
 	err = ComputeOperationWaitTime(
@@ -729,7 +735,7 @@ func resourceComputeRouterDelete(d *schema.ResourceData, meta interface{}) error
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting Router %q: %#v", d.Id(), res)
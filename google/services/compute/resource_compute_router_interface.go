@@ -17,6 +17,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
@@ -37,9 +39,9 @@ import (
 
 func ResourceComputeRouterInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeRouterInterfaceCreate,
-		Read:   resourceComputeRouterInterfaceRead,
-		Delete: resourceComputeRouterInterfaceDelete,
+		CreateContext: resourceComputeRouterInterfaceCreate,
+		Read:          resourceComputeRouterInterfaceRead,
+		DeleteContext: resourceComputeRouterInterfaceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterInterfaceImportState,
 		},
@@ -143,28 +145,30 @@ func ResourceComputeRouterInterface() *schema.Resource {
 	}
 }
 
-func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	region, err := tpgresource.GetRegion(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routerName := d.Get("router").(string)
 	ifaceName := d.Get("name").(string)
 
 	routerLock := tpgresource.GetRouterLockName(region, routerName)
-	transport_tpg.MutexStore.Lock(routerLock)
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, routerLock, tpgresource.LockHolder("google_compute_router_interface", d)); err != nil {
+		return diag.FromErr(err)
+	}
 	defer transport_tpg.MutexStore.Unlock(routerLock)
 
 	routersService := NewClient(config, userAgent).Routers
@@ -177,7 +181,7 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
+		return diag.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
 	}
 
 	ifaces := router.Interfaces
@@ -185,7 +189,7 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 	for _, iface := range ifaces {
 		if iface.Name == ifaceName {
 			d.SetId("")
-			return fmt.Errorf("Router %s has interface %s already", routerName, ifaceName)
+			return diag.Errorf("Router %s has interface %s already", routerName, ifaceName)
 		}
 	}
 
@@ -210,7 +214,7 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 	if vpnVal, ok := d.GetOk("vpn_tunnel"); ok {
 		vpnTunnel, err := getVpnTunnelLink(config, project, region, vpnVal.(string), userAgent)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		iface.LinkedVpnTunnel = vpnTunnel
 	}
@@ -218,7 +222,7 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 	if icVal, ok := d.GetOk("interconnect_attachment"); ok {
 		interconnectAttachment, err := GetInterconnectAttachmentLink(config, project, region, icVal.(string), userAgent)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		iface.LinkedInterconnectAttachment = interconnectAttachment
 	}
@@ -236,16 +240,16 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating router %s/%s with interfaces: %+v", region, routerName, ifaces)
 	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
 	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
+		return diag.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, ifaceName))
 	err = ComputeOperationWaitTime(config, op, project, "Patching router", userAgent, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		d.SetId("")
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+		return diag.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
 	}
 
-	return resourceComputeRouterInterfaceRead(d, meta)
+	return diag.FromErr(resourceComputeRouterInterfaceRead(d, meta))
 }
 
 func resourceComputeRouterInterfaceRead(d *schema.ResourceData, meta interface{}) error {
@@ -321,28 +325,30 @@ func resourceComputeRouterInterfaceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceComputeRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	region, err := tpgresource.GetRegion(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	routerName := d.Get("router").(string)
 	ifaceName := d.Get("name").(string)
 
 	routerLock := tpgresource.GetRouterLockName(region, routerName)
-	transport_tpg.MutexStore.Lock(routerLock)
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, routerLock, tpgresource.LockHolder("google_compute_router_interface", d)); err != nil {
+		return diag.FromErr(err)
+	}
 	defer transport_tpg.MutexStore.Unlock(routerLock)
 
 	routersService := NewClient(config, userAgent).Routers
@@ -354,7 +360,7 @@ func resourceComputeRouterInterfaceDelete(d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return fmt.Errorf("Error Reading Router %s: %s", routerName, err)
+		return diag.Errorf("Error Reading Router %s: %s", routerName, err)
 	}
 
 	var ifaceFound bool
@@ -394,12 +400,12 @@ func resourceComputeRouterInterfaceDelete(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Updating router %s/%s with interfaces: %+v", region, routerName, newIfaces)
 	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
 	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
+		return diag.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 
 	err = ComputeOperationWaitTime(config, op, project, "Patching router", userAgent, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+		return diag.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
 	}
 
 	d.SetId("")
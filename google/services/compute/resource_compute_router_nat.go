@@ -253,10 +253,10 @@ func init() {
 
 func ResourceComputeRouterNat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeRouterNatCreate,
-		Read:   resourceComputeRouterNatRead,
-		Update: resourceComputeRouterNatUpdate,
-		Delete: resourceComputeRouterNatDelete,
+		CreateContext: resourceComputeRouterNatCreate,
+		Read:          resourceComputeRouterNatRead,
+		UpdateContext: resourceComputeRouterNatUpdate,
+		DeleteContext: resourceComputeRouterNatDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterNatImport,
@@ -696,181 +696,183 @@ This field is used for private NAT.`,
 	}
 }
 
-func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := make(map[string]interface{})
 	nameProp, err := expandNestedComputeRouterNatName(d.Get("name"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
 	natIpAllocateOptionProp, err := expandNestedComputeRouterNatNatIpAllocateOption(d.Get("nat_ip_allocate_option"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ip_allocate_option"); !tpgresource.IsEmptyValue(reflect.ValueOf(natIpAllocateOptionProp)) && (ok || !reflect.DeepEqual(v, natIpAllocateOptionProp)) {
 		obj["natIpAllocateOption"] = natIpAllocateOptionProp
 	}
 	initialNatIpsProp, err := expandNestedComputeRouterNatInitialNatIps(d.Get("initial_nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("initial_nat_ips"); ok || !reflect.DeepEqual(v, initialNatIpsProp) {
 		obj["initialNatIps"] = initialNatIpsProp
 	}
 	natIpsProp, err := expandNestedComputeRouterNatNatIps(d.Get("nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ips"); ok || !reflect.DeepEqual(v, natIpsProp) {
 		obj["natIps"] = natIpsProp
 	}
 	drainNatIpsProp, err := expandNestedComputeRouterNatDrainNatIps(d.Get("drain_nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
 	sourceSubnetworkIpRangesToNatProp, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat(d.Get("source_subnetwork_ip_ranges_to_nat"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("source_subnetwork_ip_ranges_to_nat"); !tpgresource.IsEmptyValue(reflect.ValueOf(sourceSubnetworkIpRangesToNatProp)) && (ok || !reflect.DeepEqual(v, sourceSubnetworkIpRangesToNatProp)) {
 		obj["sourceSubnetworkIpRangesToNat"] = sourceSubnetworkIpRangesToNatProp
 	}
 	subnetworkProp, err := expandNestedComputeRouterNatSubnetwork(d.Get("subnetwork"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("subnetwork"); ok || !reflect.DeepEqual(v, subnetworkProp) {
 		obj["subnetworks"] = subnetworkProp
 	}
 	sourceSubnetworkIpRangesToNat64Prop, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat64(d.Get("source_subnetwork_ip_ranges_to_nat64"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("source_subnetwork_ip_ranges_to_nat64"); !tpgresource.IsEmptyValue(reflect.ValueOf(sourceSubnetworkIpRangesToNat64Prop)) && (ok || !reflect.DeepEqual(v, sourceSubnetworkIpRangesToNat64Prop)) {
 		obj["sourceSubnetworkIpRangesToNat64"] = sourceSubnetworkIpRangesToNat64Prop
 	}
 	nat64SubnetworkProp, err := expandNestedComputeRouterNatNat64Subnetwork(d.Get("nat64_subnetwork"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat64_subnetwork"); ok || !reflect.DeepEqual(v, nat64SubnetworkProp) {
 		obj["nat64Subnetworks"] = nat64SubnetworkProp
 	}
 	minPortsPerVmProp, err := expandNestedComputeRouterNatMinPortsPerVm(d.Get("min_ports_per_vm"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("min_ports_per_vm"); !tpgresource.IsEmptyValue(reflect.ValueOf(minPortsPerVmProp)) && (ok || !reflect.DeepEqual(v, minPortsPerVmProp)) {
 		obj["minPortsPerVm"] = minPortsPerVmProp
 	}
 	maxPortsPerVmProp, err := expandNestedComputeRouterNatMaxPortsPerVm(d.Get("max_ports_per_vm"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("max_ports_per_vm"); !tpgresource.IsEmptyValue(reflect.ValueOf(maxPortsPerVmProp)) && (ok || !reflect.DeepEqual(v, maxPortsPerVmProp)) {
 		obj["maxPortsPerVm"] = maxPortsPerVmProp
 	}
 	enableDynamicPortAllocationProp, err := expandNestedComputeRouterNatEnableDynamicPortAllocation(d.Get("enable_dynamic_port_allocation"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_dynamic_port_allocation"); ok || !reflect.DeepEqual(v, enableDynamicPortAllocationProp) {
 		obj["enableDynamicPortAllocation"] = enableDynamicPortAllocationProp
 	}
 	udpIdleTimeoutSecProp, err := expandNestedComputeRouterNatUdpIdleTimeoutSec(d.Get("udp_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("udp_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(udpIdleTimeoutSecProp)) && (ok || !reflect.DeepEqual(v, udpIdleTimeoutSecProp)) {
 		obj["udpIdleTimeoutSec"] = udpIdleTimeoutSecProp
 	}
 	icmpIdleTimeoutSecProp, err := expandNestedComputeRouterNatIcmpIdleTimeoutSec(d.Get("icmp_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("icmp_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(icmpIdleTimeoutSecProp)) && (ok || !reflect.DeepEqual(v, icmpIdleTimeoutSecProp)) {
 		obj["icmpIdleTimeoutSec"] = icmpIdleTimeoutSecProp
 	}
 	tcpEstablishedIdleTimeoutSecProp, err := expandNestedComputeRouterNatTcpEstablishedIdleTimeoutSec(d.Get("tcp_established_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_established_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(tcpEstablishedIdleTimeoutSecProp)) && (ok || !reflect.DeepEqual(v, tcpEstablishedIdleTimeoutSecProp)) {
 		obj["tcpEstablishedIdleTimeoutSec"] = tcpEstablishedIdleTimeoutSecProp
 	}
 	tcpTransitoryIdleTimeoutSecProp, err := expandNestedComputeRouterNatTcpTransitoryIdleTimeoutSec(d.Get("tcp_transitory_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_transitory_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(tcpTransitoryIdleTimeoutSecProp)) && (ok || !reflect.DeepEqual(v, tcpTransitoryIdleTimeoutSecProp)) {
 		obj["tcpTransitoryIdleTimeoutSec"] = tcpTransitoryIdleTimeoutSecProp
 	}
 	tcpTimeWaitTimeoutSecProp, err := expandNestedComputeRouterNatTcpTimeWaitTimeoutSec(d.Get("tcp_time_wait_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_time_wait_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(tcpTimeWaitTimeoutSecProp)) && (ok || !reflect.DeepEqual(v, tcpTimeWaitTimeoutSecProp)) {
 		obj["tcpTimeWaitTimeoutSec"] = tcpTimeWaitTimeoutSecProp
 	}
 	logConfigProp, err := expandNestedComputeRouterNatLogConfig(d.Get("log_config"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("log_config"); ok || !reflect.DeepEqual(v, logConfigProp) {
 		obj["logConfig"] = logConfigProp
 	}
 	endpointTypesProp, err := expandNestedComputeRouterNatEndpointTypes(d.Get("endpoint_types"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("endpoint_types"); !tpgresource.IsEmptyValue(reflect.ValueOf(endpointTypesProp)) && (ok || !reflect.DeepEqual(v, endpointTypesProp)) {
 		obj["endpointTypes"] = endpointTypesProp
 	}
 	rulesProp, err := expandNestedComputeRouterNatRules(d.Get("rules"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("rules"); ok || !reflect.DeepEqual(v, rulesProp) {
 		obj["rules"] = rulesProp
 	}
 	enableEndpointIndependentMappingProp, err := expandNestedComputeRouterNatEnableEndpointIndependentMapping(d.Get("enable_endpoint_independent_mapping"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_endpoint_independent_mapping"); ok || !reflect.DeepEqual(v, enableEndpointIndependentMappingProp) {
 		obj["enableEndpointIndependentMapping"] = enableEndpointIndependentMappingProp
 	}
 	typeProp, err := expandNestedComputeRouterNatType(d.Get("type"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("type"); !tpgresource.IsEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
 		obj["type"] = typeProp
 	}
 	autoNetworkTierProp, err := expandNestedComputeRouterNatAutoNetworkTier(d.Get("auto_network_tier"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("auto_network_tier"); !tpgresource.IsEmptyValue(reflect.ValueOf(autoNetworkTierProp)) && (ok || !reflect.DeepEqual(v, autoNetworkTierProp)) {
 		obj["autoNetworkTier"] = autoNetworkTierProp
 	}
 
 	obj, err = resourceComputeRouterNatEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{router}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Creating new RouterNat: %#v", obj)
 
 	obj, err = resourceComputeRouterNatPatchCreateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNat: %s", err)
+		return diag.Errorf("Error fetching project for RouterNat: %s", err)
 	}
 	billingProject = project
 
@@ -902,7 +904,7 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 			}
 
 			if !containAction || !containActiveRange {
-				return fmt.Errorf("The rule for PRIVATE nat type must contain an action with source_nat_active_ranges set")
+				return diag.Errorf("The rule for PRIVATE nat type must contain an action with source_nat_active_ranges set")
 			}
 		}
 	}
@@ -917,13 +919,13 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 		Headers:   headers,
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating RouterNat: %s", err)
+		return diag.Errorf("Error creating RouterNat: %s", err)
 	}
 
 	// Store the ID now
 	id, err := tpgresource.ReplaceVars(d, config, "{{project}}/{{region}}/{{router}}/{{name}}")
 	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
+		return diag.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
 
@@ -934,7 +936,7 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		// The resource didn't actually create
 		d.SetId("")
-		return fmt.Errorf("Error waiting to create RouterNat: %s", err)
+		return diag.Errorf("Error waiting to create RouterNat: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished creating RouterNat %q: %#v", d.Id(), res)
@@ -943,29 +945,29 @@ func resourceComputeRouterNatCreate(d *schema.ResourceData, meta interface{}) er
 	if err == nil && identity != nil {
 		if nameValue, ok := d.GetOk("name"); ok && nameValue.(string) != "" {
 			if err = identity.Set("name", nameValue.(string)); err != nil {
-				return fmt.Errorf("Error setting name: %s", err)
+				return diag.Errorf("Error setting name: %s", err)
 			}
 		}
 		if routerValue, ok := d.GetOk("router"); ok && routerValue.(string) != "" {
 			if err = identity.Set("router", routerValue.(string)); err != nil {
-				return fmt.Errorf("Error setting router: %s", err)
+				return diag.Errorf("Error setting router: %s", err)
 			}
 		}
 		if regionValue, ok := d.GetOk("region"); ok && regionValue.(string) != "" {
 			if err = identity.Set("region", regionValue.(string)); err != nil {
-				return fmt.Errorf("Error setting region: %s", err)
+				return diag.Errorf("Error setting region: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
 		log.Printf("[DEBUG] (Create) identity not set: %s", err)
 	}
 
-	return resourceComputeRouterNatRead(d, meta)
+	return diag.FromErr(resourceComputeRouterNatRead(d, meta))
 }
 
 func resourceComputeRouterNatRead(d *schema.ResourceData, meta interface{}) error {
@@ -1062,32 +1064,32 @@ func resourceComputeRouterNatRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	identity, err := d.Identity()
 	if err == nil && identity != nil {
 		if nameValue, ok := d.GetOk("name"); ok && nameValue.(string) != "" {
 			if err = identity.Set("name", nameValue.(string)); err != nil {
-				return fmt.Errorf("Error setting name: %s", err)
+				return diag.Errorf("Error setting name: %s", err)
 			}
 		}
 		if routerValue, ok := d.GetOk("router"); ok && routerValue.(string) != "" {
 			if err = identity.Set("router", routerValue.(string)); err != nil {
-				return fmt.Errorf("Error setting router: %s", err)
+				return diag.Errorf("Error setting router: %s", err)
 			}
 		}
 		if regionValue, ok := d.GetOk("region"); ok && regionValue.(string) != "" {
 			if err = identity.Set("region", regionValue.(string)); err != nil {
-				return fmt.Errorf("Error setting region: %s", err)
+				return diag.Errorf("Error setting region: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
@@ -1098,141 +1100,143 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNat: %s", err)
+		return diag.Errorf("Error fetching project for RouterNat: %s", err)
 	}
 	billingProject = project
 
 	obj := make(map[string]interface{})
 	natIpAllocateOptionProp, err := expandNestedComputeRouterNatNatIpAllocateOption(d.Get("nat_ip_allocate_option"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ip_allocate_option"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, natIpAllocateOptionProp)) {
 		obj["natIpAllocateOption"] = natIpAllocateOptionProp
 	}
 	natIpsProp, err := expandNestedComputeRouterNatNatIps(d.Get("nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ips"); ok || !reflect.DeepEqual(v, natIpsProp) {
 		obj["natIps"] = natIpsProp
 	}
 	drainNatIpsProp, err := expandNestedComputeRouterNatDrainNatIps(d.Get("drain_nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
 	sourceSubnetworkIpRangesToNatProp, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat(d.Get("source_subnetwork_ip_ranges_to_nat"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("source_subnetwork_ip_ranges_to_nat"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, sourceSubnetworkIpRangesToNatProp)) {
 		obj["sourceSubnetworkIpRangesToNat"] = sourceSubnetworkIpRangesToNatProp
 	}
 	subnetworkProp, err := expandNestedComputeRouterNatSubnetwork(d.Get("subnetwork"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("subnetwork"); ok || !reflect.DeepEqual(v, subnetworkProp) {
 		obj["subnetworks"] = subnetworkProp
 	}
 	sourceSubnetworkIpRangesToNat64Prop, err := expandNestedComputeRouterNatSourceSubnetworkIpRangesToNat64(d.Get("source_subnetwork_ip_ranges_to_nat64"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("source_subnetwork_ip_ranges_to_nat64"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, sourceSubnetworkIpRangesToNat64Prop)) {
 		obj["sourceSubnetworkIpRangesToNat64"] = sourceSubnetworkIpRangesToNat64Prop
 	}
 	nat64SubnetworkProp, err := expandNestedComputeRouterNatNat64Subnetwork(d.Get("nat64_subnetwork"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat64_subnetwork"); ok || !reflect.DeepEqual(v, nat64SubnetworkProp) {
 		obj["nat64Subnetworks"] = nat64SubnetworkProp
 	}
 	minPortsPerVmProp, err := expandNestedComputeRouterNatMinPortsPerVm(d.Get("min_ports_per_vm"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("min_ports_per_vm"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, minPortsPerVmProp)) {
 		obj["minPortsPerVm"] = minPortsPerVmProp
 	}
 	maxPortsPerVmProp, err := expandNestedComputeRouterNatMaxPortsPerVm(d.Get("max_ports_per_vm"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("max_ports_per_vm"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, maxPortsPerVmProp)) {
 		obj["maxPortsPerVm"] = maxPortsPerVmProp
 	}
 	enableDynamicPortAllocationProp, err := expandNestedComputeRouterNatEnableDynamicPortAllocation(d.Get("enable_dynamic_port_allocation"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_dynamic_port_allocation"); ok || !reflect.DeepEqual(v, enableDynamicPortAllocationProp) {
 		obj["enableDynamicPortAllocation"] = enableDynamicPortAllocationProp
 	}
 	udpIdleTimeoutSecProp, err := expandNestedComputeRouterNatUdpIdleTimeoutSec(d.Get("udp_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("udp_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, udpIdleTimeoutSecProp)) {
 		obj["udpIdleTimeoutSec"] = udpIdleTimeoutSecProp
 	}
 	icmpIdleTimeoutSecProp, err := expandNestedComputeRouterNatIcmpIdleTimeoutSec(d.Get("icmp_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("icmp_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, icmpIdleTimeoutSecProp)) {
 		obj["icmpIdleTimeoutSec"] = icmpIdleTimeoutSecProp
 	}
 	tcpEstablishedIdleTimeoutSecProp, err := expandNestedComputeRouterNatTcpEstablishedIdleTimeoutSec(d.Get("tcp_established_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_established_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, tcpEstablishedIdleTimeoutSecProp)) {
 		obj["tcpEstablishedIdleTimeoutSec"] = tcpEstablishedIdleTimeoutSecProp
 	}
 	tcpTransitoryIdleTimeoutSecProp, err := expandNestedComputeRouterNatTcpTransitoryIdleTimeoutSec(d.Get("tcp_transitory_idle_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_transitory_idle_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, tcpTransitoryIdleTimeoutSecProp)) {
 		obj["tcpTransitoryIdleTimeoutSec"] = tcpTransitoryIdleTimeoutSecProp
 	}
 	tcpTimeWaitTimeoutSecProp, err := expandNestedComputeRouterNatTcpTimeWaitTimeoutSec(d.Get("tcp_time_wait_timeout_sec"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("tcp_time_wait_timeout_sec"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, tcpTimeWaitTimeoutSecProp)) {
 		obj["tcpTimeWaitTimeoutSec"] = tcpTimeWaitTimeoutSecProp
 	}
 	logConfigProp, err := expandNestedComputeRouterNatLogConfig(d.Get("log_config"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("log_config"); ok || !reflect.DeepEqual(v, logConfigProp) {
 		obj["logConfig"] = logConfigProp
 	}
 	rulesProp, err := expandNestedComputeRouterNatRules(d.Get("rules"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("rules"); ok || !reflect.DeepEqual(v, rulesProp) {
 		obj["rules"] = rulesProp
 	}
 	enableEndpointIndependentMappingProp, err := expandNestedComputeRouterNatEnableEndpointIndependentMapping(d.Get("enable_endpoint_independent_mapping"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_endpoint_independent_mapping"); ok || !reflect.DeepEqual(v, enableEndpointIndependentMappingProp) {
 		obj["enableEndpointIndependentMapping"] = enableEndpointIndependentMappingProp
 	}
 	autoNetworkTierProp, err := expandNestedComputeRouterNatAutoNetworkTier(d.Get("auto_network_tier"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("auto_network_tier"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoNetworkTierProp)) {
 		obj["autoNetworkTier"] = autoNetworkTierProp
 	}
 
 	obj, err = resourceComputeRouterNatEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{router}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating RouterNat %q: %#v", d.Id(), obj)
@@ -1259,14 +1263,14 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 			}
 
 			if !containAction || !containActiveRange {
-				return fmt.Errorf("The rule for PRIVATE nat type must contain an action with source_nat_active_ranges set")
+				return diag.Errorf("The rule for PRIVATE nat type must contain an action with source_nat_active_ranges set")
 			}
 		}
 	}
 
 	obj, err = resourceComputeRouterNatPatchUpdateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// err == nil indicates that the billing_project value was found
@@ -1286,7 +1290,7 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error updating RouterNat %q: %s", d.Id(), err)
+		return diag.Errorf("Error updating RouterNat %q: %s", d.Id(), err)
 	} else {
 		log.Printf("[DEBUG] Finished updating RouterNat %q: %#v", d.Id(), res)
 	}
@@ -1296,43 +1300,45 @@ func resourceComputeRouterNatUpdate(d *schema.ResourceData, meta interface{}) er
 		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeRouterNatRead(d, meta)
+	return diag.FromErr(resourceComputeRouterNatRead(d, meta))
 }
 
-func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNat: %s", err)
+		return diag.Errorf("Error fetching project for RouterNat: %s", err)
 	}
 	billingProject = project
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{router}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var obj map[string]interface{}
 
 	obj, err = resourceComputeRouterNatPatchDeleteEncoder(d, meta, obj)
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterNat")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterNat"))
 	}
 
 	// err == nil indicates that the billing_project value was found
@@ -1354,7 +1360,7 @@ func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) er
 		Headers:   headers,
 	})
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterNat")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterNat"))
 	}
 
 	err = ComputeOperationWaitTime(
@@ -1362,7 +1368,7 @@ func resourceComputeRouterNatDelete(d *schema.ResourceData, meta interface{}) er
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting RouterNat %q: %#v", d.Id(), res)
@@ -178,10 +178,10 @@ func init() {
 
 func ResourceComputeRouterNatAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeRouterNatAddressCreate,
-		Read:   resourceComputeRouterNatAddressRead,
-		Update: resourceComputeRouterNatAddressUpdate,
-		Delete: resourceComputeRouterNatAddressDelete,
+		CreateContext: resourceComputeRouterNatAddressCreate,
+		Read:          resourceComputeRouterNatAddressRead,
+		UpdateContext: resourceComputeRouterNatAddressUpdate,
+		DeleteContext: resourceComputeRouterNatAddressDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterNatAddressImport,
@@ -281,30 +281,30 @@ valid static external IPs that have been assigned to the NAT.`,
 	}
 }
 
-func resourceComputeRouterNatAddressCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	// A custom_create function similar to the generated code when using a nested_query, but replaces the encoder with a custom one instead of just injecting it;
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := make(map[string]interface{})
 	natIpsProp, err := expandNestedComputeRouterNatAddressNatIps(d.Get("nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ips"); ok || !reflect.DeepEqual(v, natIpsProp) {
 		obj["natIps"] = natIpsProp
 	}
 	drainNatIpsProp, err := expandNestedComputeRouterNatAddressDrainNatIps(d.Get("drain_nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
 	nameProp, err := expandNestedComputeRouterNatAddressRouterNat(d.Get("router_nat"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("router_nat"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
@@ -313,26 +313,28 @@ func resourceComputeRouterNatAddressCreate(d *schema.ResourceData, meta interfac
 
 	obj, err = resourceComputeRouterNatAddressEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat_address", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNatAddress: %s", err)
+		return diag.Errorf("Error fetching project for RouterNatAddress: %s", err)
 	}
 	billingProject = project
 
@@ -353,13 +355,13 @@ func resourceComputeRouterNatAddressCreate(d *schema.ResourceData, meta interfac
 		Headers:   headers,
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating RouterNatAddress: %s", err)
+		return diag.Errorf("Error creating RouterNatAddress: %s", err)
 	}
 
 	// Store the ID now
 	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/regions/{{region}}/routers/{{router}}/{{router_nat}}")
 	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
+		return diag.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
 
@@ -370,12 +372,12 @@ func resourceComputeRouterNatAddressCreate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		// The resource didn't actually create
 		d.SetId("")
-		return fmt.Errorf("Error waiting to create RouterNatAddress: %s", err)
+		return diag.Errorf("Error waiting to create RouterNatAddress: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished creating RouterNatAddress %q: %#v", d.Id(), res)
 
-	return resourceComputeRouterNatAddressRead(d, meta)
+	return diag.FromErr(resourceComputeRouterNatAddressRead(d, meta))
 }
 
 func resourceComputeRouterNatAddressRead(d *schema.ResourceData, meta interface{}) error {
@@ -472,32 +474,32 @@ func resourceComputeRouterNatAddressRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	identity, err := d.Identity()
 	if err == nil && identity != nil {
 		if routerValue, ok := d.GetOk("router"); ok && routerValue.(string) != "" {
 			if err = identity.Set("router", routerValue.(string)); err != nil {
-				return fmt.Errorf("Error setting router: %s", err)
+				return diag.Errorf("Error setting router: %s", err)
 			}
 		}
 		if routerNatValue, ok := d.GetOk("router_nat"); ok && routerNatValue.(string) != "" {
 			if err = identity.Set("router_nat", routerNatValue.(string)); err != nil {
-				return fmt.Errorf("Error setting router_nat: %s", err)
+				return diag.Errorf("Error setting router_nat: %s", err)
 			}
 		}
 		if regionValue, ok := d.GetOk("region"); ok && regionValue.(string) != "" {
 			if err = identity.Set("region", regionValue.(string)); err != nil {
-				return fmt.Errorf("Error setting region: %s", err)
+				return diag.Errorf("Error setting region: %s", err)
 			}
 		}
 		if projectValue, ok := d.GetOk("project"); ok && projectValue.(string) != "" {
 			if err = identity.Set("project", projectValue.(string)); err != nil {
-				return fmt.Errorf("Error setting project: %s", err)
+				return diag.Errorf("Error setting project: %s", err)
 			}
 		}
 	} else {
@@ -508,39 +510,41 @@ func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interfac
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNatAddress: %s", err)
+		return diag.Errorf("Error fetching project for RouterNatAddress: %s", err)
 	}
 	billingProject = project
 
 	obj := make(map[string]interface{})
 	natIpsProp, err := expandNestedComputeRouterNatAddressNatIps(d.Get("nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("nat_ips"); ok || !reflect.DeepEqual(v, natIpsProp) {
 		obj["natIps"] = natIpsProp
 	}
 	drainNatIpsProp, err := expandNestedComputeRouterNatAddressDrainNatIps(d.Get("drain_nat_ips"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("drain_nat_ips"); ok || !reflect.DeepEqual(v, drainNatIpsProp) {
 		obj["drainNatIps"] = drainNatIpsProp
 	}
 
 	obj, err = resourceComputeRouterNatAddressUpdateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat_address", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{router}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating RouterNatAddress %q: %#v", d.Id(), obj)
@@ -548,7 +552,7 @@ func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interfac
 
 	obj, err = resourceComputeRouterNatAddressPatchUpdateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// err == nil indicates that the billing_project value was found
@@ -568,7 +572,7 @@ func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error updating RouterNatAddress %q: %s", d.Id(), err)
+		return diag.Errorf("Error updating RouterNatAddress %q: %s", d.Id(), err)
 	} else {
 		log.Printf("[DEBUG] Finished updating RouterNatAddress %q: %#v", d.Id(), res)
 	}
@@ -578,43 +582,45 @@ func resourceComputeRouterNatAddressUpdate(d *schema.ResourceData, meta interfac
 		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeRouterNatAddressRead(d, meta)
+	return diag.FromErr(resourceComputeRouterNatAddressRead(d, meta))
 }
 
-func resourceComputeRouterNatAddressDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterNatAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterNatAddress: %s", err)
+		return diag.Errorf("Error fetching project for RouterNatAddress: %s", err)
 	}
 	billingProject = project
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_nat_address", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, config), "projects/{{project}}/regions/{{region}}/routers/{{router}}"))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var obj map[string]interface{}
 
 	obj, err = resourceComputeRouterNatAddressPatchDeleteEncoder(d, meta, obj)
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterNatAddress")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterNatAddress"))
 	}
 
 	// err == nil indicates that the billing_project value was found
@@ -626,7 +632,7 @@ func resourceComputeRouterNatAddressDelete(d *schema.ResourceData, meta interfac
 	// Since RouterNatAddress reopresents only the natIps field, we must make sure we only remove this value and not the entire nat
 	obj, err = resourceComputeRouterNatAddressDeleteOnlyNatIps(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Deleting RouterNatAddress %q", d.Id())
@@ -641,7 +647,7 @@ func resourceComputeRouterNatAddressDelete(d *schema.ResourceData, meta interfac
 		Headers:   headers,
 	})
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterNatAddress")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterNatAddress"))
 	}
 
 	err = ComputeOperationWaitTime(
@@ -649,7 +655,7 @@ func resourceComputeRouterNatAddressDelete(d *schema.ResourceData, meta interfac
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting RouterNatAddress %q: %#v", d.Id(), res)
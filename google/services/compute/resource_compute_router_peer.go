@@ -17,6 +17,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -25,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -50,10 +52,10 @@ func ipv6RepresentationDiffSuppress(_, old, new string, d *schema.ResourceData)
 
 func ResourceComputeRouterBgpPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeRouterBgpPeerCreate,
-		Read:   resourceComputeRouterBgpPeerRead,
-		Update: resourceComputeRouterBgpPeerUpdate,
-		Delete: resourceComputeRouterBgpPeerDelete,
+		CreateContext: resourceComputeRouterBgpPeerCreate,
+		Read:          resourceComputeRouterBgpPeerRead,
+		UpdateContext: resourceComputeRouterBgpPeerUpdate,
+		DeleteContext: resourceComputeRouterBgpPeerDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterBgpPeerImport,
@@ -411,57 +413,57 @@ CIDR-formatted string.`,
 	}
 }
 
-func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterBgpPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	obj := make(map[string]interface{})
 	nameProp, err := expandNestedComputeRouterBgpPeerName(d.Get("name"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
 	interfaceNameProp, err := expandNestedComputeRouterBgpPeerInterface(d.Get("interface"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("interface"); !tpgresource.IsEmptyValue(reflect.ValueOf(interfaceNameProp)) && (ok || !reflect.DeepEqual(v, interfaceNameProp)) {
 		obj["interfaceName"] = interfaceNameProp
 	}
 	ipAddressProp, err := expandNestedComputeRouterBgpPeerIpAddress(d.Get("ip_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ip_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(ipAddressProp)) && (ok || !reflect.DeepEqual(v, ipAddressProp)) {
 		obj["ipAddress"] = ipAddressProp
 	}
 	peerIpAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpAddress(d.Get("peer_ip_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ip_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(peerIpAddressProp)) && (ok || !reflect.DeepEqual(v, peerIpAddressProp)) {
 		obj["peerIpAddress"] = peerIpAddressProp
 	}
 	peerAsnProp, err := expandNestedComputeRouterBgpPeerPeerAsn(d.Get("peer_asn"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_asn"); !tpgresource.IsEmptyValue(reflect.ValueOf(peerAsnProp)) && (ok || !reflect.DeepEqual(v, peerAsnProp)) {
 		obj["peerAsn"] = peerAsnProp
 	}
 	advertisedRoutePriorityProp, err := expandNestedComputeRouterBgpPeerAdvertisedRoutePriority(d.Get("advertised_route_priority"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_route_priority"); ok || !reflect.DeepEqual(v, advertisedRoutePriorityProp) {
 		if !d.Get("zero_advertised_route_priority").(bool) && advertisedRoutePriorityProp == 0 {
 			// Add the condition to check the present value
 			if !d.Get("is_advertised_route_priority_set").(bool) {
 				log.Printf("[WARN] advertised_route_priority can't be 0 unless zero_advertised_route_priority set to true")
 			} else {
-				return fmt.Errorf("Invalid advertised_route_priority value: When zero_advertised_route_priority is set to 'false', the advertised_route_priority field cannot be 0. Please provide a non-zero value.")
+				return diag.Errorf("Invalid advertised_route_priority value: When zero_advertised_route_priority is set to 'false', the advertised_route_priority field cannot be 0. Please provide a non-zero value.")
 			}
 		} else if d.Get("zero_advertised_route_priority").(bool) && advertisedRoutePriorityProp != 0 {
-			return fmt.Errorf("[ERROR] advertised_route_priority cannot be set to value other than zero unless zero_advertised_route_priority is false")
+			return diag.Errorf("[ERROR] advertised_route_priority cannot be set to value other than zero unless zero_advertised_route_priority is false")
 		} else {
 			obj["advertisedRoutePriority"] = advertisedRoutePriorityProp
 			d.Set("is_advertised_route_priority_set", true)
@@ -469,41 +471,41 @@ func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}
 	}
 	advertiseModeProp, err := expandNestedComputeRouterBgpPeerAdvertiseMode(d.Get("advertise_mode"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertise_mode"); !tpgresource.IsEmptyValue(reflect.ValueOf(advertiseModeProp)) && (ok || !reflect.DeepEqual(v, advertiseModeProp)) {
 		obj["advertiseMode"] = advertiseModeProp
 	}
 	advertisedGroupsProp, err := expandNestedComputeRouterBgpPeerAdvertisedGroups(d.Get("advertised_groups"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_groups"); ok || !reflect.DeepEqual(v, advertisedGroupsProp) {
 		obj["advertisedGroups"] = advertisedGroupsProp
 	}
 	advertisedIpRangesProp, err := expandNestedComputeRouterBgpPeerAdvertisedIpRanges(d.Get("advertised_ip_ranges"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_ip_ranges"); ok || !reflect.DeepEqual(v, advertisedIpRangesProp) {
 		obj["advertisedIpRanges"] = advertisedIpRangesProp
 	}
 	customLearnedIpRangesProp, err := expandNestedComputeRouterBgpPeerCustomLearnedIpRanges(d.Get("custom_learned_ip_ranges"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("custom_learned_ip_ranges"); ok || !reflect.DeepEqual(v, customLearnedIpRangesProp) {
 		obj["customLearnedIpRanges"] = customLearnedIpRangesProp
 	}
 	customLearnedRoutePriorityProp, err := expandNestedComputeRouterBgpPeerCustomLearnedRoutePriority(d.Get("custom_learned_route_priority"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("custom_learned_route_priority"); ok || !reflect.DeepEqual(v, customLearnedRoutePriorityProp) {
 		if !d.Get("zero_custom_learned_route_priority").(bool) && customLearnedRoutePriorityProp == 0 {
 			// Add the condition to check the present value
 			if !d.Get("is_custom_learned_priority_set").(bool) {
 				log.Printf("[WARN] custom_learned_route_priority can't be 0 unless zero_custom_learned_route_priority set to true")
 			} else {
-				return fmt.Errorf("Invalid custom_learned_route_priority value: When zero_custom_learned_route_priority is set to 'false', the custom_learned_route_priority field cannot be 0. Please provide a non-zero value.")
+				return diag.Errorf("Invalid custom_learned_route_priority value: When zero_custom_learned_route_priority is set to 'false', the custom_learned_route_priority field cannot be 0. Please provide a non-zero value.")
 			}
 		} else if d.Get("zero_custom_learned_route_priority").(bool) && customLearnedRoutePriorityProp != 0 {
-			return fmt.Errorf("[ERROR] custom_learned_route_priority cannot be set to value other than zero unless zero_custom_learned_route_priority is false")
+			return diag.Errorf("[ERROR] custom_learned_route_priority cannot be set to value other than zero unless zero_custom_learned_route_priority is false")
 		} else {
 			obj["customLearnedRoutePriority"] = customLearnedRoutePriorityProp
 			d.Set("is_custom_learned_priority_set", true)
@@ -511,73 +513,73 @@ func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}
 	}
 	bfdProp, err := expandNestedComputeRouterBgpPeerBfd(d.Get("bfd"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("bfd"); !tpgresource.IsEmptyValue(reflect.ValueOf(bfdProp)) && (ok || !reflect.DeepEqual(v, bfdProp)) {
 		obj["bfd"] = bfdProp
 	}
 	enableProp, err := expandNestedComputeRouterBgpPeerEnable(d.Get("enable"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable"); ok || !reflect.DeepEqual(v, enableProp) {
 		obj["enable"] = enableProp
 	}
 	routerApplianceInstanceProp, err := expandNestedComputeRouterBgpPeerRouterApplianceInstance(d.Get("router_appliance_instance"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("router_appliance_instance"); !tpgresource.IsEmptyValue(reflect.ValueOf(routerApplianceInstanceProp)) && (ok || !reflect.DeepEqual(v, routerApplianceInstanceProp)) {
 		obj["routerApplianceInstance"] = routerApplianceInstanceProp
 	}
 	enableIpv6Prop, err := expandNestedComputeRouterBgpPeerEnableIpv6(d.Get("enable_ipv6"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_ipv6"); ok || !reflect.DeepEqual(v, enableIpv6Prop) {
 		obj["enableIpv6"] = enableIpv6Prop
 	}
 	enableIpv4Prop, err := expandNestedComputeRouterBgpPeerEnableIpv4(d.Get("enable_ipv4"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_ipv4"); ok || !reflect.DeepEqual(v, enableIpv4Prop) {
 		obj["enableIpv4"] = enableIpv4Prop
 	}
 	ipv4NexthopAddressProp, err := expandNestedComputeRouterBgpPeerIpv4NexthopAddress(d.Get("ipv4_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ipv4_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(ipv4NexthopAddressProp)) && (ok || !reflect.DeepEqual(v, ipv4NexthopAddressProp)) {
 		obj["ipv4NexthopAddress"] = ipv4NexthopAddressProp
 	}
 	peerIpv4NexthopAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpv4NexthopAddress(d.Get("peer_ipv4_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ipv6_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(peerIpv4NexthopAddressProp)) && (ok || !reflect.DeepEqual(v, peerIpv4NexthopAddressProp)) {
 		obj["peerIpv4NexthopAddress"] = peerIpv4NexthopAddressProp
 	}
 	exportPoliciesProp, err := expandNestedComputeRouterBgpPeerExportPolicies(d.Get("export_policies"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("export_policies"); ok || !reflect.DeepEqual(v, exportPoliciesProp) {
 		obj["exportPolicies"] = exportPoliciesProp
 	}
 	importPoliciesProp, err := expandNestedComputeRouterBgpPeerImportPolicies(d.Get("import_policies"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("import_policies"); ok || !reflect.DeepEqual(v, importPoliciesProp) {
 		obj["importPolicies"] = importPoliciesProp
 	}
 	ipv6NexthopAddressProp, err := expandNestedComputeRouterBgpPeerIpv6NexthopAddress(d.Get("ipv6_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ipv6_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(ipv6NexthopAddressProp)) && (ok || !reflect.DeepEqual(v, ipv6NexthopAddressProp)) {
 		obj["ipv6NexthopAddress"] = ipv6NexthopAddressProp
 	}
 	peerIpv6NexthopAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpv6NexthopAddress(d.Get("peer_ipv6_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ipv6_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(peerIpv6NexthopAddressProp)) && (ok || !reflect.DeepEqual(v, peerIpv6NexthopAddressProp)) {
 		obj["peerIpv6NexthopAddress"] = peerIpv6NexthopAddressProp
 	}
 	md5AuthenticationKeyProp, err := expandNestedComputeRouterBgpPeerMd5AuthenticationKey(d.Get("md5_authentication_key"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("md5_authentication_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(md5AuthenticationKeyProp)) && (ok || !reflect.DeepEqual(v, md5AuthenticationKeyProp)) {
 		/*some manual handling is required here as the parent cloud router object has a different layout for keyName and keyValue.
 		bgpPeer blocks in cloud router only specify the keyName to be used and the cloudRouter object has another block called
@@ -591,27 +593,29 @@ func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_peer", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Creating new RouterBgpPeer: %#v", obj)
 
 	obj, err = resourceComputeRouterBgpPeerPatchCreateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterBgpPeer: %s", err)
+		return diag.Errorf("Error fetching project for RouterBgpPeer: %s", err)
 	}
 	billingProject = project
 
@@ -630,13 +634,13 @@ func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}
 		Timeout:   d.Timeout(schema.TimeoutCreate),
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating RouterBgpPeer: %s", err)
+		return diag.Errorf("Error creating RouterBgpPeer: %s", err)
 	}
 
 	// Store the ID now
 	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/regions/{{region}}/routers/{{router}}/{{name}}")
 	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
+		return diag.Errorf("Error constructing id: %s", err)
 	}
 	d.SetId(id)
 
@@ -647,17 +651,17 @@ func resourceComputeRouterBgpPeerCreate(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		// The resource didn't actually create
 		d.SetId("")
-		return fmt.Errorf("Error waiting to create RouterBgpPeer: %s", err)
+		return diag.Errorf("Error waiting to create RouterBgpPeer: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished creating RouterBgpPeer %q: %#v", d.Id(), res)
 
 	err = d.Set("md5_authentication_key", []interface{}{md5AuthenticationKeyProp})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeRouterBgpPeerRead(d, meta)
+	return diag.FromErr(resourceComputeRouterBgpPeerRead(d, meta))
 }
 
 func resourceComputeRouterBgpPeerRead(d *schema.ResourceData, meta interface{}) error {
@@ -788,53 +792,53 @@ func resourceComputeRouterBgpPeerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterBgpPeerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterBgpPeer: %s", err)
+		return diag.Errorf("Error fetching project for RouterBgpPeer: %s", err)
 	}
 	billingProject = project
 
 	obj := make(map[string]interface{})
 	ipAddressProp, err := expandNestedComputeRouterBgpPeerIpAddress(d.Get("ip_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ip_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, ipAddressProp)) {
 		obj["ipAddress"] = ipAddressProp
 	}
 	peerIpAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpAddress(d.Get("peer_ip_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ip_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, peerIpAddressProp)) {
 		obj["peerIpAddress"] = peerIpAddressProp
 	}
 	peerAsnProp, err := expandNestedComputeRouterBgpPeerPeerAsn(d.Get("peer_asn"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_asn"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, peerAsnProp)) {
 		obj["peerAsn"] = peerAsnProp
 	}
 	advertisedRoutePriorityProp, err := expandNestedComputeRouterBgpPeerAdvertisedRoutePriority(d.Get("advertised_route_priority"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_route_priority"); ok || !reflect.DeepEqual(v, advertisedRoutePriorityProp) {
 		if !d.Get("zero_advertised_route_priority").(bool) && advertisedRoutePriorityProp == 0 {
 			// Add the condition to check the present value
 			if !d.Get("is_advertised_route_priority_set").(bool) {
 				log.Printf("[WARN] advertised_route_priority can't be 0 unless zero_advertised_route_priority set to true")
 			} else {
-				return fmt.Errorf("Invalid advertised_route_priority value: When zero_advertised_route_priority is set to 'false', the advertised_route_priority field cannot be 0. Please provide a non-zero value.")
+				return diag.Errorf("Invalid advertised_route_priority value: When zero_advertised_route_priority is set to 'false', the advertised_route_priority field cannot be 0. Please provide a non-zero value.")
 			}
 		} else if d.Get("zero_advertised_route_priority").(bool) && advertisedRoutePriorityProp != 0 {
-			return fmt.Errorf("[ERROR] advertised_route_priority cannot be set to value other than zero unless zero_advertised_route_priority is false")
+			return diag.Errorf("[ERROR] advertised_route_priority cannot be set to value other than zero unless zero_advertised_route_priority is false")
 		} else {
 			obj["advertisedRoutePriority"] = advertisedRoutePriorityProp
 			d.Set("is_advertised_route_priority_set", true)
@@ -842,41 +846,41 @@ func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}
 	}
 	advertiseModeProp, err := expandNestedComputeRouterBgpPeerAdvertiseMode(d.Get("advertise_mode"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertise_mode"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, advertiseModeProp)) {
 		obj["advertiseMode"] = advertiseModeProp
 	}
 	advertisedGroupsProp, err := expandNestedComputeRouterBgpPeerAdvertisedGroups(d.Get("advertised_groups"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_groups"); ok || !reflect.DeepEqual(v, advertisedGroupsProp) {
 		obj["advertisedGroups"] = advertisedGroupsProp
 	}
 	advertisedIpRangesProp, err := expandNestedComputeRouterBgpPeerAdvertisedIpRanges(d.Get("advertised_ip_ranges"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("advertised_ip_ranges"); ok || !reflect.DeepEqual(v, advertisedIpRangesProp) {
 		obj["advertisedIpRanges"] = advertisedIpRangesProp
 	}
 	customLearnedIpRangesProp, err := expandNestedComputeRouterBgpPeerCustomLearnedIpRanges(d.Get("custom_learned_ip_ranges"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("custom_learned_ip_ranges"); ok || !reflect.DeepEqual(v, customLearnedIpRangesProp) {
 		obj["customLearnedIpRanges"] = customLearnedIpRangesProp
 	}
 	customLearnedRoutePriorityProp, err := expandNestedComputeRouterBgpPeerCustomLearnedRoutePriority(d.Get("custom_learned_route_priority"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("custom_learned_route_priority"); ok || !reflect.DeepEqual(v, customLearnedRoutePriorityProp) {
 		if !d.Get("zero_custom_learned_route_priority").(bool) && customLearnedRoutePriorityProp == 0 {
 			// Add the condition to check the present value
 			if !d.Get("is_custom_learned_priority_set").(bool) {
 				log.Printf("[WARN] custom_learned_route_priority can't be 0 unless zero_custom_learned_route_priority set to true")
 			} else {
-				return fmt.Errorf("Invalid custom_learned_route_priority value: When zero_custom_learned_route_priority is set to 'false', the custom_learned_route_priority field cannot be 0. Please provide a non-zero value.")
+				return diag.Errorf("Invalid custom_learned_route_priority value: When zero_custom_learned_route_priority is set to 'false', the custom_learned_route_priority field cannot be 0. Please provide a non-zero value.")
 			}
 		} else if d.Get("zero_custom_learned_route_priority").(bool) && customLearnedRoutePriorityProp != 0 {
-			return fmt.Errorf("[ERROR] custom_learned_route_priority cannot be set to value other than zero unless zero_custom_learned_route_priority is false")
+			return diag.Errorf("[ERROR] custom_learned_route_priority cannot be set to value other than zero unless zero_custom_learned_route_priority is false")
 		} else {
 			obj["customLearnedRoutePriority"] = customLearnedRoutePriorityProp
 			d.Set("is_custom_learned_priority_set", true)
@@ -884,73 +888,73 @@ func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}
 	}
 	bfdProp, err := expandNestedComputeRouterBgpPeerBfd(d.Get("bfd"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("bfd"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, bfdProp)) {
 		obj["bfd"] = bfdProp
 	}
 	enableProp, err := expandNestedComputeRouterBgpPeerEnable(d.Get("enable"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable"); ok || !reflect.DeepEqual(v, enableProp) {
 		obj["enable"] = enableProp
 	}
 	routerApplianceInstanceProp, err := expandNestedComputeRouterBgpPeerRouterApplianceInstance(d.Get("router_appliance_instance"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("router_appliance_instance"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, routerApplianceInstanceProp)) {
 		obj["routerApplianceInstance"] = routerApplianceInstanceProp
 	}
 	enableIpv6Prop, err := expandNestedComputeRouterBgpPeerEnableIpv6(d.Get("enable_ipv6"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_ipv6"); ok || !reflect.DeepEqual(v, enableIpv6Prop) {
 		obj["enableIpv6"] = enableIpv6Prop
 	}
 	enableIpv4Prop, err := expandNestedComputeRouterBgpPeerEnableIpv4(d.Get("enable_ipv4"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("enable_ipv4"); ok || !reflect.DeepEqual(v, enableIpv4Prop) {
 		obj["enableIpv4"] = enableIpv4Prop
 	}
 	ipv4NexthopAddressProp, err := expandNestedComputeRouterBgpPeerIpv4NexthopAddress(d.Get("ipv4_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ipv4_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(ipv4NexthopAddressProp)) && (ok || !reflect.DeepEqual(v, ipv4NexthopAddressProp)) {
 		obj["ipv4NexthopAddress"] = ipv4NexthopAddressProp
 	}
 	peerIpv4NexthopAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpv4NexthopAddress(d.Get("peer_ipv4_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ipv4_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, peerIpv4NexthopAddressProp)) {
 		obj["peerIpv4NexthopAddress"] = peerIpv4NexthopAddressProp
 	}
 	exportPoliciesProp, err := expandNestedComputeRouterBgpPeerExportPolicies(d.Get("export_policies"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("export_policies"); ok || !reflect.DeepEqual(v, exportPoliciesProp) {
 		obj["exportPolicies"] = exportPoliciesProp
 	}
 	importPoliciesProp, err := expandNestedComputeRouterBgpPeerImportPolicies(d.Get("import_policies"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("import_policies"); ok || !reflect.DeepEqual(v, importPoliciesProp) {
 		obj["importPolicies"] = importPoliciesProp
 	}
 	ipv6NexthopAddressProp, err := expandNestedComputeRouterBgpPeerIpv6NexthopAddress(d.Get("ipv6_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("ipv6_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, ipv6NexthopAddressProp)) {
 		obj["ipv6NexthopAddress"] = ipv6NexthopAddressProp
 	}
 	peerIpv6NexthopAddressProp, err := expandNestedComputeRouterBgpPeerPeerIpv6NexthopAddress(d.Get("peer_ipv6_nexthop_address"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("peer_ipv6_nexthop_address"); !tpgresource.IsEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, peerIpv6NexthopAddressProp)) {
 		obj["peerIpv6NexthopAddress"] = peerIpv6NexthopAddressProp
 	}
 	md5AuthenticationKeyProp, err := expandNestedComputeRouterBgpPeerMd5AuthenticationKey(d.Get("md5_authentication_key"), d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	} else if v, ok := d.GetOkExists("md5_authentication_key"); !tpgresource.IsEmptyValue(reflect.ValueOf(md5AuthenticationKeyProp)) && (ok || !reflect.DeepEqual(v, md5AuthenticationKeyProp)) {
 		/*some manual handling is required here as the parent cloud router object has a different layout for keyName and keyValue.
 		bgpPeer blocks in cloud router only specify the keyName to be used and the cloudRouter object has another block called
@@ -964,21 +968,23 @@ func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_peer", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating RouterBgpPeer %q: %#v", d.Id(), obj)
 
 	obj, err = resourceComputeRouterBgpPeerPatchUpdateEncoder(d, meta, obj)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// err == nil indicates that the billing_project value was found
@@ -997,7 +1003,7 @@ func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		return fmt.Errorf("Error updating RouterBgpPeer %q: %s", d.Id(), err)
+		return diag.Errorf("Error updating RouterBgpPeer %q: %s", d.Id(), err)
 	} else {
 		log.Printf("[DEBUG] Finished updating RouterBgpPeer %q: %#v", d.Id(), res)
 	}
@@ -1007,49 +1013,51 @@ func resourceComputeRouterBgpPeerUpdate(d *schema.ResourceData, meta interface{}
 		d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("md5_authentication_key", []interface{}{md5AuthenticationKeyProp})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceComputeRouterBgpPeerRead(d, meta)
+	return diag.FromErr(resourceComputeRouterBgpPeerRead(d, meta))
 }
 
-func resourceComputeRouterBgpPeerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceComputeRouterBgpPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return fmt.Errorf("Error fetching project for RouterBgpPeer: %s", err)
+		return diag.Errorf("Error fetching project for RouterBgpPeer: %s", err)
 	}
 	billingProject = project
 
 	lockName, err := tpgresource.ReplaceVars(d, config, "router/{{region}}/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, lockName, tpgresource.LockHolder("google_compute_router_peer", d)); err != nil {
+		return diag.FromErr(err)
 	}
-	transport_tpg.MutexStore.Lock(lockName)
 	defer transport_tpg.MutexStore.Unlock(lockName)
 
 	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}projects/{{project}}/regions/{{region}}/routers/{{router}}")
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var obj map[string]interface{}
 
 	obj, err = resourceComputeRouterBgpPeerPatchDeleteEncoder(d, meta, obj)
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterBgpPeer")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterBgpPeer"))
 	}
 	log.Printf("[DEBUG] Deleting RouterBgpPeer %q", d.Id())
 
@@ -1068,7 +1076,7 @@ func resourceComputeRouterBgpPeerDelete(d *schema.ResourceData, meta interface{}
 		Timeout:   d.Timeout(schema.TimeoutDelete),
 	})
 	if err != nil {
-		return transport_tpg.HandleNotFoundError(err, d, "RouterBgpPeer")
+		return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, "RouterBgpPeer"))
 	}
 
 	err = ComputeOperationWaitTime(
@@ -1076,7 +1084,7 @@ func resourceComputeRouterBgpPeerDelete(d *schema.ResourceData, meta interface{}
 		d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting RouterBgpPeer %q: %#v", d.Id(), res)
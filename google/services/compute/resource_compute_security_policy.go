@@ -913,11 +913,11 @@ func resourceComputeSecurityPolicyRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting recaptcha_options_config: %s", err)
 	}
 
-	if err := tpgresource.SetLabels(securityPolicy.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(securityPolicy.Labels, d, "labels", config); err != nil {
 		return err
 	}
 
-	if err := tpgresource.SetLabels(securityPolicy.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(securityPolicy.Labels, d, "terraform_labels", config); err != nil {
 		return err
 	}
 
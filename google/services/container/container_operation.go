@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
@@ -109,6 +110,52 @@ func (w *ContainerOperationWaiter) QueryOp() (interface{}, error) {
 	return op, err
 }
 
+func (w *ContainerOperationWaiter) WaitContext() context.Context {
+	return w.Context
+}
+
+func (w *ContainerOperationWaiter) CancelOp() error {
+	name := fmt.Sprintf("projects/%s/locations/%s/operations/%s",
+		w.Project, w.Location, w.Op.Name)
+	cancelCall := w.Service.Projects.Locations.Operations.Cancel(name, &container.CancelOperationRequest{})
+	if w.UserProjectOverride {
+		cancelCall.Header().Add("X-Goog-User-Project", w.Project)
+	}
+	_, err := cancelCall.Do()
+	return err
+}
+
+// Progress returns the stage the operation is running and, for operations
+// on nodes, the percent of nodes done.
+func (w *ContainerOperationWaiter) Progress() tpgresource.OperationProgress {
+	p := tpgresource.OperationProgress{Percent: -1}
+	if w == nil || w.Op == nil || w.Op.Progress == nil {
+		return p
+	}
+	progress := w.Op.Progress
+	for _, stage := range progress.Stages {
+		if stage.Status == "RUNNING" {
+			progress = stage
+			break
+		}
+	}
+	p.Stage = progress.Name
+
+	var done, total int64
+	for _, m := range progress.Metrics {
+		switch strings.ToLower(m.Name) {
+		case "nodes done":
+			done = m.IntValue
+		case "nodes total":
+			total = m.IntValue
+		}
+	}
+	if total > 0 {
+		p.Percent = int(done * 100 / total)
+	}
+	return p
+}
+
 func (w *ContainerOperationWaiter) OpName() string {
 	if w == nil || w.Op == nil {
 		return "<nil>"
@@ -138,5 +185,5 @@ func ContainerOperationWait(config *transport_tpg.Config, op *container.Operatio
 		return err
 	}
 
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
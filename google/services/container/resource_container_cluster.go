@@ -2969,6 +2969,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 			op, err = clusterCreateCall.Do()
 			return err
 		},
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return err
@@ -3025,6 +3026,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 				op, err = clusterNodePoolDeleteCall.Do()
 				return err
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return errwrap.Wrapf("Error deleting default node pool: {{err}}", err)
@@ -3068,6 +3070,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 				op, err = clusterUpdateCall.Do()
 				return err
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return errwrap.Wrapf(fmt.Sprintf("Error updating cluster for %v: {{err}}", update.ForceSendFields), err)
@@ -3100,6 +3103,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 				op, err = clusterUpdateCall.Do()
 				return err
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return errwrap.Wrapf("Error updating AdditionalPodRangesConfig: {{err}}", err)
@@ -3128,6 +3132,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 				op, err = clusterUpdateCall.Do()
 				return err
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return errwrap.Wrapf("Error updating LinuxNodeConfig: {{err}}", err)
@@ -3443,10 +3448,10 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := tpgresource.SetLabels(cluster.ResourceLabels, d, "resource_labels"); err != nil {
+	if err := tpgresource.SetLabels(cluster.ResourceLabels, d, "resource_labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
-	if err := tpgresource.SetLabels(cluster.ResourceLabels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(cluster.ResourceLabels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", cluster.ResourceLabels); err != nil {
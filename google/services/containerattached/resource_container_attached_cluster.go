@@ -124,6 +124,15 @@ func ResourceContainerAttachedCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceContainerAttachedClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceContainerAttachedClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -431,6 +440,12 @@ this is an Azure region.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"errors": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -1868,3 +1883,371 @@ func ResourceContainerAttachedClusterFlatten(d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+func resourceContainerAttachedClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"distribution": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The Kubernetes distribution of the underlying attached cluster. Supported values:
+"eks", "aks", "generic". The generic distribution provides the ability to register
+or migrate any CNCF conformant cluster.`,
+			},
+			"fleet": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Fleet configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidateRegexp(`^projects/[0-9]+$`),
+							Description:  `The number of the Fleet host project where this cluster will be registered.`,
+						},
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The name of the managed Hub Membership resource associated to this
+cluster. Membership names are formatted as
+projects/<project-number>/locations/global/membership/<cluster-id>.`,
+						},
+					},
+				},
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location for the resource`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of this resource.`,
+			},
+			"oidc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				Description: `OIDC discovery information of the target cluster.
+
+Kubernetes Service Account (KSA) tokens are JWT tokens signed by the cluster
+API server. This fields indicates how GCP services
+validate KSA tokens in order to allow system workloads (such as GKE Connect
+and telemetry agents) to authenticate back to GCP.
+
+Both clusters with public and private issuer URLs are supported.
+Clusters with public issuers only need to specify the 'issuer_url' field
+while clusters with private issuers need to provide both
+'issuer_url' and 'jwks'.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `A JSON Web Token (JWT) issuer URI. 'issuer' must start with 'https://'`,
+						},
+						"jwks": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `OIDC verification keys in JWKS format (RFC 7517).`,
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The platform version for the cluster (e.g. '1.23.0-gke.1').`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. Annotations on the cluster. This field has the same
+restrictions as Kubernetes annotations. The total size of all keys and
+values combined is limited to 256k. Key can have 2 segments: prefix (optional)
+and name (required), separated by a slash (/). Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"authorization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration related to the cluster RBAC settings.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_groups": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Groups that can perform operations as a cluster admin. A managed
+ClusterRoleBinding will be created to grant the 'cluster-admin' ClusterRole
+to the groups. Up to ten admin groups can be provided.
+
+For more info on RBAC, see
+https://kubernetes.io/docs/reference/access-authn-authz/rbac/#user-facing-roles`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"admin_users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Users that can perform operations as a cluster admin. A managed
+ClusterRoleBinding will be created to grant the 'cluster-admin' ClusterRole
+to the users. Up to ten admin users can be provided.
+
+For more info on RBAC, see
+https://kubernetes.io/docs/reference/access-authn-authz/rbac/#user-facing-roles`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"binary_authorization": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Binary Authorization configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluation_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"DISABLED", "PROJECT_SINGLETON_POLICY_ENFORCE", ""}),
+							Description:  `Configure Binary Authorization evaluation mode. Possible values: ["DISABLED", "PROJECT_SINGLETON_POLICY_ENFORCE"]`,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `A human readable description of this attached cluster. Cannot be longer
+than 255 UTF-8 encoded bytes.`,
+			},
+			"logging_config": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressAttachedClustersLoggingConfigDiff,
+				Description:      `Logging configuration.`,
+				MaxItems:         1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `The configuration of the logging components`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_components": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `The components to be enabled. Possible values: ["SYSTEM_COMPONENTS", "WORKLOADS"]`,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidateEnum([]string{"SYSTEM_COMPONENTS", "WORKLOADS"}),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"monitoring_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Monitoring configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_prometheus_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Enable Google Cloud Managed Service for Prometheus in the cluster.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Enable Managed Collection.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"proxy_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Support for proxy configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kubernetes_secret": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `The Kubernetes Secret resource that contains the HTTP(S) proxy configuration.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Name of the kubernetes secret containing the proxy config.`,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Namespace of the kubernetes secret containing the proxy config.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"security_posture_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Deprecated:  "`security_posture_config` is deprecated and will be removed in a future major release.",
+				Description: `Enable/Disable Security Posture API features for the cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vulnerability_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"VULNERABILITY_DISABLED", "VULNERABILITY_ENTERPRISE"}),
+							Description:  `Sets the mode of the Kubernetes security posture API's workload vulnerability scanning. Possible values: ["VULNERABILITY_DISABLED", "VULNERABILITY_ENTERPRISE"]`,
+						},
+					},
+				},
+			},
+			"cluster_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Output only. The region where this cluster runs.
+
+For EKS clusters, this is an AWS region. For AKS clusters,
+this is an Azure region.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The time at which this cluster was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `A set of errors found in the cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Human-friendly description of the error.`,
+						},
+					},
+				},
+			},
+			"kubernetes_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The Kubernetes version of the cluster.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the cluster.`,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The current state of the cluster. Possible values:
+STATE_UNSPECIFIED, PROVISIONING, RUNNING, RECONCILING, STOPPING, ERROR,
+DEGRADED`,
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A globally unique identifier for the cluster.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time at which this cluster was last updated.`,
+			},
+			"workload_identity_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Workload Identity settings.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The ID of the OIDC Identity Provider (IdP) associated to
+the Workload Identity Pool.`,
+						},
+						"issuer_uri": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The OIDC issuer URL for this cluster.`,
+						},
+						"workload_pool": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The Workload Identity Pool associated to the cluster.`,
+						},
+					},
+				},
+			},
+			"deletion_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Policy to determine what flags to send on delete. Possible values: DELETE, DELETE_IGNORE_ERRORS`,
+				Default:     "DELETE",
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceContainerAttachedClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
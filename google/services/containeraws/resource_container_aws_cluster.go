@@ -46,6 +46,16 @@ func ResourceContainerAwsCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceContainerAwsClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceContainerAwsClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetAnnotationsDiff,
@@ -126,6 +136,11 @@ func ResourceContainerAwsCluster() *schema.Resource {
 				Computed:    true,
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+			},
 
 			"project": {
 				Type:             schema.TypeString,
@@ -1393,3 +1408,153 @@ func init() {
 		Schema:      ResourceContainerAwsCluster(),
 	}.Register()
 }
+
+func resourceContainerAwsClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"authorization": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Configuration related to the cluster RBAC settings.",
+				MaxItems:    1,
+				Elem:        ContainerAwsClusterAuthorizationSchema(),
+			},
+
+			"aws_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The AWS region where the cluster runs. Each Google Cloud region supports a subset of nearby AWS regions. You can call to list all supported AWS regions within a given Google Cloud region.",
+			},
+
+			"control_plane": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Configuration related to the cluster control plane.",
+				MaxItems:    1,
+				Elem:        ContainerAwsClusterControlPlaneSchema(),
+			},
+
+			"fleet": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Fleet configuration.",
+				MaxItems:    1,
+				Elem:        ContainerAwsClusterFleetSchema(),
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location for the resource",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of this resource.",
+			},
+
+			"networking": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Cluster-wide networking configuration.",
+				MaxItems:    1,
+				Elem:        ContainerAwsClusterNetworkingSchema(),
+			},
+
+			"binary_authorization": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: "Configuration options for the Binary Authorization feature.",
+				MaxItems:    1,
+				Elem:        ContainerAwsClusterBinaryAuthorizationSchema(),
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. A human readable description of this cluster. Cannot be longer than 255 UTF-8 encoded bytes.",
+			},
+
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
+			},
+
+			"project": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      "The project for the resource",
+			},
+
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Optional. Annotations on the cluster. This field has the same restrictions as Kubernetes annotations. The total size of all keys and values combined is limited to 256k. Key can have 2 segments: prefix (optional) and name (required), separated by a slash (/). Prefix must be a DNS subdomain. Name must be 63 characters or less, begin and end with alphanumerics, with dashes (-), underscores (_), dots (.), and alphanumerics between.\n\n**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.\nPlease refer to the field `effective_annotations` for all of the annotations present on the resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The time at which this cluster was created.",
+			},
+
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The endpoint of the cluster's API server.",
+			},
+
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Allows clients to perform consistent read-modify-writes through optimistic concurrency control. May be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.",
+			},
+
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Output only. If set, there are currently changes in flight to the cluster.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The current state of the cluster. Possible values: STATE_UNSPECIFIED, PROVISIONING, RUNNING, RECONCILING, STOPPING, ERROR, DEGRADED",
+			},
+
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. A globally unique identifier for the cluster.",
+			},
+
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The time at which this cluster was last updated.",
+			},
+
+			"workload_identity_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Output only. Workload Identity settings.",
+				Elem:        ContainerAwsClusterWorkloadIdentityConfigSchema(),
+			},
+		},
+	}
+}
+
+func ResourceContainerAwsClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -46,6 +46,16 @@ func ResourceContainerAwsNodePool() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceContainerAwsNodePoolResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceContainerAwsNodePoolUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetAnnotationsDiff,
@@ -117,6 +127,11 @@ func ResourceContainerAwsNodePool() *schema.Resource {
 				Computed:    true,
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+			},
 
 			"kubelet_config": {
 				Type:        schema.TypeList,
@@ -1263,3 +1278,159 @@ func init() {
 		Schema:      ResourceContainerAwsNodePool(),
 	}.Register()
 }
+
+func resourceContainerAwsNodePoolResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"autoscaling": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Autoscaler configuration for this node pool.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolAutoscalingSchema(),
+			},
+
+			"cluster": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      "The awsCluster for the resource",
+			},
+
+			"config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The configuration of the node pool.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolConfigSchema(),
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location for the resource",
+			},
+
+			"max_pods_constraint": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The constraint on the maximum number of pods that can be run simultaneously on a node in the node pool.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolMaxPodsConstraintSchema(),
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of this resource.",
+			},
+
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subnet where the node pool node run.",
+			},
+
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kubernetes version to run on this node pool (e.g. `1.19.10-gke.1000`). You can list all supported versions on a given Google Cloud region by calling GetAwsServerConfig.",
+			},
+
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
+			},
+
+			"kubelet_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The kubelet configuration for the node pool.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolKubeletConfigSchema(),
+			},
+
+			"management": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: "The Management configuration for this node pool.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolManagementSchema(),
+			},
+
+			"project": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      "The project for the resource",
+			},
+
+			"update_settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: "Optional. Update settings control the speed and disruption of the node pool update.",
+				MaxItems:    1,
+				Elem:        ContainerAwsNodePoolUpdateSettingsSchema(),
+			},
+
+			"annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Optional. Annotations on the node pool. This field has the same restrictions as Kubernetes annotations. The total size of all keys and values combined is limited to 256k. Key can have 2 segments: prefix (optional) and name (required), separated by a slash (/). Prefix must be a DNS subdomain. Name must be 63 characters or less, begin and end with alphanumerics, with dashes (-), underscores (_), dots (.), and alphanumerics between.\n\n**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.\nPlease refer to the field `effective_annotations` for all of the annotations present on the resource.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The time at which this node pool was created.",
+			},
+
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Allows clients to perform consistent read-modify-writes through optimistic concurrency control. May be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.",
+			},
+
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Output only. If set, there are currently changes in flight to the node pool.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The lifecycle state of the node pool. Possible values: STATE_UNSPECIFIED, PROVISIONING, RUNNING, RECONCILING, STOPPING, ERROR, DEGRADED",
+			},
+
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. A globally unique identifier for the node pool.",
+			},
+
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Output only. The time at which this node pool was last updated.",
+			},
+		},
+	}
+}
+
+func ResourceContainerAwsNodePoolUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
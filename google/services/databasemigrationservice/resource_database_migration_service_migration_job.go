@@ -974,18 +974,7 @@ func flattenDatabaseMigrationServiceMigrationJobCreateTime(v interface{}, d *sch
 }
 
 func flattenDatabaseMigrationServiceMigrationJobLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDatabaseMigrationServiceMigrationJobState(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1295,18 +1284,7 @@ func flattenDatabaseMigrationServiceMigrationJobObjectsConfigSourceObjectsConfig
 }
 
 func flattenDatabaseMigrationServiceMigrationJobTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDatabaseMigrationServiceMigrationJobEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -415,10 +415,10 @@ func resourceDataflowJobRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error setting project: %s", err)
 	}
-	if err := tpgresource.SetLabels(job.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(job.Labels, d, "labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
-	if err := tpgresource.SetLabels(job.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(job.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", job.Labels); err != nil {
@@ -512,6 +512,7 @@ func resourceDataflowJobUpdateByReplacement(d *schema.ResourceData, meta interfa
 			},
 			Timeout:              time.Minute * time.Duration(5),
 			ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsDataflowJobUpdateRetryableError},
+			RetryOn:              config.RetryOn,
 		})
 		if err != nil {
 			return err
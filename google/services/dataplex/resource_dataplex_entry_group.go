@@ -622,18 +622,7 @@ func flattenDataplexEntryGroupDisplayName(v interface{}, d *schema.ResourceData,
 }
 
 func flattenDataplexEntryGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataplexEntryGroupTransferStatus(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -641,18 +630,7 @@ func flattenDataplexEntryGroupTransferStatus(v interface{}, d *schema.ResourceDa
 }
 
 func flattenDataplexEntryGroupTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataplexEntryGroupEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
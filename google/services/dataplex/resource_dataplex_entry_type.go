@@ -712,18 +712,7 @@ func flattenDataplexEntryTypeDisplayName(v interface{}, d *schema.ResourceData,
 }
 
 func flattenDataplexEntryTypeLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataplexEntryTypeTypeAliases(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -761,18 +750,7 @@ func flattenDataplexEntryTypeRequiredAspectsType(v interface{}, d *schema.Resour
 }
 
 func flattenDataplexEntryTypeTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataplexEntryTypeEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
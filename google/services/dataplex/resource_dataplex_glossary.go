@@ -614,18 +614,7 @@ func flattenDataplexGlossaryDescription(v interface{}, d *schema.ResourceData, c
 }
 
 func flattenDataplexGlossaryLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataplexGlossaryUid(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -675,18 +664,7 @@ func flattenDataplexGlossaryCategoryCount(v interface{}, d *schema.ResourceData,
 }
 
 func flattenDataplexGlossaryTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataplexGlossaryEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -626,18 +626,7 @@ func flattenDataplexGlossaryCategoryDescription(v interface{}, d *schema.Resourc
 }
 
 func flattenDataplexGlossaryCategoryLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataplexGlossaryCategoryUid(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -657,18 +646,7 @@ func flattenDataplexGlossaryCategoryParent(v interface{}, d *schema.ResourceData
 }
 
 func flattenDataplexGlossaryCategoryTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataplexGlossaryCategoryEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
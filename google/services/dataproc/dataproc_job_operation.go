@@ -100,7 +100,7 @@ func DataprocJobOperationWait(config *transport_tpg.Config, region, projectId, j
 		JobId:             jobId,
 		WaitForCompletion: waitForCompletion,
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
 type DataprocDeleteJobOperationWaiter struct {
@@ -140,5 +140,5 @@ func DataprocDeleteOperationWait(config *transport_tpg.Config, region, projectId
 			JobId:     jobId,
 		},
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
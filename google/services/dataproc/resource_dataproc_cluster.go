@@ -3185,11 +3185,11 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting region: %s", err)
 	}
 
-	if err := tpgresource.SetLabels(cluster.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(cluster.Labels, d, "labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
 
-	if err := tpgresource.SetLabels(cluster.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(cluster.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 
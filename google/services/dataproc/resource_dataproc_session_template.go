@@ -727,18 +727,7 @@ func flattenDataprocSessionTemplateCreator(v interface{}, d *schema.ResourceData
 }
 
 func flattenDataprocSessionTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataprocSessionTemplateRuntimeConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -928,18 +917,7 @@ func flattenDataprocSessionTemplateSparkConnectSession(v interface{}, d *schema.
 }
 
 func flattenDataprocSessionTemplateTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataprocSessionTemplateEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -612,18 +612,7 @@ func flattenDataprocGdcServiceInstanceReconciling(v interface{}, d *schema.Resou
 }
 
 func flattenDataprocGdcServiceInstanceLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataprocGdcServiceInstanceSparkServiceInstanceConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -651,18 +640,7 @@ func flattenDataprocGdcServiceInstanceEffectiveServiceAccount(v interface{}, d *
 }
 
 func flattenDataprocGdcServiceInstanceTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataprocGdcServiceInstanceEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -113,6 +113,15 @@ func ResourceDataprocGdcSparkApplication() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDataprocGdcSparkApplicationResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceDataprocGdcSparkApplicationUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.SetAnnotationsDiff,
@@ -458,6 +467,13 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -1062,18 +1078,7 @@ func flattenDataprocGdcSparkApplicationReconciling(v interface{}, d *schema.Reso
 }
 
 func flattenDataprocGdcSparkApplicationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDataprocGdcSparkApplicationAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1124,18 +1129,7 @@ func flattenDataprocGdcSparkApplicationDependencyImages(v interface{}, d *schema
 }
 
 func flattenDataprocGdcSparkApplicationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDataprocGdcSparkApplicationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1586,3 +1580,394 @@ func ResourceDataprocGdcSparkApplicationFlatten(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func resourceDataprocGdcSparkApplicationResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the spark application.`,
+			},
+			"serviceinstance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The id of the service instance to which this spark application belongs.`,
+			},
+			"spark_application_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The id of the application`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: `The annotations to associate with this application. Annotations may be used to store client information, but are not used by the server. 
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"application_environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `An ApplicationEnvironment from which to inherit configuration properties.`,
+			},
+			"dependency_images": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `List of container image uris for additional file dependencies. Dependent files are sequentially copied from each image. If a file with the same name exists in 2 images then the file from later image is used.`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `User-provided human-readable name to be used in user interfaces.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `The labels to associate with this application. Labels may be used for filtering and billing tracking. 
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The Kubernetes namespace in which to create the application. This namespace must already exist on the cluster.`,
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `application-specific properties.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"pyspark_application_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Represents the PySparkApplicationConfig.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"main_python_file_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The HCFS URI of the main Python file to use as the driver. Must be a .py file.`,
+						},
+						"archive_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: .jar, .tar, .tar.gz, .tgz, and .zip.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The arguments to pass to the driver.  Do not include arguments, such as '--conf', that can be set as job properties, since a collision may occur that causes an incorrect job submission.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of files to be placed in the working directory of each executor. Useful for naively parallel tasks.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"jar_file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of jar files to add to the CLASSPATHs of the Python driver and tasks.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"python_file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS file URIs of Python files to pass to the PySpark framework. Supported file types: .py, .egg, and .zip.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"pyspark_application_config", "spark_application_config", "spark_r_application_config", "spark_sql_application_config"},
+			},
+			"spark_application_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Represents the SparkApplicationConfig.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"archive_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: '.jar', '.tar', '.tar.gz', '.tgz', and '.zip'.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The arguments to pass to the driver. Do not include arguments that can be set as application properties, such as '--conf', since a collision can occur that causes an incorrect application submission.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of files to be placed in the working directory of each executor.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"jar_file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of jar files to add to the classpath of the Spark driver and tasks.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"main_class": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The name of the driver main class. The jar file that contains the class must be in the classpath or specified in 'jar_file_uris'.`,
+						},
+						"main_jar_file_uri": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The HCFS URI of the jar file that contains the main class.`,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"pyspark_application_config", "spark_application_config", "spark_r_application_config", "spark_sql_application_config"},
+			},
+			"spark_r_application_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Represents the SparkRApplicationConfig.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"main_r_file_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The HCFS URI of the main R file to use as the driver. Must be a .R file.`,
+						},
+						"archive_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of archives to be extracted into the working directory of each executor. Supported file types: .jar, .tar, .tar.gz, .tgz, and .zip.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The arguments to pass to the driver.  Do not include arguments, such as '--conf', that can be set as job properties, since a collision may occur that causes an incorrect job submission.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of files to be placed in the working directory of each executor. Useful for naively parallel tasks.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"pyspark_application_config", "spark_application_config", "spark_r_application_config", "spark_sql_application_config"},
+			},
+			"spark_sql_application_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Represents the SparkRApplicationConfig.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"jar_file_uris": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `HCFS URIs of jar files to be added to the Spark CLASSPATH.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"query_file_uri": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `The HCFS URI of the script that contains SQL queries.`,
+						},
+						"query_list": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Represents a list of queries.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"queries": {
+										Type:        schema.TypeList,
+										Required:    true,
+										ForceNew:    true,
+										Description: `The queries to run.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"script_variables": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Mapping of query variable names to values (equivalent to the Spark SQL command: SET 'name="value";').`,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"pyspark_application_config", "spark_application_config", "spark_r_application_config", "spark_sql_application_config"},
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The Dataproc version of this application.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp when the resource was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"monitoring_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `URL for a monitoring UI for this application (for eventual Spark PHS/UI support) Out of scope for private GA`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Identifier. The name of the application. Format: projects/{project}/locations/{location}/serviceInstances/{service_instance}/sparkApplications/{application}`,
+			},
+			"output_uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `An HCFS URI pointing to the location of stdout and stdout of the application Mainly useful for Pantheon and gcloud Not in scope for private GA`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether the application is currently reconciling. True if the current state of the resource does not match the intended state, and the system is working to reconcile them, whether or not the change was user initiated.`,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The current state.
+Possible values:
+* 'STATE_UNSPECIFIED'
+* 'PENDING'
+* 'RUNNING'
+* 'CANCELLING'
+* 'CANCELLED'
+* 'SUCCEEDED'
+* 'FAILED'`,
+			},
+			"state_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `A message explaining the current state.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `System generated unique identifier for this application, formatted as UUID4.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp when the resource was most recently updated.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceDataprocGdcSparkApplicationUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
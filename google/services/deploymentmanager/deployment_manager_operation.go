@@ -26,84 +26,65 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
-type DeploymentManagerOperationWaiter struct {
-	Config       *transport_tpg.Config
-	UserAgent    string
-	Project      string
-	OperationUrl string
-	tpgcompute.ComputeOperationWaiter
+// deploymentManagerOperation is the kind of Deployment Manager operations,
+// which are like those of Compute Engine, but are polled at their selfLink
+// and report their errors differently.
+var deploymentManagerOperation = &tpgresource.OperationKind{
+	Name:          "deploymentmanager",
+	PendingStates: tpgcompute.ComputeOperation.PendingStates,
+	TargetStates:  tpgcompute.ComputeOperation.TargetStates,
+	State:         tpgresource.OperationStatus,
+	Error:         deploymentManagerOperationError,
+	Url: func(w *tpgresource.OperationWaiter) string {
+		selfLink, _ := w.Op["selfLink"].(string)
+		return selfLink
+	},
 }
 
-func (w *DeploymentManagerOperationWaiter) IsRetryable(error) bool {
-	return false
-}
-
-func (w *DeploymentManagerOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil || w.Op == nil || w.OperationUrl == "" {
-		return nil, fmt.Errorf("cannot query unset/nil operation")
-	}
-
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    w.Config,
-		Method:    "GET",
-		Project:   w.Project,
-		RawURL:    w.OperationUrl,
-		UserAgent: w.UserAgent,
-	})
+func init() {
+	tpgresource.RegisterOperationKind(deploymentManagerOperation)
 }
 
 func DeploymentManagerOperationWaitTime(config *transport_tpg.Config, resp interface{}, project, activity, userAgent string, timeout time.Duration) error {
-	op, err := tpgresource.ConvertToMap(resp)
+	w, err := tpgresource.NewOperationWaiter(config, deploymentManagerOperation, resp, transport_tpg.BaseUrl(Product, config), project, userAgent)
 	if err != nil {
 		return err
 	}
-
-	selfLink, _ := op["selfLink"].(string)
-	w := &DeploymentManagerOperationWaiter{
-		Config:       config,
-		UserAgent:    userAgent,
-		OperationUrl: selfLink,
-		ComputeOperationWaiter: tpgcompute.ComputeOperationWaiter{
-			Project: project,
-		},
-	}
-	if err := w.SetOp(op); err != nil {
-		return err
+	if selfLink, _ := w.Op["selfLink"].(string); selfLink == "" {
+		return fmt.Errorf("cannot query unset/nil operation")
 	}
-
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
-func (w *DeploymentManagerOperationWaiter) Error() error {
-	if w != nil && w.Op != nil {
-		if opErr, ok := w.Op["error"]; ok && opErr != nil {
-			errObj, ok := opErr.(map[string]interface{})
-			if !ok {
-				return fmt.Errorf("operation error: %v", opErr)
-			}
+func deploymentManagerOperationError(op map[string]interface{}) error {
+	opErr, ok := op["error"]
+	if !ok || opErr == nil {
+		return nil
+	}
+	errObj, ok := opErr.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("operation error: %v", opErr)
+	}
 
-			httpStatusCode, _ := w.Op["httpErrorStatusCode"].(float64)
-			httpMessage, _ := w.Op["httpErrorMessage"].(string)
+	httpStatusCode, _ := op["httpErrorStatusCode"].(float64)
+	httpMessage, _ := op["httpErrorMessage"].(string)
 
-			var errorMessages []string
-			if rawErrors, ok := errObj["errors"].([]interface{}); ok {
-				for _, rawErr := range rawErrors {
-					if errMap, ok := rawErr.(map[string]interface{}); ok {
-						if msg, ok := errMap["message"].(string); ok {
-							errorMessages = append(errorMessages, msg)
-						}
-					}
+	var errorMessages []string
+	if rawErrors, ok := errObj["errors"].([]interface{}); ok {
+		for _, rawErr := range rawErrors {
+			if errMap, ok := rawErr.(map[string]interface{}); ok {
+				if msg, ok := errMap["message"].(string); ok {
+					errorMessages = append(errorMessages, msg)
 				}
 			}
-
-			return DeploymentManagerOperationError{
-				HTTPStatusCode: int64(httpStatusCode),
-				HTTPMessage:    httpMessage,
-				Errors:         errorMessages,
-			}
 		}
 	}
-	return nil
+
+	return DeploymentManagerOperationError{
+		HTTPStatusCode: int64(httpStatusCode),
+		HTTPMessage:    httpMessage,
+		Errors:         errorMessages,
+	}
 }
 
 // DeploymentManagerOperationError wraps information from an operation response
@@ -753,14 +753,10 @@ func flattenDeploymentManagerDeploymentLabels(v interface{}, d *schema.ResourceD
 	if v == nil {
 		return v
 	}
-	l := v.([]interface{})
+	l := tpgresource.FlattenLabelBlocks(v, d, "labels", config)
 	transformed := schema.NewSet(schema.HashResource(deploymentmanagerDeploymentLabelsSchema()), []interface{}{})
 	for _, raw := range l {
 		original := raw.(map[string]interface{})
-		if len(original) < 1 {
-			// Do not include empty json objects coming back from the api
-			continue
-		}
 		transformed.Add(map[string]interface{}{
 			"key":   flattenDeploymentManagerDeploymentLabelsKey(original["key"], d, config),
 			"value": flattenDeploymentManagerDeploymentLabelsValue(original["value"], d, config),
@@ -58,7 +58,7 @@ func TestAccDeploymentManagerDeployment_basicFile(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 		},
 	})
@@ -103,7 +103,7 @@ func TestAccDeploymentManagerDeployment_createDeletePolicy(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 		},
 	})
@@ -134,7 +134,7 @@ func TestAccDeploymentManagerDeployment_imports(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 		},
 	})
@@ -163,7 +163,7 @@ func TestAccDeploymentManagerDeployment_update(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 			{
 				Config: testAccDeploymentManagerDeployment_previewUpdated(deploymentName, accountId2),
@@ -173,7 +173,7 @@ func TestAccDeploymentManagerDeployment_update(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 			{
 				// Turn preview to false
@@ -184,7 +184,7 @@ func TestAccDeploymentManagerDeployment_update(t *testing.T) {
 				ResourceName:            "google_deployment_manager_deployment.deployment",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview"},
+				ImportStateVerifyIgnore: []string{"target", "create_policy", "delete_policy", "preview", "labels"},
 			},
 		},
 	})
@@ -113,6 +113,15 @@ func ResourceDeveloperConnectAccountConnector() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDeveloperConnectAccountConnectorResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceDeveloperConnectAccountConnectorUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -333,6 +342,12 @@ behalf of the user configured under the account connector.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -908,18 +923,7 @@ func flattenDeveloperConnectAccountConnectorEtag(v interface{}, d *schema.Resour
 }
 
 func flattenDeveloperConnectAccountConnectorLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDeveloperConnectAccountConnectorName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -979,18 +983,7 @@ func flattenDeveloperConnectAccountConnectorEffectiveAnnotations(v interface{},
 }
 
 func flattenDeveloperConnectAccountConnectorTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDeveloperConnectAccountConnectorEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1289,3 +1282,240 @@ func ResourceDeveloperConnectAccountConnectorFlatten(d *schema.ResourceData, met
 
 	return nil
 }
+
+func resourceDeveloperConnectAccountConnectorResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The ID to use for the AccountConnector, which will become the final
+component of the AccountConnector's resource name. Its format should adhere
+to https://google.aip.dev/122#resource-id-segments Names must be unique
+per-project per-location.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Resource ID segment making up resource 'name'. It identifies the resource within its parent collection as described in https://google.aip.dev/122.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Allows users to store small amounts of arbitrary data.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_oauth_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Message for a customized OAuth config.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The OAuth2 authrization server URL.`,
+						},
+						"client_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The client ID of the OAuth application.`,
+						},
+						"client_secret": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `Input only. The client secret of the OAuth application.
+It will be provided as plain text, but encrypted and stored in developer
+connect. As INPUT_ONLY field, it will not be included in the output.`,
+						},
+						"host_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The host URI of the OAuth application.`,
+						},
+						"scm_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The type of the SCM provider.
+Possible values:
+SCM_PROVIDER_UNKNOWN
+GITHUB_ENTERPRISE
+GITLAB_ENTERPRISE
+BITBUCKET_DATA_CENTER`,
+						},
+						"scopes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `The scopes to be requested during OAuth.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"token_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The OAuth2 token request URL.`,
+						},
+						"pkce_disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Disable PKCE for this OAuth config. PKCE is enabled by default.`,
+						},
+						"service_directory_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `ServiceDirectoryConfig represents Service Directory configuration for a
+connection.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `The Service Directory service name.
+Format:
+projects/{project}/locations/{location}/namespaces/{namespace}/services/{service}.`,
+									},
+								},
+							},
+						},
+						"ssl_ca_certificate": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `SSL certificate to use for requests to a private service.`,
+						},
+						"server_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `SCM server version installed at the host URI.`,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Labels as key value pairs
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_oauth_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `ProviderOAuthConfig is the OAuth config for a provider.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scopes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `User selected scopes to apply to the Oauth config
+In the event of changing scopes, user records under AccountConnector will
+be deleted and users will re-auth again.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"system_provider_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Possible values:
+GITHUB
+GITLAB
+GOOGLE
+SENTRY
+ROVO
+NEW_RELIC
+DATASTAX
+DYNATRACE`,
+						},
+					},
+				},
+			},
+			"proxy_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The proxy configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: `Setting this to true allows the git and http proxies to perform actions on
+behalf of the user configured under the account connector.`,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp when the accountConnector was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The resource name of the accountConnector, in the format
+'projects/{project}/locations/{location}/accountConnectors/{account_connector_id}'.`,
+			},
+			"oauth_start_uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Start OAuth flow by clicking on this URL.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp when the accountConnector was updated.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceDeveloperConnectAccountConnectorUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceDeveloperConnectConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDeveloperConnectConnectionResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceDeveloperConnectConnectionUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.SetAnnotationsDiff,
@@ -758,6 +767,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -1494,18 +1509,7 @@ func flattenDeveloperConnectConnectionGithubEnterpriseConfigAppInstallationId(v
 }
 
 func flattenDeveloperConnectConnectionLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDeveloperConnectConnectionEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2036,18 +2040,7 @@ func flattenDeveloperConnectConnectionCryptoKeyConfigKeyReference(v interface{},
 }
 
 func flattenDeveloperConnectConnectionTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDeveloperConnectConnectionEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -3171,3 +3164,705 @@ func ResourceDeveloperConnectConnectionFlatten(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+func resourceDeveloperConnectConnectionResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Required. Id of the requesting object
+If auto-generating Id server-side, remove this field and
+connection_id from the method_signature of Create RPC`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Resource ID segment making up resource 'name'. It identifies the resource within its parent collection as described in https://google.aip.dev/122.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. Allows clients to store small amounts of arbitrary data.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"bitbucket_cloud_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to an instance of Bitbucket Cloud.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"read_authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"webhook_secret_secret_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `Required. Immutable. SecretManager resource containing the webhook secret used to verify webhook
+events, formatted as 'projects/*/secrets/*/versions/*'. This is used to
+validate and create webhooks.`,
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Required. The Bitbucket Cloud Workspace ID to be connected to Google Cloud Platform.`,
+						},
+					},
+				},
+			},
+			"bitbucket_data_center_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to an instance of Bitbucket Data Center.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"host_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Required. The URI of the Bitbucket Data Center host this connection is for.`,
+						},
+						"read_authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"webhook_secret_secret_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `Required. Immutable. SecretManager resource containing the webhook secret used to verify webhook
+events, formatted as 'projects/*/secrets/*/versions/*'. This is used to
+validate webhooks.`,
+						},
+						"service_directory_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `ServiceDirectoryConfig represents Service Directory configuration for a
+connection.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. The Service Directory service name.
+Format:
+projects/{project}/locations/{location}/namespaces/{namespace}/services/{service}.`,
+									},
+								},
+							},
+						},
+						"ssl_ca_certificate": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Optional. SSL certificate authority to trust when making requests to Bitbucket Data
+Center.`,
+						},
+						"server_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Output only. Version of the Bitbucket Data Center server running on the 'host_uri'.`,
+						},
+					},
+				},
+			},
+			"crypto_key_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: `The crypto key configuration. This field is used by the Customer-managed
+encryption keys (CMEK) feature.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_reference": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `Required. The name of the key which is used to encrypt/decrypt customer data. For key
+in Cloud KMS, the key should be in the format of
+'projects/*/locations/*/keyRings/*/cryptoKeys/*'.`,
+						},
+					},
+				},
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: `Optional. If disabled is set to true, functionality is disabled for this connection.
+Repository based API methods and webhooks processing for repositories in
+this connection will be disabled.`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `Optional. This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.`,
+			},
+			"github_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to github.com.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"github_app": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `Required. Immutable. The GitHub Application that was installed to the GitHub user or
+organization.
+Possible values:
+GIT_HUB_APP_UNSPECIFIED
+DEVELOPER_CONNECT
+FIREBASE`,
+						},
+						"app_installation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Optional. GitHub App installation id.`,
+						},
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Description: `Represents an OAuth token of the account that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"oauth_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the OAuth token that authorizes
+the connection. Format: 'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"installation_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Output only. The URI to navigate to in order to manage the installation associated
+with this GitHubConfig.`,
+						},
+					},
+				},
+			},
+			"github_enterprise_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to an instance of GitHub Enterprise.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Required. The URI of the GitHub Enterprise host this connection is for.`,
+						},
+						"app_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. ID of the GitHub App created from the manifest.`,
+						},
+						"app_installation_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. ID of the installation of the GitHub App.`,
+						},
+						"private_key_secret_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Optional. SecretManager resource containing the private key of the GitHub App,
+formatted as 'projects/*/secrets/*/versions/*'.`,
+						},
+						"service_directory_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `ServiceDirectoryConfig represents Service Directory configuration for a
+connection.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. The Service Directory service name.
+Format:
+projects/{project}/locations/{location}/namespaces/{namespace}/services/{service}.`,
+									},
+								},
+							},
+						},
+						"ssl_ca_certificate": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. SSL certificate to use for requests to GitHub Enterprise.`,
+						},
+						"webhook_secret_secret_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Optional. SecretManager resource containing the webhook secret of the GitHub App,
+formatted as 'projects/*/secrets/*/versions/*'.`,
+						},
+						"app_slug": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Output only. The URL-friendly name of the GitHub App.`,
+						},
+						"installation_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Output only. The URI to navigate to in order to manage the installation associated
+with this GitHubEnterpriseConfig.`,
+						},
+						"server_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Output only. GitHub Enterprise version installed at the host_uri.`,
+						},
+					},
+				},
+			},
+			"gitlab_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to gitlab.com.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"read_authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"webhook_secret_secret_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `Required. Immutable. SecretManager resource containing the webhook secret of a GitLab project,
+formatted as 'projects/*/secrets/*/versions/*'. This is used to validate
+webhooks.`,
+						},
+					},
+				},
+			},
+			"gitlab_enterprise_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to an instance of GitLab Enterprise.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"host_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Required. The URI of the GitLab Enterprise host this connection is for.`,
+						},
+						"read_authorizer_credential": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Represents a personal access token that authorized the Connection,
+and associated metadata.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_token_secret_version": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. A SecretManager resource containing the user token that authorizes
+the Developer Connect connection. Format:
+'projects/*/secrets/*/versions/*'.`,
+									},
+									"username": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Output only. The username associated with this token.`,
+									},
+								},
+							},
+						},
+						"webhook_secret_secret_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `Required. Immutable. SecretManager resource containing the webhook secret of a GitLab project,
+formatted as 'projects/*/secrets/*/versions/*'. This is used to validate
+webhooks.`,
+						},
+						"service_directory_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `ServiceDirectoryConfig represents Service Directory configuration for a
+connection.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `Required. The Service Directory service name.
+Format:
+projects/{project}/locations/{location}/namespaces/{namespace}/services/{service}.`,
+									},
+								},
+							},
+						},
+						"ssl_ca_certificate": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Optional. SSL Certificate Authority certificate to use for requests to GitLab
+Enterprise instance.`,
+						},
+						"server_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Output only. Version of the GitLab Enterprise server running on the 'host_uri'.`,
+						},
+					},
+				},
+			},
+			"http_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for connections to an HTTP service provider.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_uri": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `The service provider's https endpoint.`,
+						},
+						"basic_authentication": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Basic authentication with username and password.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The username to authenticate as.`,
+									},
+									"password_secret_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The password SecretManager secret version to authenticate as.`,
+									},
+								},
+							},
+						},
+						"bearer_token_authentication": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Bearer token authentication with a token.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"token_secret_version": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The token SecretManager secret version to authenticate as.`,
+									},
+								},
+							},
+						},
+						"service_directory_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `ServiceDirectoryConfig represents Service Directory configuration for a
+connection.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `The Service Directory service name.
+Format:
+projects/{project}/locations/{location}/namespaces/{namespace}/services/{service}.`,
+									},
+								},
+							},
+						},
+						"ssl_ca_certificate": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The SSL certificate to use for requests to the HTTP service provider.`,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. Labels as key value pairs
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. [Output only] Create timestamp`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. [Output only] Delete timestamp`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"installation_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: `Describes stage and necessary actions to be taken by the
+user to complete the installation. Used for GitHub and GitHub Enterprise
+based connections.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Output only. Link to follow for next action. Empty string if the installation is already
+complete.`,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `Output only. Message of what the user should do next to continue the installation.
+Empty string if the installation is already complete.`,
+						},
+						"stage": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Output only. Current step of the installation process.
+Possible values:
+STAGE_UNSPECIFIED
+PENDING_CREATE_APP
+PENDING_USER_OAUTH
+PENDING_INSTALL_APP
+COMPLETE`,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The resource name of the connection, in the format
+'projects/{project}/locations/{location}/connections/{connection_id}'.`,
+			},
+			"reconciling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: `Output only. Set to true when the connection is being set up or updated in the
+background.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. A system-assigned unique identifier for a the GitRepositoryLink.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. [Output only] Update timestamp`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceDeveloperConnectConnectionUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceDeveloperConnectInsightsConfig() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceDeveloperConnectInsightsConfigResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceDeveloperConnectInsightsConfigUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -269,6 +278,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -1105,18 +1120,7 @@ func flattenDeveloperConnectInsightsConfigEffectiveAnnotations(v interface{}, d
 }
 
 func flattenDeveloperConnectInsightsConfigTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDeveloperConnectInsightsConfigEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1329,3 +1333,308 @@ func ResourceDeveloperConnectInsightsConfigFlatten(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func resourceDeveloperConnectInsightsConfigResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"insights_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `ID of the requesting InsightsConfig.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Resource ID segment making up resource 'name'. It identifies the resource within its parent collection as described in https://google.aip.dev/122.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `User specified annotations. See https://google.aip.dev/148#annotations
+for more details such as format and size limitations.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"app_hub_application": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The name of the App Hub Application.
+Format:
+projects/{project}/locations/{location}/applications/{application}`,
+				ConflictsWith: []string{"target_projects"},
+			},
+			"artifact_configs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The artifact configurations of the artifacts that are deployed.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"google_artifact_analysis": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Google Artifact Analysis configurations.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"project_id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The project id of the project where the provenance is stored.`,
+									},
+								},
+							},
+						},
+						"google_artifact_registry": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Google Artifact Registry configurations.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"artifact_registry_package": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `The name of the artifact registry package.`,
+									},
+									"project_id": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The host project of Artifact Registry.`,
+									},
+								},
+							},
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Description: `The URI of the artifact that is deployed.
+e.g. 'us-docker.pkg.dev/my-project/my-repo/image'.
+The URI does not include the tag / digest because it captures a lineage of
+artifacts.`,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Set of labels associated with an InsightsConfig.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"target_projects": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The projects to track with the InsightsConfig.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_ids": {
+							Type:             schema.TypeList,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tpgresource.ProjectNumberDiffSuppress,
+							Description:      `The project IDs. Format {project}.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+				ConflictsWith: []string{"app_hub_application"},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `[Output only] Create timestamp`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: `Any errors that occurred while setting up the InsightsConfig.
+Each error will be in the format: 'field_name: error_message', e.g.
+GetAppHubApplication: Permission denied while getting App Hub
+application. Please grant permissions to the P4SA.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The status code, which should be an enum value of google.rpc.Code.`,
+						},
+						"details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: `A list of messages that carry the error details.  There is a common set of
+message types for APIs to use.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"detail_message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `A message with details about the error.`,
+									},
+								},
+							},
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `A developer-facing error message, which should be in English. Any
+user-facing error message should be localized and sent in the
+google.rpc.Status.details field, or localized by the client.`,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The name of the InsightsConfig.
+Format:
+projects/{project}/locations/{location}/insightsConfigs/{insightsConfig}`,
+			},
+			"reconciling": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: `Reconciling (https://google.aip.dev/128#reconciliation).
+Set to true if the current state of InsightsConfig does not match the
+user's intended state, and the service is actively updating the resource to
+reconcile them. This can happen due to user-triggered updates or
+system actions like failover or maintenance.`,
+			},
+			"runtime_configs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The runtime configurations where the application is deployed.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							Description: `The URI of the runtime configuration.
+For GKE, this is the cluster name.
+For Cloud Run, this is the service name.`,
+						},
+						"app_hub_workload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `AppHubWorkload represents the App Hub Workload.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"criticality": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The criticality of the App Hub Workload.`,
+									},
+									"environment": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The environment of the App Hub Workload.`,
+									},
+									"workload": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Output only. The name of the App Hub Workload.
+Format:
+'projects/{project}/locations/{location}/applications/{application}/workloads/{workload}'.`,
+									},
+								},
+							},
+						},
+						"gke_workload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `GKEWorkload represents the Google Kubernetes Engine runtime.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										Description: `The name of the GKE cluster.
+Format:
+'projects/{project}/locations/{location}/clusters/{cluster}'.`,
+									},
+									"deployment": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `The name of the GKE deployment.
+Format:
+'projects/{project}/locations/{location}/clusters/{cluster}/namespaces/{namespace}/deployments/{deployment}'.`,
+									},
+								},
+							},
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The state of the Runtime.
+Possible values:
+STATE_UNSPECIFIED
+LINKED
+UNLINKED`,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The state of the InsightsConfig.
+Possible values:
+STATE_UNSPECIFIED
+PENDING
+COMPLETE
+ERROR`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `[Output only] Update timestamp`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceDeveloperConnectInsightsConfigUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
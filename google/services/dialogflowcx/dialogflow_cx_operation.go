@@ -17,56 +17,14 @@
 package dialogflowcx
 
 import (
-	"encoding/json"
-	"fmt"
-	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
-type DialogflowCXOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *DialogflowCXOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	// Returns the proper get.
-	location := ""
-	if parts := regexp.MustCompile(`locations\/([^\/]*)\/`).FindStringSubmatch(w.CommonOperationWaiter.Op.Name); parts != nil {
-		location = parts[1]
-	} else {
-		return nil, fmt.Errorf(
-			"Saw %s when the op name is expected to contains location %s",
-			w.CommonOperationWaiter.Op.Name,
-			"projects/{{project}}/locations/{{location}}/...",
-		)
-	}
-
-	url := fmt.Sprintf("https://%s-dialogflow.googleapis.com/v3/%s", location, w.CommonOperationWaiter.Op.Name)
-
-	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    w.Config,
-		Method:    "GET",
-		RawURL:    url,
-		UserAgent: w.UserAgent,
-	})
-}
-
-func createDialogflowCXWaiter(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string) (*DialogflowCXOperationWaiter, error) {
-	w := &DialogflowCXOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-	}
-	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
-		return nil, err
-	}
-	return w, nil
+func createDialogflowCXWaiter(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string) (*tpgresource.OperationWaiter, error) {
+	return tpgresource.NewOperationWaiter(config, tpgresource.LocationalAipOperation, op, transport_tpg.BaseUrl(Product, config), "", userAgent)
 }
 
 // nolint: deadcode,unused
@@ -75,10 +33,10 @@ func DialogflowCXOperationWaitTimeWithResponse(config *transport_tpg.Config, op
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
-	return json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), response)
+	return w.Response(response)
 }
 
 func DialogflowCXOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string, timeout time.Duration) error {
@@ -91,5 +49,5 @@ func DialogflowCXOperationWaitTime(config *transport_tpg.Config, op map[string]i
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
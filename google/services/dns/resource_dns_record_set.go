@@ -463,6 +463,7 @@ func resourceDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
 				project, zone).Name(name).Type(dnsType).Do()
 			return reqErr
 		},
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("DNS Record Set %q", d.Get("name").(string)))
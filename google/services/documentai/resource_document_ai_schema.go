@@ -552,18 +552,7 @@ func flattenDocumentAISchemaDisplayName(v interface{}, d *schema.ResourceData, c
 }
 
 func flattenDocumentAISchemaLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenDocumentAISchemaCreateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -575,18 +564,7 @@ func flattenDocumentAISchemaUpdateTime(v interface{}, d *schema.ResourceData, co
 }
 
 func flattenDocumentAISchemaTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenDocumentAISchemaEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -88,7 +88,7 @@ func EventarcOperationWaitTimeWithResponse(config *transport_tpg.Config, op map[
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
 	rawResponse := []byte(w.CommonOperationWaiter.Op.Response)
@@ -108,5 +108,5 @@ func EventarcOperationWaitTime(config *transport_tpg.Config, op map[string]inter
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
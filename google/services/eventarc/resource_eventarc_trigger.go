@@ -1007,18 +1007,7 @@ func flattenEventarcTriggerTransportPubsubSubscription(v interface{}, d *schema.
 }
 
 func flattenEventarcTriggerLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenEventarcTriggerEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1068,18 +1057,7 @@ func flattenEventarcTriggerRetryPolicyMaxAttempts(v interface{}, d *schema.Resou
 }
 
 func flattenEventarcTriggerTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenEventarcTriggerEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
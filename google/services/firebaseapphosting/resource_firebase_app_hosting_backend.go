@@ -113,6 +113,15 @@ func ResourceFirebaseAppHostingBackend() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceFirebaseAppHostingBackendResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceFirebaseAppHostingBackendUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -253,6 +262,12 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -830,18 +845,7 @@ func flattenFirebaseAppHostingBackendEnvironment(v interface{}, d *schema.Resour
 }
 
 func flattenFirebaseAppHostingBackendLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenFirebaseAppHostingBackendEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -935,18 +939,7 @@ func flattenFirebaseAppHostingBackendEffectiveAnnotations(v interface{}, d *sche
 }
 
 func flattenFirebaseAppHostingBackendTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenFirebaseAppHostingBackendEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1099,3 +1092,202 @@ func ResourceFirebaseAppHostingBackendFlatten(d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func resourceFirebaseAppHostingBackendResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: `The [ID of a Web
+App](https://firebase.google.com/docs/reference/firebase-management/rest/v1beta1/projects.webApps#WebApp.FIELDS.app_id)
+associated with the backend.`,
+			},
+			"backend_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Id of the backend. Also used as the service ID for Cloud Run, and as part
+of the default domain name.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The canonical IDs of a Google Cloud location such as "us-east1".`,
+			},
+			"service_account": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: `The name of the service account used for Cloud Build and Cloud Run.
+Should have the role roles/firebaseapphosting.computeRunner
+or equivalent permissions.`,
+			},
+			"serving_locality": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateEnum([]string{"REGIONAL_STRICT", "GLOBAL_ACCESS"}),
+				Description: `Immutable. Specifies how App Hosting will serve the content for this backend. It will
+either be contained to a single region (REGIONAL_STRICT) or allowed to use
+App Hosting's global-replicated serving infrastructure (GLOBAL_ACCESS). Possible values: ["REGIONAL_STRICT", "GLOBAL_ACCESS"]`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Unstructured key value map that may be set by external tools to
+store and arbitrary metadata. They are not queryable and should be
+preserved when modifying objects.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"codebase": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: `The connection to an external source repository to watch for event-driven
+updates to the backend.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The resource name for the Developer Connect
+['gitRepositoryLink'](https://cloud.google.com/developer-connect/docs/api/reference/rest/v1/projects.locations.connections.gitRepositoryLinks)
+connected to this backend, in the format:
+
+projects/{project}/locations/{location}/connections/{connection}/gitRepositoryLinks/{repositoryLink}`,
+						},
+						"root_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `If 'repository' is provided, the directory relative to the root of the
+repository to use as the root for the deployed web app.`,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Human-readable name. 63 character limit.`,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The environment name of the backend, used to load environment variables
+from environment specific configuration.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Unstructured key value map that can be used to organize and categorize
+objects.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time at which the backend was created.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time at which the backend was deleted.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Server-computed checksum based on other values; may be sent
+on update or delete to ensure operation is done on expected resource.`,
+			},
+			"managed_resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `A list of the resources managed by this backend.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"run_service": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: `A managed Cloud Run
+['service'](https://cloud.google.com/run/docs/reference/rest/v2/projects.locations.services#resource:-service).`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `The name of the Cloud Run
+['service'](https://cloud.google.com/run/docs/reference/rest/v2/projects.locations.services#resource:-service),
+in the format:
+
+projects/{project}/locations/{location}/services/{serviceId}`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The resource name of the backend.
+
+Format:
+
+'projects/{project}/locations/{locationId}/backends/{backendId}'.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `System-assigned, unique identifier.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time at which the backend was last updated.`,
+			},
+			"uri": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The primary URI to communicate with the backend.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceFirebaseAppHostingBackendUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceFirebaseAppHostingBuild() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceFirebaseAppHostingBuildResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceFirebaseAppHostingBuildUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.SetLabelsDiff,
@@ -324,6 +333,13 @@ process.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -701,18 +717,7 @@ func flattenFirebaseAppHostingBuildAnnotations(v interface{}, d *schema.Resource
 }
 
 func flattenFirebaseAppHostingBuildLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenFirebaseAppHostingBuildName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -918,18 +923,7 @@ func flattenFirebaseAppHostingBuildEffectiveAnnotations(v interface{}, d *schema
 }
 
 func flattenFirebaseAppHostingBuildTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenFirebaseAppHostingBuildEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1228,3 +1222,307 @@ func ResourceFirebaseAppHostingBuildFlatten(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceFirebaseAppHostingBuildResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The ID of the Backend that this Build applies to`,
+			},
+			"build_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The user-specified ID of the build being created.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the Backend that this Build applies to`,
+			},
+			"source": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The source for the build.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codebase": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Description: `A codebase source, representing the state of the codebase
+that the build will be created at.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"branch": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `The branch in the codebase to build from, using the latest commit.`,
+									},
+									"commit": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `The commit in the codebase to build from.`,
+									},
+									"author": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Description: `Version control metadata for a user associated with a resolved codebase.
+Currently assumes a Git user.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_name": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The 'name' field in a Git user's git.config. Required by Git.`,
+												},
+												"email": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The 'email' field in a Git user's git.config, if available.`,
+												},
+												"image_uri": {
+													Type:     schema.TypeString,
+													Computed: true,
+													Description: `The URI of an image file associated with the user's account in an
+external source control provider, if available.`,
+												},
+											},
+										},
+									},
+									"commit_message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The message of a codebase change.`,
+									},
+									"commit_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The time the change was made.`,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `The human-friendly name to use for this Codebase when displaying a build.
+We use the first eight characters of the SHA-1 hash for GitHub.com.`,
+									},
+									"hash": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The full SHA-1 hash of a Git commit, if available.`,
+									},
+									"uri": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `A URI linking to the codebase on an hosting provider's website. May
+not be valid if the commit has been rebased or force-pushed out of
+existence in the linked repository.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"source.0.codebase", "source.0.container"},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Description: `The URI of an Artifact Registry
+[container
+image](https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.dockerImages)
+to use as the build source.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `A URI representing a container for the backend to use.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"source.0.codebase", "source.0.container"},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: `Unstructured key value map that may be set by external tools to
+store and arbitrary metadata. They are not queryable and should be
+preserved when modifying objects.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Human-readable name. 63 character limit.`,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Unstructured key value map that can be used to organize and categorize
+objects.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"build_logs_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The location of the [Cloud Build
+logs](https://cloud.google.com/build/docs/view-build-results) for the build
+process.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time at which the build was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The environment name of the backend when this build was created.`,
+			},
+			"error": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: `The 'Status' type defines a logical error model that is suitable for
+different programming environments, including REST APIs and RPC APIs. It is
+used by [gRPC](https://github.com/grpc). Each 'Status' message contains
+three pieces of data: error code, error message, and error details.
+
+You can find out more about this error model and how to work with it in the
+[API Design Guide](https://cloud.google.com/apis/design/errors).`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The status code, which should be an enum value of google.rpc.Code.`,
+						},
+						"details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: `A list of messages that carry the error details. There is a common set of
+message types for APIs to use.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeMap,
+							},
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `A developer-facing error message, which should be in English. Any
+user-facing error message should be localized and sent in the
+google.rpc.Status.details field, or localized by the client.`,
+						},
+					},
+				},
+			},
+			"error_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The source of the error for the build, if in a 'FAILED' state.
+Possible values:
+CLOUD_BUILD
+CLOUD_RUN`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Server-computed checksum based on other values; may be sent
+on update or delete to ensure operation is done on expected resource.`,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The Artifact Registry
+[container
+image](https://cloud.google.com/artifact-registry/docs/reference/rest/v1/projects.locations.repositories.dockerImages)
+URI, used by the Cloud Run
+['revision'](https://cloud.google.com/run/docs/reference/rest/v2/projects.locations.services.revisions)
+for this build.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The resource name of the build.
+
+Format:
+
+'projects/{project}/locations/{locationId}/backends/{backendId}/builds/{buildId}'.`,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The state of the build.
+Possible values:
+BUILDING
+BUILT
+DEPLOYING
+READY
+FAILED`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `System-assigned, unique identifier.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time at which the build was last updated.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceFirebaseAppHostingBuildUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
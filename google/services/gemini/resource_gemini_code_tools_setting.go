@@ -618,18 +618,7 @@ func flattenGeminiCodeToolsSettingUpdateTime(v interface{}, d *schema.ResourceDa
 }
 
 func flattenGeminiCodeToolsSettingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiCodeToolsSettingEnabledTool(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -698,18 +687,7 @@ func flattenGeminiCodeToolsSettingEnabledToolUriOverride(v interface{}, d *schem
 }
 
 func flattenGeminiCodeToolsSettingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiCodeToolsSettingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -652,18 +652,7 @@ func flattenGeminiCodeToolsSettingBindingUpdateTime(v interface{}, d *schema.Res
 }
 
 func flattenGeminiCodeToolsSettingBindingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiCodeToolsSettingBindingTarget(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -671,18 +660,7 @@ func flattenGeminiCodeToolsSettingBindingTarget(v interface{}, d *schema.Resourc
 }
 
 func flattenGeminiCodeToolsSettingBindingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiCodeToolsSettingBindingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -595,18 +595,7 @@ func flattenGeminiDataSharingWithGoogleSettingUpdateTime(v interface{}, d *schem
 }
 
 func flattenGeminiDataSharingWithGoogleSettingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiDataSharingWithGoogleSettingEnablePreviewDataSharing(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -618,18 +607,7 @@ func flattenGeminiDataSharingWithGoogleSettingEnableDataSharing(v interface{}, d
 }
 
 func flattenGeminiDataSharingWithGoogleSettingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiDataSharingWithGoogleSettingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -651,18 +651,7 @@ func flattenGeminiDataSharingWithGoogleSettingBindingUpdateTime(v interface{}, d
 }
 
 func flattenGeminiDataSharingWithGoogleSettingBindingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiDataSharingWithGoogleSettingBindingTarget(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -670,18 +659,7 @@ func flattenGeminiDataSharingWithGoogleSettingBindingTarget(v interface{}, d *sc
 }
 
 func flattenGeminiDataSharingWithGoogleSettingBindingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiDataSharingWithGoogleSettingBindingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
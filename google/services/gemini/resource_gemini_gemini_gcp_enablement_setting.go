@@ -620,18 +620,7 @@ func flattenGeminiGeminiGcpEnablementSettingUpdateTime(v interface{}, d *schema.
 }
 
 func flattenGeminiGeminiGcpEnablementSettingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiGeminiGcpEnablementSettingEnableCustomerDataSharing(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -647,18 +636,7 @@ func flattenGeminiGeminiGcpEnablementSettingWebGroundingType(v interface{}, d *s
 }
 
 func flattenGeminiGeminiGcpEnablementSettingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiGeminiGcpEnablementSettingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
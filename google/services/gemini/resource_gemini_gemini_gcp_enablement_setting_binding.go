@@ -635,18 +635,7 @@ func resourceGeminiGeminiGcpEnablementSettingBindingImport(d *schema.ResourceDat
 }
 
 func flattenGeminiGeminiGcpEnablementSettingBindingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiGeminiGcpEnablementSettingBindingTarget(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -670,18 +659,7 @@ func flattenGeminiGeminiGcpEnablementSettingBindingUpdateTime(v interface{}, d *
 }
 
 func flattenGeminiGeminiGcpEnablementSettingBindingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiGeminiGcpEnablementSettingBindingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -595,18 +595,7 @@ func flattenGeminiLoggingSettingUpdateTime(v interface{}, d *schema.ResourceData
 }
 
 func flattenGeminiLoggingSettingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiLoggingSettingLogPromptsAndResponses(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -618,18 +607,7 @@ func flattenGeminiLoggingSettingLogMetadata(v interface{}, d *schema.ResourceDat
 }
 
 func flattenGeminiLoggingSettingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiLoggingSettingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -573,18 +573,7 @@ func flattenGeminiReleaseChannelSettingUpdateTime(v interface{}, d *schema.Resou
 }
 
 func flattenGeminiReleaseChannelSettingLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGeminiReleaseChannelSettingReleaseChannel(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -596,18 +585,7 @@ func flattenGeminiReleaseChannelSettingName(v interface{}, d *schema.ResourceDat
 }
 
 func flattenGeminiReleaseChannelSettingTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGeminiReleaseChannelSettingEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -613,18 +613,7 @@ func flattenGKEBackupRestoreChannelDescription(v interface{}, d *schema.Resource
 }
 
 func flattenGKEBackupRestoreChannelLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenGKEBackupRestoreChannelEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -636,18 +625,7 @@ func flattenGKEBackupRestoreChannelDestinationProjectId(v interface{}, d *schema
 }
 
 func flattenGKEBackupRestoreChannelTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenGKEBackupRestoreChannelEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
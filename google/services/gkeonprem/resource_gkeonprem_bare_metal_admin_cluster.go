@@ -113,6 +113,15 @@ func ResourceGkeonpremBareMetalAdminCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremBareMetalAdminClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremBareMetalAdminClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -819,6 +828,12 @@ automatically created during cluster creation.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -3842,3 +3857,882 @@ func ResourceGkeonpremBareMetalAdminClusterFlatten(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func resourceGkeonpremBareMetalAdminClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The bare metal admin cluster name.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Annotations on the Bare Metal Admin Cluster.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"bare_metal_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A human readable description of this Bare Metal Admin Cluster.`,
+			},
+			"cluster_operations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the Admin Cluster's observability infrastructure.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_application_logs": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether collection of application logs/metrics should be enabled (in addition to system logs/metrics).`,
+						},
+					},
+				},
+			},
+			"control_plane": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the control plane configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_plane_node_pool_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Configures the node pool running the control plane. If specified the corresponding NodePool will be created for the cluster's control plane. The NodePool will have the same name and namespace as the cluster.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"node_pool_config": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `The generic configuration for a node pool running the control plane.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"labels": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+													Elem: &schema.Schema{Type: schema.TypeString},
+												},
+												"node_configs": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The list of machine addresses in the Bare Metal Node Pool.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"labels": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																Elem: &schema.Schema{Type: schema.TypeString},
+															},
+															"node_ip": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Description: `The default IPv4 address for SSH access and Kubernetes node.
+Example: 192.168.0.1`,
+															},
+														},
+													},
+												},
+												"operating_system": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `Specifies the nodes operating system (default: LINUX).`,
+												},
+												"taints": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The initial taints assigned to nodes of this node pool.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"effect": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+																Description:  `Specifies the nodes operating system (default: LINUX). Possible values: ["EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+															},
+															"key": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Key associated with the effect.`,
+															},
+															"value": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Value associated with the effect.`,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"api_server_args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Customizes the default API server args. Only a subset of
+customized flags are supported. Please refer to the API server
+documentation below to know the exact format:
+https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"argument": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The argument name as it appears on the API Server command line please make sure to remove the leading dashes.`,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The value of the arg as it will be passed to the API Server command line.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A human readable description of this Bare Metal Admin Cluster.`,
+			},
+			"load_balancer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the load balancer configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Specifies the load balancer ports.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_load_balancer_port": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: `The port that control plane hosted load balancers will listen on.`,
+									},
+								},
+							},
+						},
+						"vip_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Specified the Bare Metal Load Balancer Config`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_vip": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The VIP which you previously set aside for the Kubernetes API of this Bare Metal Admin Cluster.`,
+									},
+								},
+							},
+						},
+						"bgp_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_pools": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Description: `a list of non-overlapping IP pools used
+by load balancer typed services.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"addresses": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The addresses that are part of this pool.`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"avoid_buggy_ips": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Description: `This avoids buggy consumer devices mistakenly
+dropping IPv4 traffic for those special IP addresses.`,
+												},
+												"manual_assign": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `If true, prevent IP addresses from being automatically assigned.`,
+												},
+												"pool": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: ``,
+												},
+											},
+										},
+									},
+									"asn": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `BGP autonomous system number (ASN) of the cluster.`,
+									},
+									"bgp_peer_configs": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `BGP autonomous system number (ASN) of the cluster.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"asn": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: ``,
+												},
+												"control_plane_nodes": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Description: `The IP address of the control plane node that
+connects to the external peer.`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"ip_address": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: ``,
+												},
+											},
+										},
+									},
+									"load_balancer_node_pool_config": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `A nested object resource.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"node_pool_config": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `A nested object resource.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"kubelet_config": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `A nested object resource.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"registry_burst": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																		"registry_pull_qps": {
+																			Type:        schema.TypeInt,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																		"serialize_image_pulls_disabled": {
+																			Type:        schema.TypeBool,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																	},
+																},
+															},
+															"labels": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Description: `The labels assigned to nodes of this node pool.
+An object containing a list of key/value pairs.
+Example:
+{ "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																Elem: &schema.Schema{Type: schema.TypeString},
+															},
+															"node_configs": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: ``,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"labels": {
+																			Type:     schema.TypeMap,
+																			Optional: true,
+																			Description: `The labels assigned to nodes of this node pool.
+An object containing a list of key/value pairs.
+Example:
+{ "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																			Elem: &schema.Schema{Type: schema.TypeString},
+																		},
+																		"node_ip": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																	},
+																},
+															},
+															"operating_system": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `The available Operating Systems to be run in a Node.`,
+															},
+															"taints": {
+																Type:        schema.TypeList,
+																Computed:    true,
+																Optional:    true,
+																Description: ``,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"effect": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Available taint effects.`,
+																		},
+																		"key": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																		"value": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: ``,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"manual_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Required:    true,
+										Description: `Whether manual load balancing is enabled.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"maintenance_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the workload node configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All IPv4 address from these ranges will be placed into maintenance mode.
+Nodes in maintenance mode will be cordoned and drained. When both of these
+are true, the "baremetal.cluster.gke.io/maintenance" annotation will be set
+on the node resource.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"network_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Network configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_networking": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Enables the use of advanced Anthos networking features.`,
+						},
+						"island_mode_cidr": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pod_address_cidr_blocks": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `All pods in the cluster are assigned an RFC1918 IPv4 address from these ranges. This field cannot be changed after creation.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"service_address_cidr_blocks": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `All services in the cluster are assigned an RFC1918 IPv4 address from these ranges. This field cannot be changed after creation.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.island_mode_cidr"},
+						},
+						"multiple_network_interfaces_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for multiple network interfaces.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Description: `When set network_config.advanced_networking is automatically
+set to true.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"node_access_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the node access related settings for the bare metal user cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login_user": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `LoginUser is the user name used to access node machines.
+It defaults to "root" if not set.`,
+						},
+					},
+				},
+			},
+			"node_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the workload node configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_pods_per_node": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: `The maximum number of pods a node can run. The size of the CIDR range
+assigned to the node will be derived from this parameter.`,
+						},
+					},
+				},
+			},
+			"proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the cluster proxy configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `Specifies the address of your proxy server.
+For Example: http://domain
+WARNING: Do not provide credentials in the format
+of http://(username:password@)domain these will be rejected by the server.`,
+						},
+						"no_proxy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `A list of IPs, hostnames, and domains that should skip the proxy.
+For example: ["127.0.0.1", "example.com", ".corp", "localhost"].`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"security_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the security related settings for the Bare Metal User Cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configures user access to the Bare Metal User cluster.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_users": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `Users that will be granted the cluster-admin role on the cluster, providing full access to the cluster.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"username": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the user, e.g. 'my-gcp-id@gmail.com'.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"storage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the cluster storage configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lvp_node_mounts_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Specifies the config for local PersistentVolumes backed
+by mounted node disks. These disks need to be formatted and mounted by the
+user, which can be done before or after cluster creation.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The host machine path.`,
+									},
+									"storage_class": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The StorageClass name that PVs will be created with.`,
+									},
+								},
+							},
+						},
+						"lvp_share_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Specifies the config for local PersistentVolumes backed by
+subdirectories in a shared filesystem. These subdirectores are
+automatically created during cluster creation.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lvp_config": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `Defines the machine path and storage class for the LVP Share.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"path": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The host machine path.`,
+												},
+												"storage_class": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The StorageClass name that PVs will be created with.`,
+												},
+											},
+										},
+									},
+									"shared_path_pv_count": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `The number of subdirectories to create under path.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was created, in RFC3339 text format.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was deleted, in RFC3339 text format.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The IP address name of Bare Metal Admin Cluster's API server.`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"fleet": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: `Fleet related configuration.
+Fleets are a Google Cloud concept for logically organizing clusters,
+letting you use and manage multi-cluster capabilities and apply
+consistent policies across your systems.
+See [Anthos Fleets](https://cloud.google.com/anthos/multicluster-management/fleets) for
+more details on Anthos multi-cluster capabilities using Fleets.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The name of the managed Hub Membership resource associated to this cluster.
+Membership names are formatted as
+'projects/<project-number>/locations/<location>/memberships/<cluster-id>'.`,
+						},
+					},
+				},
+			},
+			"local_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The object name of the Bare Metal Admin Cluster custom resource on the
+associated admin cluster. This field is used to support conflicting
+names when enrolling existing clusters to the API. When used as a part of
+cluster enrollment, this field will differ from the ID in the resource
+name. For new clusters, this field will match the user provided cluster ID
+and be visible in the last component of the resource name. It is not
+modifiable.
+All users should use this name to access their cluster using gkectl or
+kubectl and should expect to see the local name when viewing admin
+cluster controller logs.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the Bare Metal Admin Cluster.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current state of this cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Specifies detailed cluster status.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from admin cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the admin cluster
+controller. The error message can be temporary as the admin cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the Bare Metal Admin Cluster.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was last updated, in RFC3339 text format.`,
+			},
+			"validation_check": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Specifies the security related settings for the Bare Metal Admin Cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"options": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Options used for the validation check.`,
+						},
+						"scenario": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The scenario when the preflight checks were run..`,
+						},
+						"status": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Specifies the detailed validation check status`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"result": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `Individual checks which failed as part of the Preflight check execution.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"category": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The category of the validation.`,
+												},
+												"description": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The description of the validation check.`,
+												},
+												"details": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Detailed failure information, which might be unformatted.`,
+												},
+												"options": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Options used for the validation check.`,
+												},
+												"reason": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `A human-readable message of the check failure.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremBareMetalAdminClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
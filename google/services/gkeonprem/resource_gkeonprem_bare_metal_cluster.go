@@ -113,6 +113,15 @@ func ResourceGkeonpremBareMetalCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremBareMetalClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremBareMetalClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -1098,6 +1107,12 @@ For example ["127.0.0.1", "example.com", ".corp", "localhost"].`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -4885,3 +4900,1161 @@ func ResourceGkeonpremBareMetalClusterFlatten(d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func resourceGkeonpremBareMetalClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_cluster_membership": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.ProjectNumberDiffSuppress,
+				Description: `The Admin Cluster this Bare Metal User Cluster belongs to.
+This is the full resource name of the Admin Cluster's hub membership.`,
+			},
+			"bare_metal_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `A human readable description of this Bare Metal User Cluster.`,
+			},
+			"control_plane": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Specifies the control plane configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_plane_node_pool_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Configures the node pool running the control plane. If specified the corresponding NodePool will be created for the cluster's control plane. The NodePool will have the same name and namespace as the cluster.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"node_pool_config": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `The generic configuration for a node pool running the control plane.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"labels": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Optional: true,
+													Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+													Elem: &schema.Schema{Type: schema.TypeString},
+												},
+												"node_configs": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The list of machine addresses in the Bare Metal Node Pool.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"labels": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+Example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																Elem: &schema.Schema{Type: schema.TypeString},
+															},
+															"node_ip": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Description: `The default IPv4 address for SSH access and Kubernetes node.
+Example: 192.168.0.1`,
+															},
+														},
+													},
+												},
+												"operating_system": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `Specifies the nodes operating system (default: LINUX).`,
+													Default:     "LINUX",
+												},
+												"taints": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Optional:    true,
+													Description: `The initial taints assigned to nodes of this node pool.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"effect": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+																Description:  `Specifies the nodes operating system (default: LINUX). Possible values: ["EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+															},
+															"key": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Key associated with the effect.`,
+															},
+															"value": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Value associated with the effect.`,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"api_server_args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Customizes the default API server args. Only a subset of
+customized flags are supported. Please refer to the API server
+documentation below to know the exact format:
+https://kubernetes.io/docs/reference/command-line-tools-reference/kube-apiserver/`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"argument": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The argument name as it appears on the API Server command line please make sure to remove the leading dashes.`,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The value of the arg as it will be passed to the API Server command line.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"load_balancer": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Specifies the load balancer configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Specifies the load balancer ports.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_load_balancer_port": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										Description: `The port that control plane hosted load balancers will listen on.`,
+									},
+								},
+							},
+						},
+						"vip_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Specified the Bare Metal Load Balancer Config`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_vip": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The VIP which you previously set aside for the Kubernetes API of this Bare Metal User Cluster.`,
+									},
+									"ingress_vip": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The VIP which you previously set aside for ingress traffic into this Bare Metal User Cluster.`,
+									},
+								},
+							},
+						},
+						"bgp_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for BGP typed load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_pools": {
+										Type:     schema.TypeList,
+										Required: true,
+										Description: `AddressPools is a list of non-overlapping IP pools used by load balancer
+typed services. All addresses must be routable to load balancer nodes.
+IngressVIP must be included in the pools.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"addresses": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Description: `The addresses that are part of this pool. Each address must be either in the CIDR form (1.2.3.0/24) or range form (1.2.3.1-1.2.3.5).`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"pool": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the address pool.`,
+												},
+												"avoid_buggy_ips": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Description: `If true, avoid using IPs ending in .0 or .255.
+This avoids buggy consumer devices mistakenly dropping IPv4 traffic for those special IP addresses.`,
+												},
+												"manual_assign": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `If true, prevent IP addresses from being automatically assigned.`,
+												},
+											},
+										},
+									},
+									"asn": {
+										Type:     schema.TypeInt,
+										Required: true,
+										Description: `BGP autonomous system number (ASN) of the cluster.
+This field can be updated after cluster creation.`,
+									},
+									"bgp_peer_configs": {
+										Type:     schema.TypeList,
+										Required: true,
+										Description: `The list of BGP peers that the cluster will connect to.
+At least one peer must be configured for each control plane node.
+Control plane nodes will connect to these peers to advertise the control
+plane VIP. The Services load balancer also uses these peers by default.
+This field can be updated after cluster creation.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"asn": {
+													Type:     schema.TypeInt,
+													Required: true,
+													Description: `BGP autonomous system number (ASN) for the network that contains the
+external peer device.`,
+												},
+												"ip_address": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The IP address of the external peer device.`,
+												},
+												"control_plane_nodes": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Description: `The IP address of the control plane node that connects to the external
+peer.
+If you don't specify any control plane nodes, all control plane nodes
+can connect to the external peer. If you specify one or more IP addresses,
+only the nodes specified participate in peering sessions.`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"load_balancer_node_pool_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Description: `Specifies the node pool running data plane load balancing. L2 connectivity
+is required among nodes in this pool. If missing, the control plane node
+pool is used for data plane load balancing.`,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"node_pool_config": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The generic configuration for a node pool running a load balancer.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"kubelet_config": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `The modifiable kubelet configurations for the baremetal machines.`,
+																MaxItems:    1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"registry_burst": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			Description: `The maximum size of bursty pulls, temporarily allows pulls to burst to this
+number, while still not exceeding registry_pull_qps.
+The value must not be a negative number.
+Updating this field may impact scalability by changing the amount of
+traffic produced by image pulls.
+Defaults to 10.`,
+																			Default: 10,
+																		},
+																		"registry_pull_qps": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			Description: `The limit of registry pulls per second.
+Setting this value to 0 means no limit.
+Updating this field may impact scalability by changing the amount of
+traffic produced by image pulls.
+Defaults to 5.`,
+																			Default: 5,
+																		},
+																		"serialize_image_pulls_disabled": {
+																			Type:     schema.TypeBool,
+																			Optional: true,
+																			Description: `Prevents the Kubelet from pulling multiple images at a time.
+We recommend *not* changing the default value on nodes that run docker
+daemon with version  < 1.9 or an Another Union File System (Aufs) storage
+backend. Issue https://github.com/kubernetes/kubernetes/issues/10959 has
+more details.`,
+																		},
+																	},
+																},
+															},
+															"labels": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																Elem: &schema.Schema{Type: schema.TypeString},
+															},
+															"node_configs": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `The list of machine addresses in the Bare Metal Node Pool.`,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"labels": {
+																			Type:     schema.TypeMap,
+																			Optional: true,
+																			Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																			Elem: &schema.Schema{Type: schema.TypeString},
+																		},
+																		"node_ip": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			Description: `The default IPv4 address for SSH access and Kubernetes node.
+Example: 192.168.0.1`,
+																		},
+																	},
+																},
+															},
+															"operating_system": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `Specifies the nodes operating system (default: LINUX).`,
+															},
+															"taints": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `The initial taints assigned to nodes of this node pool.`,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"effect": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+																			Description:  `Specifies the nodes operating system (default: LINUX). Possible values: ["EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+																		},
+																		"key": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Key associated with the effect.`,
+																		},
+																		"value": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Value associated with the effect.`,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.bgp_lb_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"manual_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Required:    true,
+										Description: `Whether manual load balancing is enabled.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.bgp_lb_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"metal_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_pools": {
+										Type:     schema.TypeList,
+										Required: true,
+										Description: `AddressPools is a list of non-overlapping IP pools used by load balancer
+typed services. All addresses must be routable to load balancer nodes.
+IngressVIP must be included in the pools.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"addresses": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Description: `The addresses that are part of this pool. Each address must be either in the CIDR form (1.2.3.0/24) or range form (1.2.3.1-1.2.3.5).`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"pool": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the address pool.`,
+												},
+												"avoid_buggy_ips": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Description: `If true, avoid using IPs ending in .0 or .255.
+This avoids buggy consumer devices mistakenly dropping IPv4 traffic for those special IP addresses.`,
+												},
+												"manual_assign": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `If true, prevent IP addresses from being automatically assigned.`,
+												},
+											},
+										},
+									},
+									"load_balancer_node_pool_config": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Specifies the load balancer's node pool configuration.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"node_pool_config": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The generic configuration for a node pool running a load balancer.`,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"labels": {
+																Type:     schema.TypeMap,
+																Computed: true,
+																Optional: true,
+																Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																Elem: &schema.Schema{Type: schema.TypeString},
+															},
+															"node_configs": {
+																Type:        schema.TypeList,
+																Optional:    true,
+																Description: `The list of machine addresses in the Bare Metal Node Pool.`,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"labels": {
+																			Type:     schema.TypeMap,
+																			Optional: true,
+																			Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+																			Elem: &schema.Schema{Type: schema.TypeString},
+																		},
+																		"node_ip": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			Description: `The default IPv4 address for SSH access and Kubernetes node.
+Example: 192.168.0.1`,
+																		},
+																	},
+																},
+															},
+															"operating_system": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Optional:    true,
+																Description: `Specifies the nodes operating system (default: LINUX).`,
+															},
+															"taints": {
+																Type:        schema.TypeList,
+																Computed:    true,
+																Optional:    true,
+																Description: `The initial taints assigned to nodes of this node pool.`,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"effect": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+																			Description:  `Specifies the nodes operating system (default: LINUX). Possible values: ["EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+																		},
+																		"key": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Key associated with the effect.`,
+																		},
+																		"value": {
+																			Type:        schema.TypeString,
+																			Optional:    true,
+																			Description: `Value associated with the effect.`,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.bgp_lb_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+					},
+				},
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The bare metal cluster name.`,
+			},
+			"network_config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Network configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_networking": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: `Enables the use of advanced Anthos networking features, such as Bundled
+Load Balancing with BGP or the egress NAT gateway.
+Setting configuration for advanced networking features will automatically
+set this flag.`,
+						},
+						"island_mode_cidr": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A nested object resource.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pod_address_cidr_blocks": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `All pods in the cluster are assigned an RFC1918 IPv4 address from these ranges. This field cannot be changed after creation.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"service_address_cidr_blocks": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `All services in the cluster are assigned an RFC1918 IPv4 address from these ranges. This field cannot be changed after creation.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.island_mode_cidr"},
+						},
+						"multiple_network_interfaces_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for multiple network interfaces.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Description: `Whether to enable multiple network interfaces for your pods.
+When set network_config.advanced_networking is automatically
+set to true.`,
+									},
+								},
+							},
+						},
+						"sr_iov_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for SR-IOV.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Whether to install the SR-IOV operator.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"storage": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Specifies the cluster storage configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lvp_node_mounts_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Specifies the config for local PersistentVolumes backed
+by mounted node disks. These disks need to be formatted and mounted by the
+user, which can be done before or after cluster creation.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The host machine path.`,
+									},
+									"storage_class": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The StorageClass name that PVs will be created with.`,
+									},
+								},
+							},
+						},
+						"lvp_share_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `Specifies the config for local PersistentVolumes backed by
+subdirectories in a shared filesystem. These subdirectores are
+automatically created during cluster creation.`,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lvp_config": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `Defines the machine path and storage class for the LVP Share.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"path": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The host machine path.`,
+												},
+												"storage_class": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The StorageClass name that PVs will be created with.`,
+												},
+											},
+										},
+									},
+									"shared_path_pv_count": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: `The number of subdirectories to create under path.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Annotations on the Bare Metal User Cluster.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"binary_authorization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Binary Authorization related configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"evaluation_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"DISABLED", "PROJECT_SINGLETON_POLICY_ENFORCE", ""}),
+							Description: `Mode of operation for binauthz policy evaluation. If unspecified,
+defaults to DISABLED. Possible values: ["DISABLED", "PROJECT_SINGLETON_POLICY_ENFORCE"]`,
+						},
+					},
+				},
+			},
+			"cluster_operations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the User Cluster's observability infrastructure.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_application_logs": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Whether collection of application logs/metrics should be enabled (in addition to system logs/metrics).`,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A human readable description of this Bare Metal User Cluster.`,
+			},
+			"maintenance_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the workload node configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All IPv4 address from these ranges will be placed into maintenance mode.
+Nodes in maintenance mode will be cordoned and drained. When both of these
+are true, the "baremetal.cluster.gke.io/maintenance" annotation will be set
+on the node resource.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"node_access_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the node access related settings for the bare metal user cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login_user": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							Description: `LoginUser is the user name used to access node machines.
+It defaults to "root" if not set.`,
+						},
+					},
+				},
+			},
+			"node_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the workload node configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_runtime": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"CONTAINER_RUNTIME_UNSPECIFIED", "DOCKER", "CONTAINERD", ""}),
+							Description:  `The available runtimes that can be used to run containers in a Bare Metal User Cluster. Possible values: ["CONTAINER_RUNTIME_UNSPECIFIED", "DOCKER", "CONTAINERD"]`,
+						},
+						"max_pods_per_node": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							Description: `The maximum number of pods a node can run. The size of the CIDR range
+assigned to the node will be derived from this parameter.`,
+						},
+					},
+				},
+			},
+			"os_environment_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `OS environment related configurations.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_repo_excluded": {
+							Type:     schema.TypeBool,
+							Required: true,
+							Description: `Whether the package repo should not be included when initializing
+bare metal machines.`,
+						},
+					},
+				},
+			},
+			"proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the cluster proxy configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `Specifies the address of your proxy server.
+For example: http://domain
+WARNING: Do not provide credentials in the format
+of http://(username:password@)domain these will be rejected by the server.`,
+						},
+						"no_proxy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `A list of IPs, hostnames, and domains that should skip the proxy.
+For example ["127.0.0.1", "example.com", ".corp", "localhost"].`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"security_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the security related settings for the Bare Metal User Cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configures user access to the Bare Metal User cluster.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"admin_users": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `Users that will be granted the cluster-admin role on the cluster, providing full access to the cluster.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"username": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the user, e.g. 'my-gcp-id@gmail.com'.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"upgrade_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The cluster upgrade policy.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateEnum([]string{"SERIAL", "CONCURRENT", ""}),
+							Description:  `Specifies which upgrade policy to use. Possible values: ["SERIAL", "CONCURRENT"]`,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was created, in RFC3339 text format.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was deleted, in RFC3339 text format.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The IP address name of Bare Metal User Cluster's API server.`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"fleet": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: `Fleet related configuration.
+Fleets are a Google Cloud concept for logically organizing clusters,
+letting you use and manage multi-cluster capabilities and apply
+consistent policies across your systems.
+See [Anthos Fleets](https://cloud.google.com/anthos/multicluster-management/fleets) for
+more details on Anthos multi-cluster capabilities using Fleets.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The name of the managed Hub Membership resource associated to this cluster.
+Membership names are formatted as
+'projects/<project-number>/locations/<location>/memberships/<cluster-id>'.`,
+						},
+					},
+				},
+			},
+			"local_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The object name of the Bare Metal Cluster custom resource on the
+associated admin cluster. This field is used to support conflicting
+names when enrolling existing clusters to the API. When used as a part of
+cluster enrollment, this field will differ from the ID in the resource
+name. For new clusters, this field will match the user provided cluster ID
+and be visible in the last component of the resource name. It is not
+modifiable.
+All users should use this name to access their cluster using gkectl or
+kubectl and should expect to see the local name when viewing admin
+cluster controller logs.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the Bare Metal User Cluster.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current state of this cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Specifies detailed cluster status.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from user cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the user cluster
+controller. The error message can be temporary as the user cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the Bare Metal User Cluster.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was last updated, in RFC3339 text format.`,
+			},
+			"validation_check": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Specifies the security related settings for the Bare Metal User Cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"options": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Options used for the validation check.`,
+						},
+						"scenario": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The scenario when the preflight checks were run..`,
+						},
+						"status": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Specifies the detailed validation check status`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"result": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `Individual checks which failed as part of the Preflight check execution.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"category": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The category of the validation.`,
+												},
+												"description": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The description of the validation check.`,
+												},
+												"details": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Detailed failure information, which might be unformatted.`,
+												},
+												"options": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Options used for the validation check.`,
+												},
+												"reason": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `A human-readable message of the check failure.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremBareMetalClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceGkeonpremBareMetalNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremBareMetalNodePoolResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremBareMetalNodePoolUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -291,6 +300,12 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1183,3 +1198,248 @@ func ResourceGkeonpremBareMetalNodePoolFlatten(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+func resourceGkeonpremBareMetalNodePoolResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bare_metal_cluster": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The cluster this node pool belongs to.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The bare metal node pool name.`,
+			},
+			"node_pool_config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Node pool configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_configs": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `The list of machine addresses in the Bare Metal Node Pool.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+										Elem: &schema.Schema{Type: schema.TypeString},
+									},
+									"node_ip": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `The default IPv4 address for SSH access and Kubernetes node.
+Example: 192.168.0.1`,
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Optional: true,
+							Description: `The map of Kubernetes labels (key/value pairs) to be applied to
+each node. These will added in addition to any default label(s)
+that Kubernetes may apply to the node. In case of conflict in
+label keys, the applied set may differ depending on the Kubernetes
+version -- it's best to assume the behavior is undefined and
+conflicts should be avoided. For more information, including usage
+and the valid values, see:
+  - http://kubernetes.io/v1.1/docs/user-guide/labels.html
+An object containing a list of "key": value pairs.
+For example: { "name": "wrench", "mass": "1.3kg", "count": "3" }.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"operating_system": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Specifies the nodes operating system (default: LINUX).`,
+						},
+						"taints": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `The initial taints assigned to nodes of this node pool.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+										Description:  `Specifies the nodes operating system (default: LINUX). Possible values: ["EFFECT_UNSPECIFIED", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+									},
+									"key": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Key associated with the effect.`,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Value associated with the effect.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Annotations on the Bare Metal Node Pool.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The display name for the Bare Metal Node Pool.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was created, in RFC3339 text format.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was deleted, in RFC3339 text format.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the Bare Metal User Cluster.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current state of this cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Specifies detailed node pool status.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from user cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the user cluster
+controller. The error message can be temporary as the user cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the Bare Metal Node Pool.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was last updated, in RFC3339 text format.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremBareMetalNodePoolUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceGkeonpremVmwareAdminCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremVmwareAdminClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremVmwareAdminClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -874,6 +883,12 @@ host names, and domain names that should not go through the proxy server.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -3957,3 +3972,870 @@ func ResourceGkeonpremVmwareAdminClusterFlatten(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func resourceGkeonpremVmwareAdminClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The VMware admin cluster resource name.`,
+			},
+			"network_config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `The VMware admin cluster network configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All pods in the cluster are assigned an RFC1918 IPv4 address from these ranges.
+Only a single range is supported. This field cannot be changed after creation.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"service_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All services in the cluster are assigned an RFC1918 IPv4 address
+from these ranges. Only a single range is supported.. This field
+cannot be changed after creation.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"dhcp_ip_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `Configuration settings for a DHCP IP configuration.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+										Description: `enabled is a flag to mark if DHCP IP allocation is
+used for VMware admin clusters.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.dhcp_ip_config", "network_config.0.static_ip_config"},
+						},
+						"ha_control_plane_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for HA admin cluster control plane.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_ip_block": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Static IP addresses for the control plane nodes.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gateway": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The network gateway used by the VMware Admin Cluster.`,
+												},
+												"ips": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Description: `The node's network configurations used by the VMware Admin Cluster.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ip": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `IP could be an IP address (like 1.2.3.4) or a CIDR (like 1.2.3.0/24).`,
+															},
+															"hostname": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Optional:    true,
+																Description: `Hostname of the machine. VM's name will be used if this field is empty.`,
+															},
+														},
+													},
+												},
+												"netmask": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The netmask used by the VMware Admin Cluster.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"host_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `Represents common network settings irrespective of the host's IP address.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dns_search_domains": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `DNS search domains.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"dns_servers": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `DNS servers.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ntp_servers": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `NTP servers.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"static_ip_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration settings for a static IP configuration.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_blocks": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Represents the configuration values for static IP allocation to nodes.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gateway": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The network gateway used by the VMware Admin Cluster.`,
+												},
+												"ips": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Description: `The node's network configurations used by the VMware Admin Cluster.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ip": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `IP could be an IP address (like 1.2.3.4) or a CIDR (like 1.2.3.0/24).`,
+															},
+															"hostname": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Optional:    true,
+																Description: `Hostname of the machine. VM's name will be used if this field is empty.`,
+															},
+														},
+													},
+												},
+												"netmask": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The netmask used by the VMware Admin Cluster.`,
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.dhcp_ip_config", "network_config.0.static_ip_config"},
+						},
+						"vcenter_network": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `vcenter_network specifies vCenter network name.`,
+						},
+					},
+				},
+			},
+			"addon_node": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `The VMware admin cluster addon node configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_resize_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `Specifies auto resize config.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Required:    true,
+										Description: `Whether to enable controle plane node auto resizing.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Optional: true,
+				Description: `Annotations on the VMware Admin Cluster.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"anti_affinity_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				Description: `AAGConfig specifies whether to spread VMware Admin Cluster nodes across at
+least three physical hosts in the datacenter.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aag_config_disabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							Description: `Spread nodes across at least three physical hosts (requires at least three
+hosts).
+Enabled by default.`,
+						},
+					},
+				},
+			},
+			"authorization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The VMware admin cluster authorization configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"viewer_users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Users that will be granted the cluster-admin role on the cluster, providing
+full access to the cluster.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The name of the user, e.g. 'my-gcp-id@gmail.com'.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"auto_repair_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Configuration for auto repairing.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: `Whether auto repair is enabled.`,
+						},
+					},
+				},
+			},
+			"bootstrap_cluster_membership": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: `The bootstrap cluster this VMware admin cluster belongs to.`,
+			},
+			"control_plane_node": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The VMware admin cluster control plane node configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpus": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The number of vCPUs for the control-plane node of the admin cluster.`,
+							Default:     4,
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The number of mebibytes of memory for the control-plane node of the admin cluster.`,
+							Default:     8192,
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The number of control plane nodes for this VMware admin cluster.`,
+							Default:     1,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: `A human readable description of this VMware admin cluster.`,
+			},
+			"enable_advanced_cluster": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Optional:    true,
+				Description: `If set, the advanced cluster feature is enabled.`,
+			},
+			"image_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Optional:    true,
+				Description: `The OS image type for the VMware admin cluster.`,
+			},
+			"load_balancer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the load balancer configuration for VMware admin cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vip_config": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `Specified the VMware Load Balancer Config`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_vip": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `The VIP which you previously set aside for the Kubernetes
+API of this VMware Admin Cluster.`,
+									},
+									"addons_vip": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The VIP to configure the load balancer for add-ons.`,
+									},
+								},
+							},
+						},
+						"f5_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for F5 Big IP typed load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The load balancer's IP address.`,
+									},
+									"partition": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `he preexisting partition to be used by the load balancer. T
+his partition is usually created for the admin cluster for example:
+'my-f5-admin-partition'.`,
+									},
+									"snat_pool": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The pool name. Only necessary, if using SNAT.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"manual_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Manually configured load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"addons_node_port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Optional:    true,
+										Description: `NodePort for add-ons server in the admin cluster.`,
+									},
+									"control_plane_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for control plane service. The Kubernetes API server in the admin
+cluster is implemented as a Service of type NodePort (ex. 30968).`,
+									},
+									"ingress_http_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for ingress service's http. The ingress service in the admin
+cluster is implemented as a Service of type NodePort (ex. 32527).`,
+									},
+									"ingress_https_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for ingress service's https. The ingress service in the admin
+cluster is implemented as a Service of type NodePort (ex. 30139).`,
+									},
+									"konnectivity_server_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for konnectivity server service running as a sidecar in each
+kube-apiserver pod (ex. 30564).`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"metal_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Metal LB load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Metal LB is enabled.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+					},
+				},
+			},
+			"on_prem_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The Anthos clusters on the VMware version for the admin cluster.`,
+			},
+			"platform_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The VMware platform configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_platform_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `The required platform version e.g. 1.13.1.
+If the current platform version is lower than the target version,
+the platform version will be updated to the target version.
+If the target version is not installed in the platform
+(bundle versions), download the target version bundle.`,
+						},
+						"bundles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The list of bundles installed in the admin cluster.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `ResourceStatus representing detailed cluster state.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"conditions": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from admin cluster controller.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"last_transition_time": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `Last time the condition transit from one status to another.`,
+															},
+															"message": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `Human-readable message indicating details about last transition.`,
+															},
+															"reason": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `Machine-readable message indicating details about last transition.`,
+															},
+															"state": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `The lifecycle state of the condition.`,
+															},
+															"type": {
+																Type:     schema.TypeString,
+																Computed: true,
+																Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+															},
+														},
+													},
+												},
+												"error_message": {
+													Type:     schema.TypeString,
+													Computed: true,
+													Description: `Human-friendly representation of the error message from the admin cluster
+controller. The error message can be temporary as the admin cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+												},
+											},
+										},
+									},
+									"version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The version of the bundle.`,
+									},
+								},
+							},
+						},
+						"platform_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The platform version e.g. 1.13.2.`,
+						},
+						"status": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceStatus representing detailed cluster state.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"conditions": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from admin cluster controller.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"last_transition_time": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Last time the condition transit from one status to another.`,
+												},
+												"message": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Human-readable message indicating details about last transition.`,
+												},
+												"reason": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Machine-readable message indicating details about last transition.`,
+												},
+												"state": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The lifecycle state of the condition.`,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+													Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+												},
+											},
+										},
+									},
+									"error_message": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Human-friendly representation of the error message from the admin cluster
+controller. The error message can be temporary as the admin cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"private_registry_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for private registry.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The registry address.`,
+						},
+						"ca_cert": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The CA certificate public key for private registry.`,
+						},
+					},
+				},
+			},
+			"proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for proxy.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The proxy url.`,
+						},
+						"no_proxy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: `A comma-separated list of IP addresses, IP address ranges,
+host names, and domain names that should not go through the proxy server.`,
+						},
+					},
+				},
+			},
+			"vcenter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies vCenter config for the admin cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The vCenter IP address.`,
+						},
+						"ca_cert_data": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Contains the vCenter CA certificate public key for SSL verification.`,
+						},
+						"cluster": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter cluster for the admin cluster.`,
+						},
+						"data_disk": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the virtual machine disk (VMDK) for the admin cluster.`,
+						},
+						"datacenter": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter datacenter for the admin cluster.`,
+						},
+						"datastore": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter datastore for the admin cluster.`,
+						},
+						"folder": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter folder for the admin cluster.`,
+						},
+						"resource_pool": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter resource pool for the admin cluster.`,
+						},
+						"storage_policy_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `The name of the vCenter storage policy for the user cluster.`,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was created, in RFC3339 text format.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The DNS name of VMware admin cluster's API server.`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"fleet": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Fleet configuration for the cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The name of the managed Fleet Membership resource associated to this cluster.
+Membership names are formatted as
+'projects/<project-number>/locations/<location>/memberships/<cluster-id>'.`,
+						},
+					},
+				},
+			},
+			"local_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The object name of the VMwareAdminCluster custom resource on the
+associated admin cluster. This field is used to support conflicting
+names when enrolling existing clusters to the API. When used as a part of
+cluster enrollment, this field will differ from the ID in the resource
+name. For new clusters, this field will match the user provided cluster ID
+and be visible in the last component of the resource name. It is not
+modifiable.
+All users should use this name to access their cluster using gkectl or
+kubectl and should expect to see the local name when viewing admin
+cluster controller logs.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the VMware admin cluster.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The lifecycle state of the VMware admin cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `ResourceStatus representing detailed cluster state.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from admin cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the admin cluster
+controller. The error message can be temporary as the admin cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the VMware Admin Cluster.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was last updated, in RFC3339 text format.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremVmwareAdminClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceGkeonpremVmwareCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremVmwareClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremVmwareClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -818,6 +827,12 @@ Inherited from the admin cluster.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -3784,3 +3799,877 @@ func ResourceGkeonpremVmwareClusterFlatten(d *schema.ResourceData, meta interfac
 
 	return nil
 }
+
+func resourceGkeonpremVmwareClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_cluster_membership": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.ProjectNumberDiffSuppress,
+				Description: `The admin cluster this VMware User Cluster belongs to.
+This is the full resource name of the admin cluster's hub membership.
+In the future, references to other resource types might be allowed if
+admin clusters are modeled as their own resources.`,
+			},
+			"control_plane_node": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `VMware User Cluster control plane nodes must have either 1 or 3 replicas.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_resize_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `AutoResizeConfig provides auto resizing configurations.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:        schema.TypeBool,
+										Required:    true,
+										Description: `Whether to enable control plane node auto resizing.`,
+									},
+								},
+							},
+						},
+						"cpus": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: `The number of CPUs for each admin cluster node that serve as control planes
+for this VMware User Cluster. (default: 4 CPUs)`,
+							Default: 4,
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: `The megabytes of memory for each admin cluster node that serves as a
+control plane for this VMware User Cluster (default: 8192 MB memory).`,
+							Default: 8192,
+						},
+						"replicas": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: `The number of control plane nodes for this VMware User Cluster.
+(default: 1 replica).`,
+							Default: 1,
+						},
+						"vsphere_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Vsphere-specific config.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The Vsphere datastore used by the Control Plane Node.`,
+									},
+									"storage_policy_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The Vsphere storage policy used by the control plane Node.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The VMware cluster name.`,
+			},
+			"on_prem_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The Anthos clusters on the VMware version for your user cluster.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Annotations on the VMware User Cluster.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"anti_affinity_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				Description: `AAGConfig specifies whether to spread VMware User Cluster nodes across at
+least three physical hosts in the datacenter.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aag_config_disabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							Description: `Spread nodes across at least three physical hosts (requires at least three
+hosts).
+Enabled by default.`,
+						},
+					},
+				},
+			},
+			"authorization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `RBAC policy that will be applied and managed by GKE On-Prem.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_users": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: `Users that will be granted the cluster-admin role on the cluster, providing
+full access to the cluster.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"username": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `The name of the user, e.g. 'my-gcp-id@gmail.com'.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"auto_repair_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Configuration for auto repairing.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: `Whether auto repair is enabled.`,
+						},
+					},
+				},
+			},
+			"dataplane_v2": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `VmwareDataplaneV2Config specifies configuration for Dataplane V2.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advanced_networking": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Enable advanced networking which requires dataplane_v2_enabled to be set true.`,
+						},
+						"dataplane_v2_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Enables Dataplane V2.`,
+						},
+						"windows_dataplane_v2_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Enable Dataplane V2 for clusters with Windows nodes.`,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `A human readable description of this VMware User Cluster.`,
+			},
+			"disable_bundled_ingress": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Disable bundled ingress.`,
+			},
+			"enable_advanced_cluster": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Enable advanced cluster. Default to false.`,
+			},
+			"enable_control_plane_v2": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Enable control plane V2. Default to false.`,
+			},
+			"load_balancer": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Load Balancer configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"f5_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for F5 Big IP typed load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The load balancer's IP address.`,
+									},
+									"partition": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `he preexisting partition to be used by the load balancer. T
+his partition is usually created for the admin cluster for example:
+'my-f5-admin-partition'.`,
+									},
+									"snat_pool": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										Description: `The pool name. Only necessary, if using SNAT.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"manual_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Manually configured load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for control plane service. The Kubernetes API server in the admin
+cluster is implemented as a Service of type NodePort (ex. 30968).`,
+									},
+									"ingress_http_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for ingress service's http. The ingress service in the admin
+cluster is implemented as a Service of type NodePort (ex. 32527).`,
+									},
+									"ingress_https_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for ingress service's https. The ingress service in the admin
+cluster is implemented as a Service of type NodePort (ex. 30139).`,
+									},
+									"konnectivity_server_node_port": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `NodePort for konnectivity server service running as a sidecar in each
+kube-apiserver pod (ex. 30564).`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"metal_lb_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for MetalLB typed load balancers.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_pools": {
+										Type:     schema.TypeList,
+										Required: true,
+										Description: `AddressPools is a list of non-overlapping IP pools used by load balancer
+typed services. All addresses must be routable to load balancer nodes.
+IngressVIP must be included in the pools.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"addresses": {
+													Type:     schema.TypeList,
+													Required: true,
+													Description: `The addresses that are part of this pool. Each address
+must be either in the CIDR form (1.2.3.0/24) or range
+form (1.2.3.1-1.2.3.5).`,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"pool": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The name of the address pool.`,
+												},
+												"avoid_buggy_ips": {
+													Type:     schema.TypeBool,
+													Computed: true,
+													Optional: true,
+													Description: `If true, avoid using IPs ending in .0 or .255.
+This avoids buggy consumer devices mistakenly dropping IPv4 traffic for
+those special IP addresses.`,
+												},
+												"manual_assign": {
+													Type:        schema.TypeBool,
+													Computed:    true,
+													Optional:    true,
+													Description: `If true, prevent IP addresses from being automatically assigned.`,
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"load_balancer.0.f5_config", "load_balancer.0.manual_lb_config", "load_balancer.0.metal_lb_config"},
+						},
+						"vip_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `The VIPs used by the load balancer.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_vip": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The VIP which you previously set aside for the Kubernetes API of this cluster.`,
+									},
+									"ingress_vip": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The VIP which you previously set aside for ingress traffic into this cluster.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"network_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `The VMware User Cluster network configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All pods in the cluster are assigned an RFC1918 IPv4 address from these ranges.
+Only a single range is supported. This field cannot be changed after creation.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"service_address_cidr_blocks": {
+							Type:     schema.TypeList,
+							Required: true,
+							Description: `All services in the cluster are assigned an RFC1918 IPv4 address
+from these ranges. Only a single range is supported.. This field
+cannot be changed after creation.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"control_plane_v2_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration for control plane V2 mode.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"control_plane_ip_block": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Static IP addresses for the control plane nodes.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gateway": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `The network gateway used by the VMware User Cluster.`,
+												},
+												"ips": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `The node's network configurations used by the VMware User Cluster.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"hostname": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Optional:    true,
+																Description: `Hostname of the machine. VM's name will be used if this field is empty.`,
+															},
+															"ip": {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Description: `IP could be an IP address (like 1.2.3.4) or a CIDR (like 1.2.3.0/24).`,
+															},
+														},
+													},
+												},
+												"netmask": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `The netmask used by the VMware User Cluster.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"dhcp_ip_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `Configuration settings for a DHCP IP configuration.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+										Description: `enabled is a flag to mark if DHCP IP allocation is
+used for VMware user clusters.`,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.dhcp_ip_config", "network_config.0.static_ip_config"},
+						},
+						"host_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `Represents common network settings irrespective of the host's IP address.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dns_search_domains": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `DNS search domains.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"dns_servers": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `DNS servers.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ntp_servers": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `NTP servers.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"static_ip_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Configuration settings for a static IP configuration.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_blocks": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: `Represents the configuration values for static IP allocation to nodes.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"gateway": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The network gateway used by the VMware User Cluster.`,
+												},
+												"ips": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Description: `The node's network configurations used by the VMware User Cluster.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"ip": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `IP could be an IP address (like 1.2.3.4) or a CIDR (like 1.2.3.0/24).`,
+															},
+															"hostname": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Optional:    true,
+																Description: `Hostname of the machine. VM's name will be used if this field is empty.`,
+															},
+														},
+													},
+												},
+												"netmask": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The netmask used by the VMware User Cluster.`,
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"network_config.0.dhcp_ip_config", "network_config.0.static_ip_config"},
+						},
+						"vcenter_network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `vcenter_network specifies vCenter network name. Inherited from the admin cluster.`,
+						},
+					},
+				},
+			},
+			"skip_validations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `A list of validations to skip during preflight checks. Possible values: ["VALIDATION_SKIP_UNSPECIFIED", "ALL", "WORKSTATION", "CONFIG", "DOCKER", "INFRA", "LOAD_BALANCER", "VIPS", "NODE_IPS", "DNS", "TOD", "NET_CONFIG", "STORAGE_DRIVER", "PROXY", "INTERNET", "GCP", "GKEHUB", "RESERVED_IPS", "STACKDRIVER", "NODEPOOL_AUTOSCALING", "OS_IMAGES", "CLUSTER_VERSION", "CLUSTER_HEALTH", "WINDOWS", "HSM_SECRET_ENCRYPTION", "BACKUP_ADMIN", "CONNECTIVITY", "CLUSTER_SECRETS_CONFIG", "CSI_WORKLOAD", "VSPHERE_VERSION", "MIGRATION"]`,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidateEnum([]string{"VALIDATION_SKIP_UNSPECIFIED", "ALL", "WORKSTATION", "CONFIG", "DOCKER", "INFRA", "LOAD_BALANCER", "VIPS", "NODE_IPS", "DNS", "TOD", "NET_CONFIG", "STORAGE_DRIVER", "PROXY", "INTERNET", "GCP", "GKEHUB", "RESERVED_IPS", "STACKDRIVER", "NODEPOOL_AUTOSCALING", "OS_IMAGES", "CLUSTER_VERSION", "CLUSTER_HEALTH", "WINDOWS", "HSM_SECRET_ENCRYPTION", "BACKUP_ADMIN", "CONNECTIVITY", "CLUSTER_SECRETS_CONFIG", "CSI_WORKLOAD", "VSPHERE_VERSION", "MIGRATION"}),
+				},
+			},
+			"storage": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Storage configuration.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vsphere_csi_disabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							Description: `Whether or not to deploy vSphere CSI components in the VMware User Cluster.
+Enabled by default.`,
+						},
+					},
+				},
+			},
+			"upgrade_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies upgrade policy for the cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_plane_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: `Controls whether the upgrade applies to the control plane only.`,
+						},
+					},
+				},
+			},
+			"vcenter": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				Description: `VmwareVCenterConfig specifies vCenter config for the user cluster.
+Inherited from the admin cluster.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ca_cert_data": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Contains the vCenter CA certificate public key for SSL verification.`,
+						},
+						"cluster": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter cluster for the user cluster.`,
+						},
+						"datacenter": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter datacenter for the user cluster.`,
+						},
+						"datastore": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter datastore for the user cluster.`,
+						},
+						"folder": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter folder for the user cluster.`,
+						},
+						"resource_pool": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter resource pool for the user cluster.`,
+						},
+						"storage_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The name of the vCenter storage policy for the user cluster.`,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The vCenter IP address.`,
+						},
+					},
+				},
+			},
+			"vm_tracking_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Optional:    true,
+				Description: `Enable VM tracking.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time at which VMware User Cluster was created.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time at which VMware User Cluster was deleted.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The DNS name of VMware User Cluster's API server.`,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"fleet": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Fleet configuration for the cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"membership": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The name of the managed Hub Membership resource associated to this cluster.
+Membership names are formatted as
+'projects/<project-number>/locations/<location>/memberships/<cluster-id>'.`,
+						},
+					},
+				},
+			},
+			"local_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The object name of the VMware OnPremUserCluster custom resource on the
+associated admin cluster. This field is used to support conflicting
+names when enrolling existing clusters to the API. When used as a part of
+cluster enrollment, this field will differ from the ID in the resource
+name. For new clusters, this field will match the user provided cluster ID
+and be visible in the last component of the resource name. It is not
+modifiable.
+
+All users should use this name to access their cluster using gkectl or
+kubectl and should expect to see the local name when viewing admin
+cluster controller logs.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the VMware User Cluster.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current state of this cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `ResourceStatus representing detailed cluster state.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from user cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the user cluster
+controller. The error message can be temporary as the user cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the VMware User Cluster.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time at which VMware User Cluster was last updated.`,
+			},
+			"validation_check": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `ValidationCheck represents the result of the preflight check job.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"options": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Options used for the validation check.`,
+						},
+						"scenario": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The scenario when the preflight checks were run..`,
+						},
+						"status": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `Specifies the detailed validation check status`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"result": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `Individual checks which failed as part of the Preflight check execution.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"category": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The category of the validation.`,
+												},
+												"description": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The description of the validation check.`,
+												},
+												"details": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Detailed failure information, which might be unformatted.`,
+												},
+												"options": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `Options used for the validation check.`,
+												},
+												"reason": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `A human-readable message of the check failure.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremVmwareClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceGkeonpremVmwareNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGkeonpremVmwareNodePoolResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceGkeonpremVmwareNodePoolUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -357,6 +366,12 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1597,3 +1612,314 @@ func ResourceGkeonpremVmwareNodePoolFlatten(d *schema.ResourceData, meta interfa
 
 	return nil
 }
+
+func resourceGkeonpremVmwareNodePoolResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"config": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `The node configuration of the node pool.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: `The OS image to be used for each node in a node pool.
+Currently 'cos', 'cos_cgv2', 'ubuntu', 'ubuntu_cgv2', 'ubuntu_containerd' and 'windows' are supported.`,
+						},
+						"boot_disk_size_gb": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `VMware disk size to be used during creation.`,
+						},
+						"cpus": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The number of CPUs for each node in the node pool.`,
+							Default:     4,
+						},
+						"enable_load_balancer": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: `Allow node pool traffic to be load balanced. Only works for clusters with
+MetalLB load balancers.`,
+						},
+						"image": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `The OS image name in vCenter, only valid when using Windows.`,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Optional: true,
+							Description: `The map of Kubernetes labels (key/value pairs) to be applied to each node.
+These will added in addition to any default label(s) that
+Kubernetes may apply to the node.
+In case of conflict in label keys, the applied set may differ depending on
+the Kubernetes version -- it's best to assume the behavior is undefined
+and conflicts should be avoided.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"memory_mb": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The megabytes of memory for each node in the node pool.`,
+							Default:     8192,
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `The number of nodes in the node pool.`,
+							Default:     1,
+						},
+						"taints": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `The initial taints assigned to nodes of this node pool.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Key associated with the effect.`,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: `Value associated with the effect.`,
+									},
+									"effect": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"EFFECT_UNSPECIFIED", "NO_SCHEDULE", "PREFER_NO_SCHEDULE", "NO_EXECUTE", ""}),
+										Description:  `Available taint effects. Possible values: ["EFFECT_UNSPECIFIED", "NO_SCHEDULE", "PREFER_NO_SCHEDULE", "NO_EXECUTE"]`,
+									},
+								},
+							},
+						},
+						"vsphere_config": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Specifies the vSphere config for node pool.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The name of the vCenter datastore. Inherited from the user cluster.`,
+									},
+									"host_groups": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Vsphere host groups to apply to all VMs in the node pool`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"tags": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Tags to apply to VMs.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"category": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `The Vsphere tag category.`,
+												},
+												"tag": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `The Vsphere tag name.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the resource.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The vmware node pool name.`,
+			},
+			"vmware_cluster": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The cluster this node pool belongs to.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Annotations on the node Pool.
+This field has the same restrictions as Kubernetes annotations.
+The total size of all keys and values combined is limited to 256k.
+Key can have 2 segments: prefix (optional) and name (required),
+separated by a slash (/).
+Prefix must be a DNS subdomain.
+Name must be 63 characters or less, begin and end with alphanumerics,
+with dashes (-), underscores (_), dots (.), and alphanumerics between.
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The display name for the node pool.`,
+			},
+			"node_pool_autoscaling": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Node Pool autoscaling config for the node pool.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_replicas": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Maximum number of replicas in the NodePool.`,
+						},
+						"min_replicas": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Minimum number of replicas in the NodePool.`,
+						},
+					},
+				},
+			},
+			"on_prem_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Anthos version for the node pool. Defaults to the user cluster version.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was created, in RFC3339 text format.`,
+			},
+			"delete_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was deleted, in RFC3339 text format.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `This checksum is computed by the server based on the value of other
+fields, and may be sent on update and delete requests to ensure the
+client has an up-to-date value before proceeding.
+Allows clients to perform consistent read-modify-writes
+through optimistic concurrency control.`,
+			},
+			"reconciling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `If set, there are currently changes in flight to the node pool.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The current state of this cluster.`,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `ResourceStatus representing detailed cluster state.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `ResourceConditions provide a standard mechanism for higher-level status reporting from user cluster controller.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"last_transition_time": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Last time the condition transit from one status to another.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Human-readable message indicating details about last transition.`,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Machine-readable message indicating details about last transition.`,
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The lifecycle state of the condition.`,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Description: `Type of the condition.
+(e.g., ClusterRunning, NodePoolRunning or ServerSidePreflightReady)`,
+									},
+								},
+							},
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Human-friendly representation of the error message from the user cluster
+controller. The error message can be temporary as the user cluster
+controller creates a cluster or node pool. If the error message persists
+for a longer period of time, it can be used to surface error message to
+indicate real problems requiring user intervention.`,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The unique identifier of the node pool.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the cluster was last updated, in RFC3339 text format.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceGkeonpremVmwareNodePoolUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
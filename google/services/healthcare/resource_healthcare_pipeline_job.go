@@ -763,18 +763,7 @@ func flattenHealthcarePipelineJobDisableLineage(v interface{}, d *schema.Resourc
 }
 
 func flattenHealthcarePipelineJobLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenHealthcarePipelineJobMappingPipelineJob(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -954,18 +943,7 @@ func flattenHealthcarePipelineJobBackfillPipelineJobMappingPipelineJob(v interfa
 }
 
 func flattenHealthcarePipelineJobTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenHealthcarePipelineJobEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1626,18 +1626,7 @@ func flattenHypercomputeclusterClusterDescription(v interface{}, d *schema.Resou
 }
 
 func flattenHypercomputeclusterClusterLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenHypercomputeclusterClusterName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2376,18 +2365,7 @@ func flattenHypercomputeclusterClusterUpdateTime(v interface{}, d *schema.Resour
 }
 
 func flattenHypercomputeclusterClusterTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenHypercomputeclusterClusterEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -113,6 +113,15 @@ func ResourceIAM3FoldersPolicyBinding() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceIAM3FoldersPolicyBindingResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceIAM3FoldersPolicyBindingUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 		),
@@ -270,6 +279,12 @@ to the policy kind) - The input policy kind   Possible values:  POLICY_KIND_UNSP
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -939,3 +954,169 @@ func ResourceIAM3FoldersPolicyBindingFlatten(d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+func resourceIAM3FoldersPolicyBindingResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"folder": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The parent folder for the PolicyBinding.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location of the PolicyBinding.`,
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Required. Immutable. The resource name of the policy to be bound. The binding parent and policy must belong to the same Organization (or Project).`,
+			},
+			"policy_binding_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The Policy Binding ID.`,
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: `Target is the full resource name of the resource to which the policy will be bound. Immutable once set.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_set": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Description: `Required. Immutable. Full Resource Name of the principal set used for principal access boundary policy bindings.
+Examples for each one of the following supported principal set types:
+* Folder: '//cloudresourcemanager.googleapis.com/folders/FOLDER_ID'
+It must be parent by the policy binding's parent (the folder).`,
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Optional. User defined annotations. See https://google.aip.dev/148#annotations for more details such as format and size limitations
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: `Represents a textual expression in the Common Expression Language
+(CEL) syntax. CEL is a C-like expression language. The syntax and semantics of
+CEL are documented at https://github.com/google/cel-spec.
+Example (Comparison):
+title: \"Summary size limit\"
+description: \"Determines if a summary is less than 100 chars\"
+expression: \"document.summary.size() < 100\"
+Example
+(Equality):
+title: \"Requestor is owner\"
+description: \"Determines if requestor is the document owner\"
+expression: \"document.owner == request.auth.claims.email\"  Example
+(Logic):
+title: \"Public documents\"
+description: \"Determine whether the document should be publicly visible\"
+expression: \"document.type != 'private' && document.type != 'internal'\"
+Example (Data Manipulation):
+title: \"Notification string\"
+description: \"Create a notification string with a timestamp.\"
+expression: \"'New message received at ' + string(document.create_time)\"
+The exact variables and functions that may be referenced within an expression are
+determined by the service that evaluates it. See the service documentation for
+additional information.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. Description of the expression. This is a longer text which describes the expression, e.g. when hovered over it in a UI.`,
+						},
+						"expression": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Textual representation of an expression in Common Expression Language syntax.`,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. String indicating the location of the expression for error reporting, e.g. a file name and a position in the file.`,
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Optional. Title for the expression, i.e. a short string describing its purpose. This can be used e.g. in UIs which allow to enter the expression.`,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Optional. The description of the policy binding. Must be less than or equal to 63 characters.`,
+			},
+			"policy_kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: `Immutable. The kind of the policy to attach in this binding. This
+field must be one of the following:  - Left empty (will be automatically set
+to the policy kind) - The input policy kind   Possible values:  POLICY_KIND_UNSPECIFIED PRINCIPAL_ACCESS_BOUNDARY ACCESS`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The time when the policy binding was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Optional. The etag for the policy binding. If this is provided on update, it must match the server's etag.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the policy binding in the format '{binding_parent/locations/{location}/policyBindings/{policy_binding_id}'`,
+			},
+			"policy_uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The globally unique ID of the policy to be bound.`,
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The globally unique ID of the policy binding. Assigned when the policy binding is created.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The time when the policy binding was most recently updated.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceIAM3FoldersPolicyBindingUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -113,6 +113,15 @@ func ResourceIAM3PrincipalAccessBoundaryPolicy() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceIAM3PrincipalAccessBoundaryPolicyResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceIAM3PrincipalAccessBoundaryPolicyUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 		),
@@ -242,6 +251,12 @@ won't get updated when new versions are released.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -842,3 +857,137 @@ func ResourceIAM3PrincipalAccessBoundaryPolicyFlatten(d *schema.ResourceData, me
 
 	return nil
 }
+
+func resourceIAM3PrincipalAccessBoundaryPolicyResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location the principal access boundary policy is in.`,
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The parent organization of the principal access boundary policy.`,
+			},
+			"principal_access_boundary_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The ID to use to create the principal access boundary policy.
+This value must start with a lowercase letter followed by up to 62 lowercase letters, numbers, hyphens, or dots. Pattern, /a-z{2,62}/.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `User defined annotations. See https://google.aip.dev/148#annotations
+for more details such as format and size limitations
+
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Principal access boundary policy details`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rules": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: `A list of principal access boundary policy rules. The number of rules in a policy is limited to 500.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:     schema.TypeString,
+										Required: true,
+										Description: `The access relationship of principals to the resources in this rule.
+Possible values: ALLOW`,
+									},
+									"resources": {
+										Type:     schema.TypeList,
+										Required: true,
+										Description: `A list of Cloud Resource Manager resources. The resource
+and all the descendants are included. The number of resources in a policy
+is limited to 500 across all rules.
+The following resource types are supported:
+* Organizations, such as '//cloudresourcemanager.googleapis.com/organizations/123'.
+* Folders, such as '//cloudresourcemanager.googleapis.com/folders/123'.
+* Projects, such as '//cloudresourcemanager.googleapis.com/projects/123'
+or '//cloudresourcemanager.googleapis.com/projects/my-project-id'.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `The description of the principal access boundary policy rule. Must be less than or equal to 256 characters.`,
+									},
+								},
+							},
+						},
+						"enforcement_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							Description: `The version number that indicates which Google Cloud services
+are included in the enforcement (e.g. \"latest\", \"1\", ...). If empty, the
+PAB policy version will be set to the current latest version, and this version
+won't get updated when new versions are released.`,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The description of the principal access boundary policy. Must be less than or equal to 63 characters.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The time when the principal access boundary policy was created.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The etag for the principal access boundary. If this is provided on update, it must match the server's etag.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Identifier. The resource name of the principal access boundary policy.  The following format is supported:
+ 'organizations/{organization_id}/locations/{location}/principalAccessBoundaryPolicies/{policy_id}'`,
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The globally unique ID of the principal access boundary policy.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Output only. The time when the principal access boundary policy was most recently updated.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceIAM3PrincipalAccessBoundaryPolicyUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -1789,18 +1789,7 @@ func flattenIntegrationConnectorsConnectionDescription(v interface{}, d *schema.
 }
 
 func flattenIntegrationConnectorsConnectionLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenIntegrationConnectorsConnectionConnectorVersion(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -3099,18 +3088,7 @@ func flattenIntegrationConnectorsConnectionConnectorVersionInfraConfigRatelimitT
 }
 
 func flattenIntegrationConnectorsConnectionTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenIntegrationConnectorsConnectionEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
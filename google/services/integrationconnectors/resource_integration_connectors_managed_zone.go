@@ -610,18 +610,7 @@ func flattenIntegrationConnectorsManagedZoneDescription(v interface{}, d *schema
 }
 
 func flattenIntegrationConnectorsManagedZoneLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenIntegrationConnectorsManagedZoneDns(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -637,18 +626,7 @@ func flattenIntegrationConnectorsManagedZoneTargetVpc(v interface{}, d *schema.R
 }
 
 func flattenIntegrationConnectorsManagedZoneTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenIntegrationConnectorsManagedZoneEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
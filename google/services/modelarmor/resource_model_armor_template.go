@@ -791,18 +791,7 @@ func flattenModelArmorTemplateUpdateTime(v interface{}, d *schema.ResourceData,
 }
 
 func flattenModelArmorTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenModelArmorTemplateFilterConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1061,18 +1050,7 @@ func flattenModelArmorTemplateTemplateMetadataEnforcementType(v interface{}, d *
 }
 
 func flattenModelArmorTemplateTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenModelArmorTemplateEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
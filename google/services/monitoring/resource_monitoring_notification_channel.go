@@ -721,7 +721,7 @@ func flattenMonitoringNotificationChannelType(v interface{}, d *schema.ResourceD
 }
 
 func flattenMonitoringNotificationChannelUserLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	return v
+	return tpgresource.FlattenLabels(v, d, "user_labels", config)
 }
 
 func flattenMonitoringNotificationChannelDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
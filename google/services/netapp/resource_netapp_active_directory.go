@@ -991,18 +991,7 @@ func flattenNetappActiveDirectoryEncryptDcConnections(v interface{}, d *schema.R
 }
 
 func flattenNetappActiveDirectoryLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetappActiveDirectoryStateDetails(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1010,18 +999,7 @@ func flattenNetappActiveDirectoryStateDetails(v interface{}, d *schema.ResourceD
 }
 
 func flattenNetappActiveDirectoryTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetappActiveDirectoryEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -722,18 +722,7 @@ func flattenNetworkConnectivityDestinationUpdateTime(v interface{}, d *schema.Re
 }
 
 func flattenNetworkConnectivityDestinationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkConnectivityDestinationEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -830,18 +819,7 @@ func flattenNetworkConnectivityDestinationUid(v interface{}, d *schema.ResourceD
 }
 
 func flattenNetworkConnectivityDestinationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkConnectivityDestinationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
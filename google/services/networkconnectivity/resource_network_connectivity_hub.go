@@ -564,18 +564,7 @@ func flattenNetworkConnectivityHubUpdateTime(v interface{}, d *schema.ResourceDa
 }
 
 func flattenNetworkConnectivityHubLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkConnectivityHubDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -625,18 +614,7 @@ func flattenNetworkConnectivityHubExportPsc(v interface{}, d *schema.ResourceDat
 }
 
 func flattenNetworkConnectivityHubTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkConnectivityHubEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -668,18 +668,7 @@ func flattenNetworkConnectivityMulticloudDataTransferConfigUpdateTime(v interfac
 }
 
 func flattenNetworkConnectivityMulticloudDataTransferConfigLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkConnectivityMulticloudDataTransferConfigEtag(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -783,18 +772,7 @@ func flattenNetworkConnectivityMulticloudDataTransferConfigUid(v interface{}, d
 }
 
 func flattenNetworkConnectivityMulticloudDataTransferConfigTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkConnectivityMulticloudDataTransferConfigEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -539,18 +539,7 @@ func flattenNetworkConnectivityRegionalEndpointUpdateTime(v interface{}, d *sche
 }
 
 func flattenNetworkConnectivityRegionalEndpointLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkConnectivityRegionalEndpointDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -582,18 +571,7 @@ func flattenNetworkConnectivityRegionalEndpointAddress(v interface{}, d *schema.
 }
 
 func flattenNetworkConnectivityRegionalEndpointTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkConnectivityRegionalEndpointEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -991,18 +991,7 @@ func flattenNetworkConnectivitySpokeUpdateTime(v interface{}, d *schema.Resource
 }
 
 func flattenNetworkConnectivitySpokeLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkConnectivitySpokeDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1295,18 +1284,7 @@ func flattenNetworkConnectivitySpokeReasonsUserDetails(v interface{}, d *schema.
 }
 
 func flattenNetworkConnectivitySpokeTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkConnectivitySpokeEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1112,18 +1112,7 @@ func flattenNetworkManagementConnectivityTestRelatedProjects(v interface{}, d *s
 }
 
 func flattenNetworkManagementConnectivityTestLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkManagementConnectivityTestRoundTrip(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1135,18 +1124,7 @@ func flattenNetworkManagementConnectivityTestBypassFirewallChecks(v interface{},
 }
 
 func flattenNetworkManagementConnectivityTestTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkManagementConnectivityTestEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1605,18 +1605,7 @@ func flattenNetworkSecurityAuthzPolicyPolicyProfile(v interface{}, d *schema.Res
 }
 
 func flattenNetworkSecurityAuthzPolicyLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkSecurityAuthzPolicyTarget(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2664,18 +2653,7 @@ func flattenNetworkSecurityAuthzPolicyCustomProviderAuthzExtensionResources(v in
 }
 
 func flattenNetworkSecurityAuthzPolicyTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkSecurityAuthzPolicyEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
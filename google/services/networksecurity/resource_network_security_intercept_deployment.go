@@ -632,18 +632,7 @@ func flattenNetworkSecurityInterceptDeploymentUpdateTime(v interface{}, d *schem
 }
 
 func flattenNetworkSecurityInterceptDeploymentLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkSecurityInterceptDeploymentForwardingRule(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -667,18 +656,7 @@ func flattenNetworkSecurityInterceptDeploymentDescription(v interface{}, d *sche
 }
 
 func flattenNetworkSecurityInterceptDeploymentTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkSecurityInterceptDeploymentEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -660,18 +660,7 @@ func flattenNetworkSecurityMirroringDeploymentGroupUpdateTime(v interface{}, d *
 }
 
 func flattenNetworkSecurityMirroringDeploymentGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkSecurityMirroringDeploymentGroupNetwork(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -740,18 +729,7 @@ func flattenNetworkSecurityMirroringDeploymentGroupLocationsState(v interface{},
 }
 
 func flattenNetworkSecurityMirroringDeploymentGroupTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkSecurityMirroringDeploymentGroupEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -747,18 +747,7 @@ func flattenNetworkSecurityMirroringEndpointGroupUpdateTime(v interface{}, d *sc
 }
 
 func flattenNetworkSecurityMirroringEndpointGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkSecurityMirroringEndpointGroupMirroringDeploymentGroup(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -868,18 +857,7 @@ func flattenNetworkSecurityMirroringEndpointGroupConnectedDeploymentGroupsLocati
 }
 
 func flattenNetworkSecurityMirroringEndpointGroupTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkSecurityMirroringEndpointGroupEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
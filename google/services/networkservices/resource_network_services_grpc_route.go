@@ -854,18 +854,7 @@ func flattenNetworkServicesGrpcRouteUpdateTime(v interface{}, d *schema.Resource
 }
 
 func flattenNetworkServicesGrpcRouteLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesGrpcRouteDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1183,18 +1172,7 @@ func flattenNetworkServicesGrpcRouteRulesActionRetryPolicyNumRetries(v interface
 }
 
 func flattenNetworkServicesGrpcRouteTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesGrpcRouteEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1125,18 +1125,7 @@ func flattenNetworkServicesHttpRouteUpdateTime(v interface{}, d *schema.Resource
 }
 
 func flattenNetworkServicesHttpRouteLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesHttpRouteDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1825,18 +1814,7 @@ func flattenNetworkServicesHttpRouteRulesActionCorsPolicyDisabled(v interface{},
 }
 
 func flattenNetworkServicesHttpRouteTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesHttpRouteEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
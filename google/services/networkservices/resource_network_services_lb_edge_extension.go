@@ -701,18 +701,7 @@ func flattenNetworkServicesLbEdgeExtensionDescription(v interface{}, d *schema.R
 }
 
 func flattenNetworkServicesLbEdgeExtensionLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesLbEdgeExtensionForwardingRules(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -807,18 +796,7 @@ func flattenNetworkServicesLbEdgeExtensionLoadBalancingScheme(v interface{}, d *
 }
 
 func flattenNetworkServicesLbEdgeExtensionTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesLbEdgeExtensionEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -766,18 +766,7 @@ func flattenNetworkServicesLbRouteExtensionDescription(v interface{}, d *schema.
 }
 
 func flattenNetworkServicesLbRouteExtensionLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesLbRouteExtensionForwardingRules(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -900,18 +889,7 @@ func flattenNetworkServicesLbRouteExtensionLoadBalancingScheme(v interface{}, d
 }
 
 func flattenNetworkServicesLbRouteExtensionTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesLbRouteExtensionEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -648,18 +648,7 @@ func flattenNetworkServicesMulticastConsumerAssociationDescription(v interface{}
 }
 
 func flattenNetworkServicesMulticastConsumerAssociationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastConsumerAssociationMulticastDomainActivation(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -704,18 +693,7 @@ func flattenNetworkServicesMulticastConsumerAssociationUpdateTime(v interface{},
 }
 
 func flattenNetworkServicesMulticastConsumerAssociationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastConsumerAssociationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -722,18 +722,7 @@ func flattenNetworkServicesMulticastDomainDescription(v interface{}, d *schema.R
 }
 
 func flattenNetworkServicesMulticastDomainLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainMulticastDomainGroup(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -787,18 +776,7 @@ func flattenNetworkServicesMulticastDomainUpdateTime(v interface{}, d *schema.Re
 }
 
 func flattenNetworkServicesMulticastDomainTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -714,18 +714,7 @@ func flattenNetworkServicesMulticastDomainActivationDisablePlacementPolicy(v int
 }
 
 func flattenNetworkServicesMulticastDomainActivationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainActivationMulticastDomain(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -816,18 +805,7 @@ func flattenNetworkServicesMulticastDomainActivationUpdateTime(v interface{}, d
 }
 
 func flattenNetworkServicesMulticastDomainActivationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainActivationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -618,18 +618,7 @@ func flattenNetworkServicesMulticastDomainGroupDescription(v interface{}, d *sch
 }
 
 func flattenNetworkServicesMulticastDomainGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainGroupMulticastDomains(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -666,18 +655,7 @@ func flattenNetworkServicesMulticastDomainGroupUpdateTime(v interface{}, d *sche
 }
 
 func flattenNetworkServicesMulticastDomainGroupTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastDomainGroupEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -671,18 +671,7 @@ func flattenNetworkServicesMulticastGroupConsumerActivationDescription(v interfa
 }
 
 func flattenNetworkServicesMulticastGroupConsumerActivationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupConsumerActivationLogConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -740,18 +729,7 @@ func flattenNetworkServicesMulticastGroupConsumerActivationUpdateTime(v interfac
 }
 
 func flattenNetworkServicesMulticastGroupConsumerActivationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupConsumerActivationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -639,18 +639,7 @@ func flattenNetworkServicesMulticastGroupProducerActivationDescription(v interfa
 }
 
 func flattenNetworkServicesMulticastGroupProducerActivationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupProducerActivationMulticastGroupRangeActivation(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -691,18 +680,7 @@ func flattenNetworkServicesMulticastGroupProducerActivationUpdateTime(v interfac
 }
 
 func flattenNetworkServicesMulticastGroupProducerActivationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupProducerActivationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
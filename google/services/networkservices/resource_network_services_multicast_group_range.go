@@ -760,18 +760,7 @@ func flattenNetworkServicesMulticastGroupRangeIpCidrRange(v interface{}, d *sche
 }
 
 func flattenNetworkServicesMulticastGroupRangeLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupRangeLogConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -833,18 +822,7 @@ func flattenNetworkServicesMulticastGroupRangeUpdateTime(v interface{}, d *schem
 }
 
 func flattenNetworkServicesMulticastGroupRangeTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupRangeEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -689,18 +689,7 @@ func flattenNetworkServicesMulticastGroupRangeActivationIpCidrRange(v interface{
 }
 
 func flattenNetworkServicesMulticastGroupRangeActivationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupRangeActivationLogConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -762,18 +751,7 @@ func flattenNetworkServicesMulticastGroupRangeActivationUpdateTime(v interface{}
 }
 
 func flattenNetworkServicesMulticastGroupRangeActivationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesMulticastGroupRangeActivationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
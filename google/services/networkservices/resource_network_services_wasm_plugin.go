@@ -745,18 +745,7 @@ func flattenNetworkServicesWasmPluginDescription(v interface{}, d *schema.Resour
 }
 
 func flattenNetworkServicesWasmPluginLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenNetworkServicesWasmPluginMainVersionId(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -874,18 +863,7 @@ func flattenNetworkServicesWasmPluginUsedByName(v interface{}, d *schema.Resourc
 }
 
 func flattenNetworkServicesWasmPluginTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenNetworkServicesWasmPluginEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
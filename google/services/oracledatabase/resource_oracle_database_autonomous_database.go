@@ -2354,18 +2354,7 @@ func flattenOracleDatabaseAutonomousDatabasePropertiesMaintenanceEndTime(v inter
 }
 
 func flattenOracleDatabaseAutonomousDatabaseLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseAutonomousDatabaseNetwork(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2397,18 +2386,7 @@ func flattenOracleDatabaseAutonomousDatabaseDisasterRecoverySupportedLocations(v
 }
 
 func flattenOracleDatabaseAutonomousDatabaseTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseAutonomousDatabaseEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1245,18 +1245,7 @@ func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMonthlyDbServerVer
 }
 
 func flattenOracleDatabaseCloudExadataInfrastructureLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseCloudExadataInfrastructureCreateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1264,18 +1253,7 @@ func flattenOracleDatabaseCloudExadataInfrastructureCreateTime(v interface{}, d
 }
 
 func flattenOracleDatabaseCloudExadataInfrastructureTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseCloudExadataInfrastructureEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1093,18 +1093,7 @@ func flattenOracleDatabaseDbSystemGcpOracleZone(v interface{}, d *schema.Resourc
 }
 
 func flattenOracleDatabaseDbSystemLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseDbSystemName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1619,18 +1608,7 @@ func flattenOracleDatabaseDbSystemPropertiesTimeZoneId(v interface{}, d *schema.
 }
 
 func flattenOracleDatabaseDbSystemTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseDbSystemEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -872,18 +872,7 @@ func flattenOracleDatabaseExadbVmClusterGcpOracleZone(v interface{}, d *schema.R
 }
 
 func flattenOracleDatabaseExadbVmClusterLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseExadbVmClusterName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1159,18 +1148,7 @@ func flattenOracleDatabaseExadbVmClusterPropertiesVmFileSystemStorageSizeInGbsPe
 }
 
 func flattenOracleDatabaseExadbVmClusterTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseExadbVmClusterEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -653,18 +653,7 @@ func flattenOracleDatabaseExascaleDbStorageVaultGcpOracleZone(v interface{}, d *
 }
 
 func flattenOracleDatabaseExascaleDbStorageVaultLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseExascaleDbStorageVaultName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -833,18 +822,7 @@ func flattenOracleDatabaseExascaleDbStorageVaultPropertiesVmClusterIds(v interfa
 }
 
 func flattenOracleDatabaseExascaleDbStorageVaultTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseExascaleDbStorageVaultEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -522,18 +522,7 @@ func flattenOracleDatabaseOdbNetworkEntitlementId(v interface{}, d *schema.Resou
 }
 
 func flattenOracleDatabaseOdbNetworkLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseOdbNetworkName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -553,18 +542,7 @@ func flattenOracleDatabaseOdbNetworkState(v interface{}, d *schema.ResourceData,
 }
 
 func flattenOracleDatabaseOdbNetworkTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseOdbNetworkEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -537,18 +537,7 @@ func flattenOracleDatabaseOdbSubnetCreateTime(v interface{}, d *schema.ResourceD
 }
 
 func flattenOracleDatabaseOdbSubnetLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOracleDatabaseOdbSubnetName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -564,18 +553,7 @@ func flattenOracleDatabaseOdbSubnetState(v interface{}, d *schema.ResourceData,
 }
 
 func flattenOracleDatabaseOdbSubnetTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOracleDatabaseOdbSubnetEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2504,18 +2504,7 @@ func flattenOSConfigV2PolicyOrchestratorForOrganizationCreateTime(v interface{},
 }
 
 func flattenOSConfigV2PolicyOrchestratorForOrganizationLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenOSConfigV2PolicyOrchestratorForOrganizationName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -3816,18 +3805,7 @@ func flattenOSConfigV2PolicyOrchestratorForOrganizationOrchestratedResourceId(v
 }
 
 func flattenOSConfigV2PolicyOrchestratorForOrganizationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenOSConfigV2PolicyOrchestratorForOrganizationEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
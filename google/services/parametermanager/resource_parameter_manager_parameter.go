@@ -602,18 +602,7 @@ func flattenParameterManagerParameterPolicyMemberIamPolicyNamePrincipal(v interf
 }
 
 func flattenParameterManagerParameterLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenParameterManagerParameterFormat(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -625,18 +614,7 @@ func flattenParameterManagerParameterKmsKey(v interface{}, d *schema.ResourceDat
 }
 
 func flattenParameterManagerParameterTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenParameterManagerParameterEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
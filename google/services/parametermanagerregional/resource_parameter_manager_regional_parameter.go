@@ -627,18 +627,7 @@ func flattenParameterManagerRegionalRegionalParameterPolicyMemberIamPolicyNamePr
 }
 
 func flattenParameterManagerRegionalRegionalParameterLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenParameterManagerRegionalRegionalParameterFormat(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -650,18 +639,7 @@ func flattenParameterManagerRegionalRegionalParameterKmsKey(v interface{}, d *sc
 }
 
 func flattenParameterManagerRegionalRegionalParameterTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenParameterManagerRegionalRegionalParameterEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -27,7 +27,7 @@ import (
 func NewClient(c *transport_tpg.Config, userAgent string) *pubsub.Service {
 	pubsubClientBasePath := transport_tpg.RemoveBasePathVersion(transport_tpg.BaseUrl(Product, c))
 	log.Printf("[INFO] Instantiating Google Pubsub client for path %s", pubsubClientBasePath)
-	wrappedPubsubClient := transport_tpg.ClientWithAdditionalRetries(c.Client, transport_tpg.PubsubTopicProjectNotReady)
+	wrappedPubsubClient := transport_tpg.ClientWithAdditionalRetries(c, transport_tpg.PubsubTopicProjectNotReady)
 	clientPubsub, err := pubsub.NewService(c.Context, option.WithHTTPClient(wrappedPubsubClient))
 	if err != nil {
 		log.Printf("[WARN] Error creating client pubsub: %s", err)
@@ -17,7 +17,12 @@
 package redis
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
 func TestSecondaryIpDiffSuppress(t *testing.T) {
@@ -107,3 +112,27 @@ func TestUnitRedisInstance_redisVersionIsDecreasing(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitRedisInstance_flattenNormalizedLabels(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, ResourceRedisInstance().Schema, map[string]interface{}{
+		"labels":           map[string]interface{}{"Team": "Data Eng", "env": "prod"},
+		"terraform_labels": map[string]interface{}{"Team": "Data Eng", "env": "prod"},
+	})
+	config := &transport_tpg.Config{LabelNormalization: true}
+	read := map[string]interface{}{"team": "data_eng", "env": "dev"}
+
+	expected := map[string]interface{}{"Team": "Data Eng", "env": "dev"}
+	if got := flattenRedisInstanceLabels(read, d, config); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected labels %v, got %v", expected, got)
+	}
+	if got := flattenRedisInstanceTerraformLabels(read, d, config); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected terraform_labels %v, got %v", expected, got)
+	}
+
+	expected = map[string]interface{}{"Team": nil, "env": "dev"}
+	if got := flattenRedisInstanceLabels(read, d, &transport_tpg.Config{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected labels without label_normalization %v, got %v", expected, got)
+	}
+}
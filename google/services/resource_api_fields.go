@@ -0,0 +1,3136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/resource_api_fields.go.tmpl
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+// Package services holds the resources of each product in a package per
+// product. Importing it makes the mapping of API fields to Terraform
+// attributes of all resources available through registry.ResourceFields.
+package services
+
+import (
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+func init() {
+	for resource, fields := range resourceApiFields {
+		registry.RegisterResourceFields(resource, fields)
+	}
+}
+
+// resourceApiFields are the API fields of each resource, from its
+// `_meta.yaml` file, whose Terraform attribute isn't their snake case form.
+var resourceApiFields = map[string][]registry.FieldMetadata{
+	"google_access_context_manager_access_level": {
+		{ApiField: "basic.conditions.devicePolicy.requireScreenlock", Field: "basic.conditions.device_policy.require_screen_lock"},
+	},
+	"google_access_context_manager_access_level_condition": {
+		{ApiField: "basic.conditions.devicePolicy.allowedDeviceManagementLevels", Field: "device_policy.allowed_device_management_levels"},
+		{ApiField: "basic.conditions.devicePolicy.allowedEncryptionStatuses", Field: "device_policy.allowed_encryption_statuses"},
+		{ApiField: "basic.conditions.devicePolicy.osConstraints.minimumVersion", Field: "device_policy.os_constraints.minimum_version"},
+		{ApiField: "basic.conditions.devicePolicy.osConstraints.osType", Field: "device_policy.os_constraints.os_type"},
+		{ApiField: "basic.conditions.devicePolicy.requireAdminApproval", Field: "device_policy.require_admin_approval"},
+		{ApiField: "basic.conditions.devicePolicy.requireCorpOwned", Field: "device_policy.require_corp_owned"},
+		{ApiField: "basic.conditions.devicePolicy.requireScreenlock", Field: "device_policy.require_screen_lock"},
+		{ApiField: "basic.conditions.ipSubnetworks", Field: "ip_subnetworks"},
+		{ApiField: "basic.conditions.members", Field: "members"},
+		{ApiField: "basic.conditions.negate", Field: "negate"},
+		{ApiField: "basic.conditions.regions", Field: "regions"},
+		{ApiField: "basic.conditions.requiredAccessLevels", Field: "required_access_levels"},
+		{ApiField: "basic.conditions.vpcNetworkSources.vpcSubnetwork.network", Field: "vpc_network_sources.vpc_subnetwork.network"},
+		{ApiField: "basic.conditions.vpcNetworkSources.vpcSubnetwork.vpcIpSubnetworks", Field: "vpc_network_sources.vpc_subnetwork.vpc_ip_subnetworks"},
+	},
+	"google_access_context_manager_access_levels": {
+		{ApiField: "accessLevels.basic.conditions.devicePolicy.requireScreenlock", Field: "access_levels.basic.conditions.device_policy.require_screen_lock"},
+	},
+	"google_access_context_manager_egress_policy": {
+		{ApiField: "status.resources.resource", Field: "resource"},
+	},
+	"google_access_context_manager_ingress_policy": {
+		{ApiField: "status.resources.resource", Field: "resource"},
+	},
+	"google_access_context_manager_service_perimeter_dry_run_egress_policy": {
+		{ApiField: "spec.egressPolicies.egressFrom.identities", Field: "egress_from.identities"},
+		{ApiField: "spec.egressPolicies.egressFrom.identityType", Field: "egress_from.identity_type"},
+		{ApiField: "spec.egressPolicies.egressFrom.sourceRestriction", Field: "egress_from.source_restriction"},
+		{ApiField: "spec.egressPolicies.egressFrom.sources.accessLevel", Field: "egress_from.sources.access_level"},
+		{ApiField: "spec.egressPolicies.egressFrom.sources.resource", Field: "egress_from.sources.resource"},
+		{ApiField: "spec.egressPolicies.egressTo.externalResources", Field: "egress_to.external_resources"},
+		{ApiField: "spec.egressPolicies.egressTo.operations.methodSelectors.method", Field: "egress_to.operations.method_selectors.method"},
+		{ApiField: "spec.egressPolicies.egressTo.operations.methodSelectors.permission", Field: "egress_to.operations.method_selectors.permission"},
+		{ApiField: "spec.egressPolicies.egressTo.operations.serviceName", Field: "egress_to.operations.service_name"},
+		{ApiField: "spec.egressPolicies.egressTo.resources", Field: "egress_to.resources"},
+		{ApiField: "spec.egressPolicies.egressTo.roles", Field: "egress_to.roles"},
+		{ApiField: "spec.egressPolicies.etag", Field: "etag"},
+		{ApiField: "spec.egressPolicies.title", Field: "title"},
+	},
+	"google_access_context_manager_service_perimeter_dry_run_ingress_policy": {
+		{ApiField: "spec.ingressPolicies.etag", Field: "etag"},
+		{ApiField: "spec.ingressPolicies.ingressFrom.identities", Field: "ingress_from.identities"},
+		{ApiField: "spec.ingressPolicies.ingressFrom.identityType", Field: "ingress_from.identity_type"},
+		{ApiField: "spec.ingressPolicies.ingressFrom.sources.accessLevel", Field: "ingress_from.sources.access_level"},
+		{ApiField: "spec.ingressPolicies.ingressFrom.sources.resource", Field: "ingress_from.sources.resource"},
+		{ApiField: "spec.ingressPolicies.ingressTo.operations.methodSelectors.method", Field: "ingress_to.operations.method_selectors.method"},
+		{ApiField: "spec.ingressPolicies.ingressTo.operations.methodSelectors.permission", Field: "ingress_to.operations.method_selectors.permission"},
+		{ApiField: "spec.ingressPolicies.ingressTo.operations.serviceName", Field: "ingress_to.operations.service_name"},
+		{ApiField: "spec.ingressPolicies.ingressTo.resources", Field: "ingress_to.resources"},
+		{ApiField: "spec.ingressPolicies.ingressTo.roles", Field: "ingress_to.roles"},
+		{ApiField: "spec.ingressPolicies.title", Field: "title"},
+	},
+	"google_access_context_manager_service_perimeter_dry_run_resource": {
+		{ApiField: "spec.resources.etag", Field: "etag"},
+		{ApiField: "spec.resources.resource", Field: "resource"},
+	},
+	"google_access_context_manager_service_perimeter_egress_policy": {
+		{ApiField: "status.egressPolicies.egressFrom.identities", Field: "egress_from.identities"},
+		{ApiField: "status.egressPolicies.egressFrom.identityType", Field: "egress_from.identity_type"},
+		{ApiField: "status.egressPolicies.egressFrom.sourceRestriction", Field: "egress_from.source_restriction"},
+		{ApiField: "status.egressPolicies.egressFrom.sources.accessLevel", Field: "egress_from.sources.access_level"},
+		{ApiField: "status.egressPolicies.egressFrom.sources.resource", Field: "egress_from.sources.resource"},
+		{ApiField: "status.egressPolicies.egressTo.externalResources", Field: "egress_to.external_resources"},
+		{ApiField: "status.egressPolicies.egressTo.operations.methodSelectors.method", Field: "egress_to.operations.method_selectors.method"},
+		{ApiField: "status.egressPolicies.egressTo.operations.methodSelectors.permission", Field: "egress_to.operations.method_selectors.permission"},
+		{ApiField: "status.egressPolicies.egressTo.operations.serviceName", Field: "egress_to.operations.service_name"},
+		{ApiField: "status.egressPolicies.egressTo.resources", Field: "egress_to.resources"},
+		{ApiField: "status.egressPolicies.egressTo.roles", Field: "egress_to.roles"},
+		{ApiField: "status.egressPolicies.etag", Field: "etag"},
+		{ApiField: "status.egressPolicies.title", Field: "title"},
+	},
+	"google_access_context_manager_service_perimeter_ingress_policy": {
+		{ApiField: "status.ingressPolicies.etag", Field: "etag"},
+		{ApiField: "status.ingressPolicies.ingressFrom.identities", Field: "ingress_from.identities"},
+		{ApiField: "status.ingressPolicies.ingressFrom.identityType", Field: "ingress_from.identity_type"},
+		{ApiField: "status.ingressPolicies.ingressFrom.sources.accessLevel", Field: "ingress_from.sources.access_level"},
+		{ApiField: "status.ingressPolicies.ingressFrom.sources.resource", Field: "ingress_from.sources.resource"},
+		{ApiField: "status.ingressPolicies.ingressTo.operations.methodSelectors.method", Field: "ingress_to.operations.method_selectors.method"},
+		{ApiField: "status.ingressPolicies.ingressTo.operations.methodSelectors.permission", Field: "ingress_to.operations.method_selectors.permission"},
+		{ApiField: "status.ingressPolicies.ingressTo.operations.serviceName", Field: "ingress_to.operations.service_name"},
+		{ApiField: "status.ingressPolicies.ingressTo.resources", Field: "ingress_to.resources"},
+		{ApiField: "status.ingressPolicies.ingressTo.roles", Field: "ingress_to.roles"},
+		{ApiField: "status.ingressPolicies.title", Field: "title"},
+	},
+	"google_access_context_manager_service_perimeter_resource": {
+		{ApiField: "status.resources.etag", Field: "etag"},
+		{ApiField: "status.resources.resource", Field: "resource"},
+	},
+	"google_active_directory_domain_trust": {
+		{ApiField: "trusts.selectiveAuthentication", Field: "selective_authentication"},
+		{ApiField: "trusts.targetDnsIpAddresses", Field: "target_dns_ip_addresses"},
+		{ApiField: "trusts.targetDomainName", Field: "target_domain_name"},
+		{ApiField: "trusts.trustDirection", Field: "trust_direction"},
+		{ApiField: "trusts.trustHandshakeSecret", Field: "trust_handshake_secret"},
+		{ApiField: "trusts.trustType", Field: "trust_type"},
+	},
+	"google_alloydb_cluster": {
+		{ApiField: "initialUser.password", Field: "initial_user.password_wo"},
+	},
+	"google_alloydb_user": {
+		{ApiField: "password", Field: "password_wo"},
+	},
+	"google_apigee_environment_api_revision_deployment": {
+		{ApiField: "basePath", Field: "basepaths"},
+	},
+	"google_apigee_keystores_aliases_key_cert_file": {
+		{ApiField: "certsInfo.certInfo.basicConstraints", Field: "certs_info.basic_constraints"},
+		{ApiField: "certsInfo.certInfo.expiryDate", Field: "certs_info.expiry_date"},
+		{ApiField: "certsInfo.certInfo.isValid", Field: "certs_info.is_valid"},
+		{ApiField: "certsInfo.certInfo.issuer", Field: "certs_info.issuer"},
+		{ApiField: "certsInfo.certInfo.publicKey", Field: "certs_info.public_key"},
+		{ApiField: "certsInfo.certInfo.serialNumber", Field: "certs_info.serial_number"},
+		{ApiField: "certsInfo.certInfo.sigAlgName", Field: "certs_info.sig_alg_name"},
+		{ApiField: "certsInfo.certInfo.subject", Field: "certs_info.subject"},
+		{ApiField: "certsInfo.certInfo.subjectAlternativeNames", Field: "certs_info.subject_alternative_names"},
+		{ApiField: "certsInfo.certInfo.validFrom", Field: "certs_info.valid_from"},
+		{ApiField: "certsInfo.certInfo.version", Field: "certs_info.version"},
+	},
+	"google_apigee_security_profile_v2": {
+		{ApiField: "profileAssessmentConfigs.key", Field: "profile_assessment_configs.assessment"},
+		{ApiField: "profileAssessmentConfigs.value.weight", Field: "profile_assessment_configs.weight"},
+	},
+	"google_app_engine_domain_mapping": {
+		{ApiField: "id", Field: "domain_name"},
+	},
+	"google_app_engine_flexible_app_version": {
+		{ApiField: "deployment.files.key", Field: "deployment.files.name"},
+		{ApiField: "deployment.files.value.sha1Sum", Field: "deployment.files.sha1_sum"},
+		{ApiField: "deployment.files.value.sourceUrl", Field: "deployment.files.source_url"},
+		{ApiField: "network.subnetworkName", Field: "network.subnetwork"},
+		{ApiField: "id", Field: "version_id"},
+	},
+	"google_app_engine_service_network_settings": {
+		{ApiField: "id", Field: "service"},
+	},
+	"google_app_engine_service_split_traffic": {
+		{ApiField: "id", Field: "service"},
+	},
+	"google_app_engine_standard_app_version": {
+		{ApiField: "deployment.files.key", Field: "deployment.files.name"},
+		{ApiField: "deployment.files.value.sha1Sum", Field: "deployment.files.sha1_sum"},
+		{ApiField: "deployment.files.value.sourceUrl", Field: "deployment.files.source_url"},
+		{ApiField: "id", Field: "version_id"},
+	},
+	"google_artifact_registry_repository": {
+		{ApiField: "cleanupPolicies.key", Field: "cleanup_policies.id"},
+		{ApiField: "cleanupPolicies.value.action", Field: "cleanup_policies.action"},
+		{ApiField: "cleanupPolicies.value.condition.newerThan", Field: "cleanup_policies.condition.newer_than"},
+		{ApiField: "cleanupPolicies.value.condition.olderThan", Field: "cleanup_policies.condition.older_than"},
+		{ApiField: "cleanupPolicies.value.condition.packageNamePrefixes", Field: "cleanup_policies.condition.package_name_prefixes"},
+		{ApiField: "cleanupPolicies.value.condition.tagPrefixes", Field: "cleanup_policies.condition.tag_prefixes"},
+		{ApiField: "cleanupPolicies.value.condition.tagState", Field: "cleanup_policies.condition.tag_state"},
+		{ApiField: "cleanupPolicies.value.condition.versionNamePrefixes", Field: "cleanup_policies.condition.version_name_prefixes"},
+		{ApiField: "cleanupPolicies.value.mostRecentVersions.keepCount", Field: "cleanup_policies.most_recent_versions.keep_count"},
+		{ApiField: "cleanupPolicies.value.mostRecentVersions.packageNamePrefixes", Field: "cleanup_policies.most_recent_versions.package_name_prefixes"},
+	},
+	"google_backup_dr_restore_workload": {
+		{ApiField: "computeInstanceRestoreProperties.labels.value.value", Field: "compute_instance_restore_properties.labels.value"},
+		{ApiField: "computeInstanceRestoreProperties.params.resourceManagerTags.value.value", Field: "compute_instance_restore_properties.params.resource_manager_tags.value"},
+		{ApiField: "diskRestoreProperties.labels.value.value", Field: "disk_restore_properties.labels.value"},
+		{ApiField: "diskRestoreProperties.resourceManagerTags.value.value", Field: "disk_restore_properties.resource_manager_tags.value"},
+	},
+	"google_beyondcorp_security_gateway": {
+		{ApiField: "hubs.key", Field: "hubs.region"},
+		{ApiField: "hubs.value.internetGateway.assignedIps", Field: "hubs.internet_gateway.assigned_ips"},
+	},
+	"google_biglake_iceberg_namespace": {
+		{ApiField: "namespace", Field: "namespace_id"},
+	},
+	"google_bigquery_analytics_hub_listing_subscription": {
+		{ApiField: "linkedDatasetMap.key", Field: "linked_dataset_map.resource_name"},
+		{ApiField: "linkedDatasetMap.value.linkedDataset", Field: "linked_dataset_map.linked_dataset"},
+		{ApiField: "linkedDatasetMap.value.listing", Field: "linked_dataset_map.listing"},
+	},
+	"google_bigquery_dataset": {
+		{ApiField: "datasetReference.datasetId", Field: "dataset_id"},
+	},
+	"google_bigquery_dataset_access": {
+		{ApiField: "access.condition.description", Field: "condition.description"},
+		{ApiField: "access.condition.expression", Field: "condition.expression"},
+		{ApiField: "access.condition.location", Field: "condition.location"},
+		{ApiField: "access.condition.title", Field: "condition.title"},
+		{ApiField: "access.dataset.dataset.datasetId", Field: "dataset.dataset.dataset_id"},
+		{ApiField: "access.dataset.dataset.projectId", Field: "dataset.dataset.project_id"},
+		{ApiField: "access.dataset.targetTypes", Field: "dataset.target_types"},
+		{ApiField: "access.datasetId", Field: "dataset_id"},
+		{ApiField: "access.domain", Field: "domain"},
+		{ApiField: "access.groupByEmail", Field: "group_by_email"},
+		{ApiField: "access.iamMember", Field: "iam_member"},
+		{ApiField: "access.role", Field: "role"},
+		{ApiField: "access.routine.datasetId", Field: "routine.dataset_id"},
+		{ApiField: "access.routine.projectId", Field: "routine.project_id"},
+		{ApiField: "access.routine.routineId", Field: "routine.routine_id"},
+		{ApiField: "access.specialGroup", Field: "special_group"},
+		{ApiField: "access.userByEmail", Field: "user_by_email"},
+		{ApiField: "access.view.datasetId", Field: "view.dataset_id"},
+		{ApiField: "access.view.projectId", Field: "view.project_id"},
+		{ApiField: "access.view.tableId", Field: "view.table_id"},
+	},
+	"google_bigquery_job": {
+		{ApiField: "configuration.copy.createDisposition", Field: "copy.create_disposition"},
+		{ApiField: "configuration.copy.destinationEncryptionConfiguration.kmsKeyName", Field: "copy.destination_encryption_configuration.kms_key_name"},
+		{ApiField: "configuration.copy.destinationEncryptionConfiguration.kmsKeyVersion", Field: "copy.destination_encryption_configuration.kms_key_version"},
+		{ApiField: "configuration.copy.destinationTable.datasetId", Field: "copy.destination_table.dataset_id"},
+		{ApiField: "configuration.copy.destinationTable.projectId", Field: "copy.destination_table.project_id"},
+		{ApiField: "configuration.copy.destinationTable.tableId", Field: "copy.destination_table.table_id"},
+		{ApiField: "configuration.copy.sourceTables.datasetId", Field: "copy.source_tables.dataset_id"},
+		{ApiField: "configuration.copy.sourceTables.projectId", Field: "copy.source_tables.project_id"},
+		{ApiField: "configuration.copy.sourceTables.tableId", Field: "copy.source_tables.table_id"},
+		{ApiField: "configuration.copy.writeDisposition", Field: "copy.write_disposition"},
+		{ApiField: "configuration.extract.compression", Field: "extract.compression"},
+		{ApiField: "configuration.extract.destinationFormat", Field: "extract.destination_format"},
+		{ApiField: "configuration.extract.destinationUris", Field: "extract.destination_uris"},
+		{ApiField: "configuration.extract.fieldDelimiter", Field: "extract.field_delimiter"},
+		{ApiField: "configuration.extract.printHeader", Field: "extract.print_header"},
+		{ApiField: "configuration.extract.sourceModel.datasetId", Field: "extract.source_model.dataset_id"},
+		{ApiField: "configuration.extract.sourceModel.modelId", Field: "extract.source_model.model_id"},
+		{ApiField: "configuration.extract.sourceModel.projectId", Field: "extract.source_model.project_id"},
+		{ApiField: "configuration.extract.sourceTable.datasetId", Field: "extract.source_table.dataset_id"},
+		{ApiField: "configuration.extract.sourceTable.projectId", Field: "extract.source_table.project_id"},
+		{ApiField: "configuration.extract.sourceTable.tableId", Field: "extract.source_table.table_id"},
+		{ApiField: "configuration.extract.useAvroLogicalTypes", Field: "extract.use_avro_logical_types"},
+		{ApiField: "jobReference.jobId", Field: "job_id"},
+		{ApiField: "configuration.jobTimeoutMs", Field: "job_timeout_ms"},
+		{ApiField: "configuration.jobType", Field: "job_type"},
+		{ApiField: "configuration.labels", Field: "labels"},
+		{ApiField: "configuration.load.allowJaggedRows", Field: "load.allow_jagged_rows"},
+		{ApiField: "configuration.load.allowQuotedNewlines", Field: "load.allow_quoted_newlines"},
+		{ApiField: "configuration.load.autodetect", Field: "load.autodetect"},
+		{ApiField: "configuration.load.createDisposition", Field: "load.create_disposition"},
+		{ApiField: "configuration.load.destinationEncryptionConfiguration.kmsKeyName", Field: "load.destination_encryption_configuration.kms_key_name"},
+		{ApiField: "configuration.load.destinationEncryptionConfiguration.kmsKeyVersion", Field: "load.destination_encryption_configuration.kms_key_version"},
+		{ApiField: "configuration.load.destinationTable.datasetId", Field: "load.destination_table.dataset_id"},
+		{ApiField: "configuration.load.destinationTable.projectId", Field: "load.destination_table.project_id"},
+		{ApiField: "configuration.load.destinationTable.tableId", Field: "load.destination_table.table_id"},
+		{ApiField: "configuration.load.encoding", Field: "load.encoding"},
+		{ApiField: "configuration.load.fieldDelimiter", Field: "load.field_delimiter"},
+		{ApiField: "configuration.load.ignoreUnknownValues", Field: "load.ignore_unknown_values"},
+		{ApiField: "configuration.load.jsonExtension", Field: "load.json_extension"},
+		{ApiField: "configuration.load.maxBadRecords", Field: "load.max_bad_records"},
+		{ApiField: "configuration.load.nullMarker", Field: "load.null_marker"},
+		{ApiField: "configuration.load.parquetOptions.enableListInference", Field: "load.parquet_options.enable_list_inference"},
+		{ApiField: "configuration.load.parquetOptions.enumAsString", Field: "load.parquet_options.enum_as_string"},
+		{ApiField: "configuration.load.projectionFields", Field: "load.projection_fields"},
+		{ApiField: "configuration.load.quote", Field: "load.quote"},
+		{ApiField: "configuration.load.schemaUpdateOptions", Field: "load.schema_update_options"},
+		{ApiField: "configuration.load.skipLeadingRows", Field: "load.skip_leading_rows"},
+		{ApiField: "configuration.load.sourceFormat", Field: "load.source_format"},
+		{ApiField: "configuration.load.sourceUris", Field: "load.source_uris"},
+		{ApiField: "configuration.load.timePartitioning.expirationMs", Field: "load.time_partitioning.expiration_ms"},
+		{ApiField: "configuration.load.timePartitioning.field", Field: "load.time_partitioning.field"},
+		{ApiField: "configuration.load.timePartitioning.type", Field: "load.time_partitioning.type"},
+		{ApiField: "configuration.load.writeDisposition", Field: "load.write_disposition"},
+		{ApiField: "jobReference.location", Field: "location"},
+		{ApiField: "configuration.query.allowLargeResults", Field: "query.allow_large_results"},
+		{ApiField: "configuration.query.connectionProperties.key", Field: "query.connection_properties.key"},
+		{ApiField: "configuration.query.connectionProperties.value", Field: "query.connection_properties.value"},
+		{ApiField: "configuration.query.createDisposition", Field: "query.create_disposition"},
+		{ApiField: "configuration.query.defaultDataset.datasetId", Field: "query.default_dataset.dataset_id"},
+		{ApiField: "configuration.query.defaultDataset.projectId", Field: "query.default_dataset.project_id"},
+		{ApiField: "configuration.query.destinationEncryptionConfiguration.kmsKeyName", Field: "query.destination_encryption_configuration.kms_key_name"},
+		{ApiField: "configuration.query.destinationEncryptionConfiguration.kmsKeyVersion", Field: "query.destination_encryption_configuration.kms_key_version"},
+		{ApiField: "configuration.query.destinationTable.datasetId", Field: "query.destination_table.dataset_id"},
+		{ApiField: "configuration.query.destinationTable.projectId", Field: "query.destination_table.project_id"},
+		{ApiField: "configuration.query.destinationTable.tableId", Field: "query.destination_table.table_id"},
+		{ApiField: "configuration.query.flattenResults", Field: "query.flatten_results"},
+		{ApiField: "configuration.query.maximumBillingTier", Field: "query.maximum_billing_tier"},
+		{ApiField: "configuration.query.maximumBytesBilled", Field: "query.maximum_bytes_billed"},
+		{ApiField: "configuration.query.parameterMode", Field: "query.parameter_mode"},
+		{ApiField: "configuration.query.priority", Field: "query.priority"},
+		{ApiField: "configuration.query.query", Field: "query.query"},
+		{ApiField: "configuration.query.schemaUpdateOptions", Field: "query.schema_update_options"},
+		{ApiField: "configuration.query.scriptOptions.keyResultStatement", Field: "query.script_options.key_result_statement"},
+		{ApiField: "configuration.query.scriptOptions.statementByteBudget", Field: "query.script_options.statement_byte_budget"},
+		{ApiField: "configuration.query.scriptOptions.statementTimeoutMs", Field: "query.script_options.statement_timeout_ms"},
+		{ApiField: "configuration.query.useLegacySql", Field: "query.use_legacy_sql"},
+		{ApiField: "configuration.query.useQueryCache", Field: "query.use_query_cache"},
+		{ApiField: "configuration.query.userDefinedFunctionResources.inlineCode", Field: "query.user_defined_function_resources.inline_code"},
+		{ApiField: "configuration.query.userDefinedFunctionResources.resourceUri", Field: "query.user_defined_function_resources.resource_uri"},
+		{ApiField: "configuration.query.writeDisposition", Field: "query.write_disposition"},
+	},
+	"google_bigquery_routine": {
+		{ApiField: "routineReference.datasetId", Field: "dataset_id"},
+		{ApiField: "routineReference.routineId", Field: "routine_id"},
+	},
+	"google_bigquery_row_access_policy": {
+		{ApiField: "rowAccessPolicyReference.datasetId", Field: "dataset_id"},
+		{ApiField: "rowAccessPolicyReference.policyId", Field: "policy_id"},
+		{ApiField: "rowAccessPolicyReference.tableId", Field: "table_id"},
+	},
+	"google_bigquery_table": {
+		{ApiField: "clustering.fields", Field: "clustering"},
+		{ApiField: "encryptionConfiguration.kmsKeyName", Field: "encryption_configuration.kms_key_version"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.encoding", Field: "external_data_configuration.bigtable_options.column_family.column.encoding"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.fieldName", Field: "external_data_configuration.bigtable_options.column_family.column.field_name"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.onlyReadLatest", Field: "external_data_configuration.bigtable_options.column_family.column.only_read_latest"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.qualifierEncoded", Field: "external_data_configuration.bigtable_options.column_family.column.qualifier_encoded"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.qualifierString", Field: "external_data_configuration.bigtable_options.column_family.column.qualifier_string"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.columns.type", Field: "external_data_configuration.bigtable_options.column_family.column.type"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.encoding", Field: "external_data_configuration.bigtable_options.column_family.encoding"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.familyId", Field: "external_data_configuration.bigtable_options.column_family.family_id"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.onlyReadLatest", Field: "external_data_configuration.bigtable_options.column_family.only_read_latest"},
+		{ApiField: "externalDataConfiguration.bigtableOptions.columnFamilies.type", Field: "external_data_configuration.bigtable_options.column_family.type"},
+		{ApiField: "schema", Field: "generated_schema_columns"},
+	},
+	"google_billing_budget": {
+		{ApiField: "notificationsRule.disableDefaultIamRecipients", Field: "all_updates_rule.disable_default_iam_recipients"},
+		{ApiField: "notificationsRule.enableProjectLevelRecipients", Field: "all_updates_rule.enable_project_level_recipients"},
+		{ApiField: "notificationsRule.monitoringNotificationChannels", Field: "all_updates_rule.monitoring_notification_channels"},
+		{ApiField: "notificationsRule.pubsubTopic", Field: "all_updates_rule.pubsub_topic"},
+		{ApiField: "notificationsRule.schemaVersion", Field: "all_updates_rule.schema_version"},
+	},
+	"google_binary_authorization_attestor": {
+		{ApiField: "userOwnedGrafeasNote.delegationServiceAccountEmail", Field: "attestation_authority_note.delegation_service_account_email"},
+		{ApiField: "userOwnedGrafeasNote.noteReference", Field: "attestation_authority_note.note_reference"},
+		{ApiField: "userOwnedGrafeasNote.publicKeys.asciiArmoredPgpPublicKey", Field: "attestation_authority_note.public_keys.ascii_armored_pgp_public_key"},
+		{ApiField: "userOwnedGrafeasNote.publicKeys.comment", Field: "attestation_authority_note.public_keys.comment"},
+		{ApiField: "userOwnedGrafeasNote.publicKeys.id", Field: "attestation_authority_note.public_keys.id"},
+		{ApiField: "userOwnedGrafeasNote.publicKeys.pkixPublicKey.publicKeyPem", Field: "attestation_authority_note.public_keys.pkix_public_key.public_key_pem"},
+		{ApiField: "userOwnedGrafeasNote.publicKeys.pkixPublicKey.signatureAlgorithm", Field: "attestation_authority_note.public_keys.pkix_public_key.signature_algorithm"},
+	},
+	"google_binary_authorization_policy": {
+		{ApiField: "clusterAdmissionRules.key", Field: "cluster_admission_rules.cluster"},
+		{ApiField: "clusterAdmissionRules.value.enforcementMode", Field: "cluster_admission_rules.enforcement_mode"},
+		{ApiField: "clusterAdmissionRules.value.evaluationMode", Field: "cluster_admission_rules.evaluation_mode"},
+		{ApiField: "clusterAdmissionRules.value.requireAttestationsBy", Field: "cluster_admission_rules.require_attestations_by"},
+	},
+	"google_ces_app": {
+		{ApiField: "audioProcessingConfig.synthesizeSpeechConfigs.key", Field: "audio_processing_config.synthesize_speech_configs.language_code"},
+		{ApiField: "audioProcessingConfig.synthesizeSpeechConfigs.value.speakingRate", Field: "audio_processing_config.synthesize_speech_configs.speaking_rate"},
+		{ApiField: "audioProcessingConfig.synthesizeSpeechConfigs.value.voice", Field: "audio_processing_config.synthesize_speech_configs.voice"},
+	},
+	"google_ces_app_version": {
+		{ApiField: "snapshot.app.audioProcessingConfig.synthesizeSpeechConfigs.key", Field: "snapshot.app.audio_processing_config.synthesize_speech_configs.language_code"},
+		{ApiField: "snapshot.app.audioProcessingConfig.synthesizeSpeechConfigs.value.speakingRate", Field: "snapshot.app.audio_processing_config.synthesize_speech_configs.speaking_rate"},
+		{ApiField: "snapshot.app.audioProcessingConfig.synthesizeSpeechConfigs.value.voice", Field: "snapshot.app.audio_processing_config.synthesize_speech_configs.voice"},
+	},
+	"google_cloud_run_service": {
+		{ApiField: "spec.template.metadata.annotations", Field: "template.metadata.annotations"},
+		{ApiField: "spec.template.metadata.generation", Field: "template.metadata.generation"},
+		{ApiField: "spec.template.metadata.labels", Field: "template.metadata.labels"},
+		{ApiField: "spec.template.metadata.name", Field: "template.metadata.name"},
+		{ApiField: "spec.template.metadata.namespace", Field: "template.metadata.namespace"},
+		{ApiField: "spec.template.metadata.resourceVersion", Field: "template.metadata.resource_version"},
+		{ApiField: "spec.template.metadata.selfLink", Field: "template.metadata.self_link"},
+		{ApiField: "spec.template.metadata.uid", Field: "template.metadata.uid"},
+		{ApiField: "spec.template.spec.containerConcurrency", Field: "template.spec.container_concurrency"},
+		{ApiField: "spec.template.spec.containers.args", Field: "template.spec.containers.args"},
+		{ApiField: "spec.template.spec.containers.command", Field: "template.spec.containers.command"},
+		{ApiField: "spec.template.spec.containers.env.name", Field: "template.spec.containers.env.name"},
+		{ApiField: "spec.template.spec.containers.env.value", Field: "template.spec.containers.env.value"},
+		{ApiField: "spec.template.spec.containers.env.valueFrom.secretKeyRef.key", Field: "template.spec.containers.env.value_from.secret_key_ref.key"},
+		{ApiField: "spec.template.spec.containers.env.valueFrom.secretKeyRef.name", Field: "template.spec.containers.env.value_from.secret_key_ref.name"},
+		{ApiField: "spec.template.spec.containers.envFrom.configMapRef.localObjectReference.name", Field: "template.spec.containers.env_from.config_map_ref.local_object_reference.name"},
+		{ApiField: "spec.template.spec.containers.envFrom.configMapRef.optional", Field: "template.spec.containers.env_from.config_map_ref.optional"},
+		{ApiField: "spec.template.spec.containers.envFrom.prefix", Field: "template.spec.containers.env_from.prefix"},
+		{ApiField: "spec.template.spec.containers.envFrom.secretRef.localObjectReference.name", Field: "template.spec.containers.env_from.secret_ref.local_object_reference.name"},
+		{ApiField: "spec.template.spec.containers.envFrom.secretRef.optional", Field: "template.spec.containers.env_from.secret_ref.optional"},
+		{ApiField: "spec.template.spec.containers.image", Field: "template.spec.containers.image"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.failureThreshold", Field: "template.spec.containers.liveness_probe.failure_threshold"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.grpc.port", Field: "template.spec.containers.liveness_probe.grpc.port"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.grpc.service", Field: "template.spec.containers.liveness_probe.grpc.service"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.httpGet.httpHeaders.name", Field: "template.spec.containers.liveness_probe.http_get.http_headers.name"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.httpGet.httpHeaders.value", Field: "template.spec.containers.liveness_probe.http_get.http_headers.value"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.httpGet.path", Field: "template.spec.containers.liveness_probe.http_get.path"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.httpGet.port", Field: "template.spec.containers.liveness_probe.http_get.port"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.initialDelaySeconds", Field: "template.spec.containers.liveness_probe.initial_delay_seconds"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.periodSeconds", Field: "template.spec.containers.liveness_probe.period_seconds"},
+		{ApiField: "spec.template.spec.containers.livenessProbe.timeoutSeconds", Field: "template.spec.containers.liveness_probe.timeout_seconds"},
+		{ApiField: "spec.template.spec.containers.name", Field: "template.spec.containers.name"},
+		{ApiField: "spec.template.spec.containers.ports.containerPort", Field: "template.spec.containers.ports.container_port"},
+		{ApiField: "spec.template.spec.containers.ports.name", Field: "template.spec.containers.ports.name"},
+		{ApiField: "spec.template.spec.containers.ports.protocol", Field: "template.spec.containers.ports.protocol"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.failureThreshold", Field: "template.spec.containers.readiness_probe.failure_threshold"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.grpc.port", Field: "template.spec.containers.readiness_probe.grpc.port"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.grpc.service", Field: "template.spec.containers.readiness_probe.grpc.service"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.httpGet.path", Field: "template.spec.containers.readiness_probe.http_get.path"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.httpGet.port", Field: "template.spec.containers.readiness_probe.http_get.port"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.periodSeconds", Field: "template.spec.containers.readiness_probe.period_seconds"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.successThreshold", Field: "template.spec.containers.readiness_probe.success_threshold"},
+		{ApiField: "spec.template.spec.containers.readinessProbe.timeoutSeconds", Field: "template.spec.containers.readiness_probe.timeout_seconds"},
+		{ApiField: "spec.template.spec.containers.resources.limits", Field: "template.spec.containers.resources.limits"},
+		{ApiField: "spec.template.spec.containers.resources.requests", Field: "template.spec.containers.resources.requests"},
+		{ApiField: "spec.template.spec.containers.startupProbe.failureThreshold", Field: "template.spec.containers.startup_probe.failure_threshold"},
+		{ApiField: "spec.template.spec.containers.startupProbe.grpc.port", Field: "template.spec.containers.startup_probe.grpc.port"},
+		{ApiField: "spec.template.spec.containers.startupProbe.grpc.service", Field: "template.spec.containers.startup_probe.grpc.service"},
+		{ApiField: "spec.template.spec.containers.startupProbe.httpGet.httpHeaders.name", Field: "template.spec.containers.startup_probe.http_get.http_headers.name"},
+		{ApiField: "spec.template.spec.containers.startupProbe.httpGet.httpHeaders.value", Field: "template.spec.containers.startup_probe.http_get.http_headers.value"},
+		{ApiField: "spec.template.spec.containers.startupProbe.httpGet.path", Field: "template.spec.containers.startup_probe.http_get.path"},
+		{ApiField: "spec.template.spec.containers.startupProbe.httpGet.port", Field: "template.spec.containers.startup_probe.http_get.port"},
+		{ApiField: "spec.template.spec.containers.startupProbe.initialDelaySeconds", Field: "template.spec.containers.startup_probe.initial_delay_seconds"},
+		{ApiField: "spec.template.spec.containers.startupProbe.periodSeconds", Field: "template.spec.containers.startup_probe.period_seconds"},
+		{ApiField: "spec.template.spec.containers.startupProbe.tcpSocket.port", Field: "template.spec.containers.startup_probe.tcp_socket.port"},
+		{ApiField: "spec.template.spec.containers.startupProbe.timeoutSeconds", Field: "template.spec.containers.startup_probe.timeout_seconds"},
+		{ApiField: "spec.template.spec.containers.volumeMounts.mountPath", Field: "template.spec.containers.volume_mounts.mount_path"},
+		{ApiField: "spec.template.spec.containers.volumeMounts.name", Field: "template.spec.containers.volume_mounts.name"},
+		{ApiField: "spec.template.spec.containers.volumeMounts.subPath", Field: "template.spec.containers.volume_mounts.sub_path"},
+		{ApiField: "spec.template.spec.containers.workingDir", Field: "template.spec.containers.working_dir"},
+		{ApiField: "spec.template.spec.nodeSelector", Field: "template.spec.node_selector"},
+		{ApiField: "spec.template.spec.serviceAccountName", Field: "template.spec.service_account_name"},
+		{ApiField: "spec.template.spec.servingState", Field: "template.spec.serving_state"},
+		{ApiField: "spec.template.spec.timeoutSeconds", Field: "template.spec.timeout_seconds"},
+		{ApiField: "spec.template.spec.volumes.csi.driver", Field: "template.spec.volumes.csi.driver"},
+		{ApiField: "spec.template.spec.volumes.csi.readOnly", Field: "template.spec.volumes.csi.read_only"},
+		{ApiField: "spec.template.spec.volumes.csi.volumeAttributes", Field: "template.spec.volumes.csi.volume_attributes"},
+		{ApiField: "spec.template.spec.volumes.emptyDir.medium", Field: "template.spec.volumes.empty_dir.medium"},
+		{ApiField: "spec.template.spec.volumes.emptyDir.sizeLimit", Field: "template.spec.volumes.empty_dir.size_limit"},
+		{ApiField: "spec.template.spec.volumes.name", Field: "template.spec.volumes.name"},
+		{ApiField: "spec.template.spec.volumes.nfs.path", Field: "template.spec.volumes.nfs.path"},
+		{ApiField: "spec.template.spec.volumes.nfs.readOnly", Field: "template.spec.volumes.nfs.read_only"},
+		{ApiField: "spec.template.spec.volumes.nfs.server", Field: "template.spec.volumes.nfs.server"},
+		{ApiField: "spec.template.spec.volumes.secret.defaultMode", Field: "template.spec.volumes.secret.default_mode"},
+		{ApiField: "spec.template.spec.volumes.secret.items.key", Field: "template.spec.volumes.secret.items.key"},
+		{ApiField: "spec.template.spec.volumes.secret.items.mode", Field: "template.spec.volumes.secret.items.mode"},
+		{ApiField: "spec.template.spec.volumes.secret.items.path", Field: "template.spec.volumes.secret.items.path"},
+		{ApiField: "spec.template.spec.volumes.secret.secretName", Field: "template.spec.volumes.secret.secret_name"},
+		{ApiField: "spec.traffic.latestRevision", Field: "traffic.latest_revision"},
+		{ApiField: "spec.traffic.percent", Field: "traffic.percent"},
+		{ApiField: "spec.traffic.revisionName", Field: "traffic.revision_name"},
+		{ApiField: "spec.traffic.tag", Field: "traffic.tag"},
+		{ApiField: "spec.traffic.url", Field: "traffic.url"},
+	},
+	"google_cloudbuild_trigger": {
+		{ApiField: "build.secrets.kmsKeyName", Field: "build.secret.kms_key_name"},
+		{ApiField: "build.secrets.secretEnv", Field: "build.secret.secret_env"},
+		{ApiField: "build.steps.allowExitCodes", Field: "build.step.allow_exit_codes"},
+		{ApiField: "build.steps.allowFailure", Field: "build.step.allow_failure"},
+		{ApiField: "build.steps.args", Field: "build.step.args"},
+		{ApiField: "build.steps.dir", Field: "build.step.dir"},
+		{ApiField: "build.steps.entrypoint", Field: "build.step.entrypoint"},
+		{ApiField: "build.steps.env", Field: "build.step.env"},
+		{ApiField: "build.steps.id", Field: "build.step.id"},
+		{ApiField: "build.steps.name", Field: "build.step.name"},
+		{ApiField: "build.steps.script", Field: "build.step.script"},
+		{ApiField: "build.steps.secretEnv", Field: "build.step.secret_env"},
+		{ApiField: "build.steps.timeout", Field: "build.step.timeout"},
+		{ApiField: "build.steps.timing", Field: "build.step.timing"},
+		{ApiField: "build.steps.volumes.name", Field: "build.step.volumes.name"},
+		{ApiField: "build.steps.volumes.path", Field: "build.step.volumes.path"},
+		{ApiField: "build.steps.waitFor", Field: "build.step.wait_for"},
+		{ApiField: "id", Field: "trigger_id"},
+	},
+	"google_cloudbuild_worker_pool": {
+		{ApiField: "privatePoolV1Config.networkConfig.peeredNetwork", Field: "network_config.peered_network"},
+		{ApiField: "privatePoolV1Config.networkConfig.peeredNetworkIpRange", Field: "network_config.peered_network_ip_range"},
+		{ApiField: "privatePoolV1Config.privateServiceConnect.networkAttachment", Field: "private_service_connect.network_attachment"},
+		{ApiField: "privatePoolV1Config.privateServiceConnect.routeAllTraffic", Field: "private_service_connect.route_all_traffic"},
+		{ApiField: "privatePoolV1Config.workerConfig.diskSizeGb", Field: "worker_config.disk_size_gb"},
+		{ApiField: "privatePoolV1Config.workerConfig.enableNestedVirtualization", Field: "worker_config.enable_nested_virtualization"},
+		{ApiField: "privatePoolV1Config.workerConfig.machineType", Field: "worker_config.machine_type"},
+		{ApiField: "privatePoolV1Config.networkConfig.egressOption", Field: "worker_config.no_external_ip"},
+	},
+	"google_compute_address": {
+		{ApiField: "id", Field: "address_id"},
+	},
+	"google_compute_attached_disk": {
+		{ApiField: "disks.deviceName", Field: "device_name"},
+		{ApiField: "disks.source", Field: "disk"},
+		{ApiField: "disks.interface", Field: "interface"},
+		{ApiField: "disks.mode", Field: "mode"},
+	},
+	"google_compute_autoscaler": {
+		{ApiField: "autoscalingPolicy.coolDownPeriodSec", Field: "autoscaling_policy.cooldown_period"},
+		{ApiField: "autoscalingPolicy.cpuUtilization.utilizationTarget", Field: "autoscaling_policy.cpu_utilization.target"},
+		{ApiField: "autoscalingPolicy.loadBalancingUtilization.utilizationTarget", Field: "autoscaling_policy.load_balancing_utilization.target"},
+		{ApiField: "autoscalingPolicy.maxNumReplicas", Field: "autoscaling_policy.max_replicas"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.filter", Field: "autoscaling_policy.metric.filter"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.metric", Field: "autoscaling_policy.metric.name"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.singleInstanceAssignment", Field: "autoscaling_policy.metric.single_instance_assignment"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.utilizationTarget", Field: "autoscaling_policy.metric.target"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.utilizationTargetType", Field: "autoscaling_policy.metric.type"},
+		{ApiField: "autoscalingPolicy.minNumReplicas", Field: "autoscaling_policy.min_replicas"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.key", Field: "autoscaling_policy.scaling_schedules.name"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.description", Field: "autoscaling_policy.scaling_schedules.description"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.disabled", Field: "autoscaling_policy.scaling_schedules.disabled"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.durationSec", Field: "autoscaling_policy.scaling_schedules.duration_sec"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.minRequiredReplicas", Field: "autoscaling_policy.scaling_schedules.min_required_replicas"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.schedule", Field: "autoscaling_policy.scaling_schedules.schedule"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.timeZone", Field: "autoscaling_policy.scaling_schedules.time_zone"},
+	},
+	"google_compute_backend_bucket_signed_url_key": {
+		{ApiField: "cdnPolicy.signedUrlKeyNames.backendBucket", Field: "backend_bucket"},
+		{ApiField: "cdnPolicy.signedUrlKeyNames.keyValue", Field: "key_value"},
+		{ApiField: "cdnPolicy.signedUrlKeyNames.keyName", Field: "name"},
+	},
+	"google_compute_backend_service": {
+		{ApiField: "backends.balancingMode", Field: "backend.balancing_mode"},
+		{ApiField: "backends.capacityScaler", Field: "backend.capacity_scaler"},
+		{ApiField: "backends.customMetrics.dryRun", Field: "backend.custom_metrics.dry_run"},
+		{ApiField: "backends.customMetrics.maxUtilization", Field: "backend.custom_metrics.max_utilization"},
+		{ApiField: "backends.customMetrics.name", Field: "backend.custom_metrics.name"},
+		{ApiField: "backends.description", Field: "backend.description"},
+		{ApiField: "backends.group", Field: "backend.group"},
+		{ApiField: "backends.maxConnections", Field: "backend.max_connections"},
+		{ApiField: "backends.maxConnectionsPerEndpoint", Field: "backend.max_connections_per_endpoint"},
+		{ApiField: "backends.maxConnectionsPerInstance", Field: "backend.max_connections_per_instance"},
+		{ApiField: "backends.maxRate", Field: "backend.max_rate"},
+		{ApiField: "backends.maxRatePerEndpoint", Field: "backend.max_rate_per_endpoint"},
+		{ApiField: "backends.maxRatePerInstance", Field: "backend.max_rate_per_instance"},
+		{ApiField: "backends.maxUtilization", Field: "backend.max_utilization"},
+		{ApiField: "backends.preference", Field: "backend.preference"},
+		{ApiField: "connectionDraining.drainingTimeoutSec", Field: "connection_draining_timeout_sec"},
+		{ApiField: "id", Field: "generated_id"},
+	},
+	"google_compute_backend_service_signed_url_key": {
+		{ApiField: "cdnPolicy.signedUrlKeyNames.backendService", Field: "backend_service"},
+		{ApiField: "cdnPolicy.signedUrlKeyNames.keyValue", Field: "key_value"},
+		{ApiField: "cdnPolicy.signedUrlKeyNames.keyName", Field: "name"},
+	},
+	"google_compute_disk": {
+		{ApiField: "diskEncryptionKey.kmsKeyName", Field: "disk_encryption_key.kms_key_self_link"},
+		{ApiField: "id", Field: "disk_id"},
+		{ApiField: "sourceImage", Field: "image"},
+		{ApiField: "sizeGb", Field: "size"},
+		{ApiField: "sourceSnapshot", Field: "snapshot"},
+		{ApiField: "sourceImageEncryptionKey.kmsKeyName", Field: "source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "sourceSnapshotEncryptionKey.kmsKeyName", Field: "source_snapshot_encryption_key.kms_key_self_link"},
+	},
+	"google_compute_disk_async_replication": {
+		{ApiField: "resourceStatus.asyncSecondaryDisks", Field: "secondary_disk.disk"},
+		{ApiField: "resourceStatus.asyncSecondaryDisks", Field: "secondary_disk.state"},
+	},
+	"google_compute_disk_resource_policy_attachment": {
+		{ApiField: "resourcePolicies.name", Field: "name"},
+	},
+	"google_compute_external_vpn_gateway": {
+		{ApiField: "interfaces.id", Field: "interface.id"},
+		{ApiField: "interfaces.ipAddress", Field: "interface.ip_address"},
+		{ApiField: "interfaces.ipv6Address", Field: "interface.ipv6_address"},
+	},
+	"google_compute_firewall": {
+		{ApiField: "allowed.ports", Field: "allow.ports"},
+		{ApiField: "allowed.IPProtocol", Field: "allow.protocol"},
+		{ApiField: "denied.ports", Field: "deny.ports"},
+		{ApiField: "denied.IPProtocol", Field: "deny.protocol"},
+	},
+	"google_compute_firewall_policy": {
+		{ApiField: "id", Field: "firewall_policy_id"},
+	},
+	"google_compute_firewall_policy_association": {
+		{ApiField: "associations.attachmentTarget", Field: "attachment_target"},
+		{ApiField: "associations.firewallPolicy", Field: "firewall_policy"},
+		{ApiField: "associations.name", Field: "name"},
+		{ApiField: "associations.shortName", Field: "short_name"},
+	},
+	"google_compute_firewall_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.creationTimestamp", Field: "creation_timestamp"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.direction", Field: "direction"},
+		{ApiField: "rules.disabled", Field: "disabled"},
+		{ApiField: "rules.enableLogging", Field: "enable_logging"},
+		{ApiField: "rules.firewallPolicy", Field: "firewall_policy"},
+		{ApiField: "rules.kind", Field: "kind"},
+		{ApiField: "rules.match.destAddressGroups", Field: "match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "match.dest_ip_ranges"},
+		{ApiField: "rules.match.destNetworkContext", Field: "match.dest_network_context"},
+		{ApiField: "rules.match.destRegionCodes", Field: "match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "match.layer4_configs.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "match.layer4_configs.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "match.src_ip_ranges"},
+		{ApiField: "rules.match.srcNetworkContext", Field: "match.src_network_context"},
+		{ApiField: "rules.match.srcNetworks", Field: "match.src_networks"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "match.src_secure_tags.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "match.src_secure_tags.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.ruleTupleCount", Field: "rule_tuple_count"},
+		{ApiField: "rules.securityProfileGroup", Field: "security_profile_group"},
+		{ApiField: "rules.targetResources", Field: "target_resources"},
+		{ApiField: "rules.targetSecureTags.name", Field: "target_secure_tags.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "target_secure_tags.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "target_service_accounts"},
+		{ApiField: "rules.tlsInspect", Field: "tls_inspect"},
+	},
+	"google_compute_firewall_policy_with_rules": {
+		{ApiField: "id", Field: "policy_id"},
+		{ApiField: "predefinedRules.match.layer4Configs.ipProtocol", Field: "predefined_rules.match.layer4_config.ip_protocol"},
+		{ApiField: "predefinedRules.match.layer4Configs.ports", Field: "predefined_rules.match.layer4_config.ports"},
+		{ApiField: "predefinedRules.match.srcSecureTags.name", Field: "predefined_rules.match.src_secure_tag.name"},
+		{ApiField: "predefinedRules.match.srcSecureTags.state", Field: "predefined_rules.match.src_secure_tag.state"},
+		{ApiField: "predefinedRules.targetSecureTags.name", Field: "predefined_rules.target_secure_tag.name"},
+		{ApiField: "predefinedRules.targetSecureTags.state", Field: "predefined_rules.target_secure_tag.state"},
+		{ApiField: "rules.action", Field: "rule.action"},
+		{ApiField: "rules.description", Field: "rule.description"},
+		{ApiField: "rules.direction", Field: "rule.direction"},
+		{ApiField: "rules.disabled", Field: "rule.disabled"},
+		{ApiField: "rules.enableLogging", Field: "rule.enable_logging"},
+		{ApiField: "rules.match.destAddressGroups", Field: "rule.match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "rule.match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "rule.match.dest_ip_ranges"},
+		{ApiField: "rules.match.destRegionCodes", Field: "rule.match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "rule.match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "rule.match.layer4_config.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "rule.match.layer4_config.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "rule.match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "rule.match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "rule.match.src_ip_ranges"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "rule.match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "rule.match.src_secure_tag.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "rule.match.src_secure_tag.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "rule.match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "rule.priority"},
+		{ApiField: "rules.ruleName", Field: "rule.rule_name"},
+		{ApiField: "rules.securityProfileGroup", Field: "rule.security_profile_group"},
+		{ApiField: "rules.targetResources", Field: "rule.target_resources"},
+		{ApiField: "rules.targetSecureTags.name", Field: "rule.target_secure_tag.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "rule.target_secure_tag.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "rule.target_service_accounts"},
+		{ApiField: "rules.tlsInspect", Field: "rule.tls_inspect"},
+	},
+	"google_compute_forwarding_rule": {
+		{ApiField: "id", Field: "forwarding_rule_id"},
+	},
+	"google_compute_global_forwarding_rule": {
+		{ApiField: "id", Field: "forwarding_rule_id"},
+	},
+	"google_compute_image": {
+		{ApiField: "imageEncryptionKey.kmsKeyName", Field: "image_encryption_key.kms_key_self_link"},
+		{ApiField: "rawDisk.sha1Checksum", Field: "raw_disk.sha1"},
+		{ApiField: "sourceDiskEncryptionKey.kmsKeyName", Field: "source_disk_encryption_key.kms_key_self_link"},
+		{ApiField: "sourceImageEncryptionKey.kmsKeyName", Field: "source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "sourceSnapshotEncryptionKey.kmsKeyName", Field: "source_snapshot_encryption_key.kms_key_self_link"},
+	},
+	"google_compute_instance": {
+		{ApiField: "disks.deviceName", Field: "attached_disk.device_name"},
+		{ApiField: "disks.diskEncryptionKey.rawKey", Field: "attached_disk.disk_encryption_key_raw"},
+		{ApiField: "disks.diskEncryptionKey.rsaEncryptedKey", Field: "attached_disk.disk_encryption_key_rsa"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "attached_disk.disk_encryption_service_account"},
+		{ApiField: "disks.diskEncryptionKey.sha256", Field: "attached_disk.disk_encryption_key_sha256"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyName", Field: "attached_disk.kms_key_self_link"},
+		{ApiField: "disks.forceAttach", Field: "attached_disk.force_attach"},
+		{ApiField: "disks.mode", Field: "attached_disk.mode"},
+		{ApiField: "disks.source", Field: "attached_disk.source"},
+		{ApiField: "disks.autoDelete", Field: "boot_disk.auto_delete"},
+		{ApiField: "disks.deviceName", Field: "boot_disk.device_name"},
+		{ApiField: "disks.diskEncryptionKey.rawKey", Field: "boot_disk.disk_encryption_key_raw"},
+		{ApiField: "disks.diskEncryptionKey.rsaEncryptedKey", Field: "boot_disk.disk_encryption_key_rsa"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.disk_encryption_service_account"},
+		{ApiField: "disks.diskEncryptionKey.sha256", Field: "boot_disk.disk_encryption_key_sha256"},
+		{ApiField: "disks.initializeParams.architecture", Field: "boot_disk.initialize_params.architecture"},
+		{ApiField: "disks.initializeParams.enableConfidentialCompute", Field: "boot_disk.initialize_params.enable_confidential_compute"},
+		{ApiField: "disks.initializeParams.sourceImage", Field: "boot_disk.initialize_params.image"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.kmsKeyName", Field: "boot_disk.initialize_params.source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.initialize_params.source_image_encryption_key.kms_key_service_account"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.rawKey", Field: "boot_disk.initialize_params.source_image_encryption_key.raw_key"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.rsaEncryptedKey", Field: "boot_disk.initialize_params.source_image_encryption_key.rsa_encrypted_key"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.sha256", Field: "boot_disk.initialize_params.source_image_encryption_key.sha256"},
+		{ApiField: "disks.initializeParams.labels", Field: "boot_disk.initialize_params.labels"},
+		{ApiField: "disks.initializeParams.provisionedIops", Field: "boot_disk.initialize_params.provisioned_iops"},
+		{ApiField: "disks.initializeParams.provisionedThroughput", Field: "boot_disk.initialize_params.provisioned_throughput"},
+		{ApiField: "disks.initializeParams.resourceManagerTags", Field: "boot_disk.initialize_params.resource_manager_tags"},
+		{ApiField: "disks.initializeParams.resourcePolicies", Field: "boot_disk.initialize_params.resource_policies"},
+		{ApiField: "disks.initializeParams.replicaZones", Field: "boot_disk.initialize_params.replica_zones"},
+		{ApiField: "disks.initializeParams.sourceSnapshot", Field: "boot_disk.initialize_params.snapshot"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyName", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.kms_key_self_link"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.kms_key_service_account"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.rawKey", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.raw_key"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.rsaEncryptedKey", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.rsa_encrypted_key"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.sha256", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.sha256"},
+		{ApiField: "disks.initializeParams.diskSizeGb", Field: "boot_disk.initialize_params.size"},
+		{ApiField: "disks.initializeParams.storagePool", Field: "boot_disk.initialize_params.storage_pool"},
+		{ApiField: "disks.initializeParams.diskType", Field: "boot_disk.initialize_params.type"},
+		{ApiField: "disks.interface", Field: "boot_disk.interface"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyName", Field: "boot_disk.kms_key_self_link"},
+		{ApiField: "disks.forceAttach", Field: "boot_disk.force_attach"},
+		{ApiField: "disks.guestOsFeatures.type", Field: "boot_disk.guest_os_features"},
+		{ApiField: "disks.mode", Field: "boot_disk.mode"},
+		{ApiField: "disks.source", Field: "boot_disk.source"},
+		{ApiField: "status", Field: "current_status"},
+		{ApiField: "status", Field: "desired_status"},
+		{ApiField: "displayDevice.enableDisplay", Field: "enable_display"},
+		{ApiField: "guestAccelerators.acceleratorCount", Field: "guest_accelerator.count"},
+		{ApiField: "guestAccelerators.acceleratorType", Field: "guest_accelerator.type"},
+		{ApiField: "id", Field: "instance_id"},
+		{ApiField: "instanceEncryptionKey.kmsKeyName", Field: "instance_encryption_key.kms_key_self_link"},
+		{ApiField: "metadata.items", Field: "metadata"},
+		{ApiField: "metadata.fingerprint", Field: "metadata_fingerprint"},
+		{ApiField: "networkInterfaces.accessConfigs.natIP", Field: "network_interface.access_config.nat_ip"},
+		{ApiField: "networkInterfaces.accessConfigs.networkTier", Field: "network_interface.access_config.network_tier"},
+		{ApiField: "networkInterfaces.accessConfigs.publicPtrDomainName", Field: "network_interface.access_config.public_ptr_domain_name"},
+		{ApiField: "networkInterfaces.aliasIpRanges.ipCidrRange", Field: "network_interface.alias_ip_range.ip_cidr_range"},
+		{ApiField: "networkInterfaces.aliasIpRanges.subnetworkRangeName", Field: "network_interface.alias_ip_range.subnetwork_range_name"},
+		{ApiField: "networkInterfaces.internalIpv6PrefixLength", Field: "network_interface.internal_ipv6_prefix_length"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.externalIpv6", Field: "network_interface.ipv6_access_config.external_ipv6"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.externalIpv6PrefixLength", Field: "network_interface.ipv6_access_config.external_ipv6_prefix_length"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.name", Field: "network_interface.ipv6_access_config.name"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.networkTier", Field: "network_interface.ipv6_access_config.network_tier"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.publicPtrDomainName", Field: "network_interface.ipv6_access_config.public_ptr_domain_name"},
+		{ApiField: "networkInterfaces.ipv6AccessType", Field: "network_interface.ipv6_access_type"},
+		{ApiField: "networkInterfaces.ipv6Address", Field: "network_interface.ipv6_address"},
+		{ApiField: "networkInterfaces.name", Field: "network_interface.name"},
+		{ApiField: "networkInterfaces.network", Field: "network_interface.network"},
+		{ApiField: "networkInterfaces.networkAttachment", Field: "network_interface.network_attachment"},
+		{ApiField: "networkInterfaces.parentNicName", Field: "network_interface.parent_nic_name"},
+		{ApiField: "networkInterfaces.vlan", Field: "network_interface.vlan"},
+		{ApiField: "networkInterfaces.networkIP", Field: "network_interface.network_ip"},
+		{ApiField: "networkInterfaces.nicType", Field: "network_interface.nic_type"},
+		{ApiField: "networkInterfaces.igmpQuery", Field: "network_interface.igmp_query"},
+		{ApiField: "networkInterfaces.queueCount", Field: "network_interface.queue_count"},
+		{ApiField: "networkInterfaces.stackType", Field: "network_interface.stack_type"},
+		{ApiField: "networkInterfaces.subnetwork", Field: "network_interface.subnetwork"},
+		{ApiField: "networkInterfaces.subnetwork", Field: "network_interface.subnetwork_project"},
+		{ApiField: "reservationAffinity.key", Field: "reservation_affinity.specific_reservation.key"},
+		{ApiField: "reservationAffinity.values", Field: "reservation_affinity.specific_reservation.values"},
+		{ApiField: "reservationAffinity.consumeReservationType", Field: "reservation_affinity.type"},
+		{ApiField: "disks.deviceName", Field: "scratch_disk.device_name"},
+		{ApiField: "disks.interface", Field: "scratch_disk.interface"},
+		{ApiField: "disks.diskSizeGb", Field: "scratch_disk.size"},
+		{ApiField: "serviceAccounts.email", Field: "service_account.email"},
+		{ApiField: "serviceAccounts.scopes", Field: "service_account.scopes"},
+		{ApiField: "tags.items", Field: "tags"},
+		{ApiField: "tags.fingerprint", Field: "tags_fingerprint"},
+	},
+	"google_compute_instance_from_template": {
+		{ApiField: "disks.deviceName", Field: "attached_disk.device_name"},
+		{ApiField: "disks.diskEncryptionKey.rawKey", Field: "attached_disk.disk_encryption_key_raw"},
+		{ApiField: "disks.diskEncryptionKey.rsaEncryptedKey", Field: "attached_disk.disk_encryption_key_rsa"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "attached_disk.disk_encryption_service_account"},
+		{ApiField: "disks.diskEncryptionKey.sha256", Field: "attached_disk.disk_encryption_key_sha256"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyName", Field: "attached_disk.kms_key_self_link"},
+		{ApiField: "disks.forceAttach", Field: "attached_disk.force_attach"},
+		{ApiField: "disks.mode", Field: "attached_disk.mode"},
+		{ApiField: "disks.source", Field: "attached_disk.source"},
+		{ApiField: "disks.autoDelete", Field: "boot_disk.auto_delete"},
+		{ApiField: "disks.deviceName", Field: "boot_disk.device_name"},
+		{ApiField: "disks.diskEncryptionKey.rawKey", Field: "boot_disk.disk_encryption_key_raw"},
+		{ApiField: "disks.diskEncryptionKey.rsaEncryptedKey", Field: "boot_disk.disk_encryption_key_rsa"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.disk_encryption_service_account"},
+		{ApiField: "disks.diskEncryptionKey.sha256", Field: "boot_disk.disk_encryption_key_sha256"},
+		{ApiField: "disks.initializeParams.architecture", Field: "boot_disk.initialize_params.architecture"},
+		{ApiField: "disks.initializeParams.enableConfidentialCompute", Field: "boot_disk.initialize_params.enable_confidential_compute"},
+		{ApiField: "disks.initializeParams.sourceImage", Field: "boot_disk.initialize_params.image"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.kmsKeyName", Field: "boot_disk.initialize_params.source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.initialize_params.source_image_encryption_key.kms_key_service_account"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.rawKey", Field: "boot_disk.initialize_params.source_image_encryption_key.raw_key"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.rsaEncryptedKey", Field: "boot_disk.initialize_params.source_image_encryption_key.rsa_encrypted_key"},
+		{ApiField: "disks.initializeParams.sourceImageEncryptionKey.sha256", Field: "boot_disk.initialize_params.source_image_encryption_key.sha256"},
+		{ApiField: "disks.initializeParams.labels", Field: "boot_disk.initialize_params.labels"},
+		{ApiField: "disks.initializeParams.provisionedIops", Field: "boot_disk.initialize_params.provisioned_iops"},
+		{ApiField: "disks.initializeParams.provisionedThroughput", Field: "boot_disk.initialize_params.provisioned_throughput"},
+		{ApiField: "disks.initializeParams.resourceManagerTags", Field: "boot_disk.initialize_params.resource_manager_tags"},
+		{ApiField: "disks.initializeParams.resourcePolicies", Field: "boot_disk.initialize_params.resource_policies"},
+		{ApiField: "disks.initializeParams.replicaZones", Field: "boot_disk.initialize_params.replica_zones"},
+		{ApiField: "disks.initializeParams.sourceSnapshot", Field: "boot_disk.initialize_params.snapshot"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyName", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.kms_key_self_link"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyServiceAccount", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.kms_key_service_account"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.rawKey", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.raw_key"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.rsaEncryptedKey", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.rsa_encrypted_key"},
+		{ApiField: "disks.initializeParams.sourceSnapshotEncryptionKey.sha256", Field: "boot_disk.initialize_params.source_snapshot_encryption_key.sha256"},
+		{ApiField: "disks.initializeParams.diskSizeGb", Field: "boot_disk.initialize_params.size"},
+		{ApiField: "disks.initializeParams.storagePool", Field: "boot_disk.initialize_params.storage_pool"},
+		{ApiField: "disks.initializeParams.diskType", Field: "boot_disk.initialize_params.type"},
+		{ApiField: "disks.interface", Field: "boot_disk.interface"},
+		{ApiField: "disks.diskEncryptionKey.kmsKeyName", Field: "boot_disk.kms_key_self_link"},
+		{ApiField: "disks.forceAttach", Field: "boot_disk.force_attach"},
+		{ApiField: "disks.guestOsFeatures.type", Field: "boot_disk.guest_os_features"},
+		{ApiField: "disks.mode", Field: "boot_disk.mode"},
+		{ApiField: "disks.source", Field: "boot_disk.source"},
+		{ApiField: "status", Field: "current_status"},
+		{ApiField: "status", Field: "desired_status"},
+		{ApiField: "displayDevice.enableDisplay", Field: "enable_display"},
+		{ApiField: "guestAccelerators.acceleratorCount", Field: "guest_accelerator.count"},
+		{ApiField: "guestAccelerators.acceleratorType", Field: "guest_accelerator.type"},
+		{ApiField: "id", Field: "instance_id"},
+		{ApiField: "instanceEncryptionKey.kmsKeyName", Field: "instance_encryption_key.kms_key_self_link"},
+		{ApiField: "metadata.items", Field: "metadata"},
+		{ApiField: "metadata.fingerprint", Field: "metadata_fingerprint"},
+		{ApiField: "networkInterfaces.accessConfigs.natIP", Field: "network_interface.access_config.nat_ip"},
+		{ApiField: "networkInterfaces.accessConfigs.networkTier", Field: "network_interface.access_config.network_tier"},
+		{ApiField: "networkInterfaces.accessConfigs.publicPtrDomainName", Field: "network_interface.access_config.public_ptr_domain_name"},
+		{ApiField: "networkInterfaces.aliasIpRanges.ipCidrRange", Field: "network_interface.alias_ip_range.ip_cidr_range"},
+		{ApiField: "networkInterfaces.aliasIpRanges.subnetworkRangeName", Field: "network_interface.alias_ip_range.subnetwork_range_name"},
+		{ApiField: "networkInterfaces.internalIpv6PrefixLength", Field: "network_interface.internal_ipv6_prefix_length"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.externalIpv6", Field: "network_interface.ipv6_access_config.external_ipv6"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.externalIpv6PrefixLength", Field: "network_interface.ipv6_access_config.external_ipv6_prefix_length"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.name", Field: "network_interface.ipv6_access_config.name"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.networkTier", Field: "network_interface.ipv6_access_config.network_tier"},
+		{ApiField: "networkInterfaces.ipv6AccessConfigs.publicPtrDomainName", Field: "network_interface.ipv6_access_config.public_ptr_domain_name"},
+		{ApiField: "networkInterfaces.ipv6AccessType", Field: "network_interface.ipv6_access_type"},
+		{ApiField: "networkInterfaces.ipv6Address", Field: "network_interface.ipv6_address"},
+		{ApiField: "networkInterfaces.name", Field: "network_interface.name"},
+		{ApiField: "networkInterfaces.network", Field: "network_interface.network"},
+		{ApiField: "networkInterfaces.networkAttachment", Field: "network_interface.network_attachment"},
+		{ApiField: "networkInterfaces.parentNicName", Field: "network_interface.parent_nic_name"},
+		{ApiField: "networkInterfaces.vlan", Field: "network_interface.vlan"},
+		{ApiField: "networkInterfaces.networkIP", Field: "network_interface.network_ip"},
+		{ApiField: "networkInterfaces.nicType", Field: "network_interface.nic_type"},
+		{ApiField: "networkInterfaces.igmpQuery", Field: "network_interface.igmp_query"},
+		{ApiField: "networkInterfaces.queueCount", Field: "network_interface.queue_count"},
+		{ApiField: "networkInterfaces.stackType", Field: "network_interface.stack_type"},
+		{ApiField: "networkInterfaces.subnetwork", Field: "network_interface.subnetwork"},
+		{ApiField: "networkInterfaces.subnetwork", Field: "network_interface.subnetwork_project"},
+		{ApiField: "reservationAffinity.key", Field: "reservation_affinity.specific_reservation.key"},
+		{ApiField: "reservationAffinity.values", Field: "reservation_affinity.specific_reservation.values"},
+		{ApiField: "reservationAffinity.consumeReservationType", Field: "reservation_affinity.type"},
+		{ApiField: "disks.deviceName", Field: "scratch_disk.device_name"},
+		{ApiField: "disks.interface", Field: "scratch_disk.interface"},
+		{ApiField: "disks.diskSizeGb", Field: "scratch_disk.size"},
+		{ApiField: "serviceAccounts.email", Field: "service_account.email"},
+		{ApiField: "serviceAccounts.scopes", Field: "service_account.scopes"},
+		{ApiField: "tags.items", Field: "tags"},
+		{ApiField: "tags.fingerprint", Field: "tags_fingerprint"},
+	},
+	"google_compute_instance_group": {
+		{ApiField: "namedPorts.name", Field: "named_port.name"},
+		{ApiField: "namedPorts.port", Field: "named_port.port"},
+	},
+	"google_compute_instance_group_manager": {
+		{ApiField: "allInstancesConfig.properties.labels", Field: "all_instances_config.labels"},
+		{ApiField: "allInstancesConfig.properties.metadata", Field: "all_instances_config.metadata"},
+		{ApiField: "id", Field: "instance_group_manager_id"},
+		{ApiField: "namedPorts.name", Field: "named_port.name"},
+		{ApiField: "namedPorts.port", Field: "named_port.port"},
+		{ApiField: "statefulPolicy.preservedState.disks.value.autoDelete", Field: "stateful_disk.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.disks.key", Field: "stateful_disk.device_name"},
+		{ApiField: "statefulPolicy.preservedState.externalIPs.value.autoDelete", Field: "stateful_external_ip.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.externalIPs.key", Field: "stateful_external_ip.interface_name"},
+		{ApiField: "statefulPolicy.preservedState.internalIPs.value.autoDelete", Field: "stateful_internal_ip.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.internalIPs.key", Field: "stateful_internal_ip.interface_name"},
+		{ApiField: "updatePolicy.maxSurge.fixed", Field: "update_policy.max_surge_fixed"},
+		{ApiField: "updatePolicy.maxSurge.percent", Field: "update_policy.max_surge_percent"},
+		{ApiField: "updatePolicy.maxUnavailable.fixed", Field: "update_policy.max_unavailable_fixed"},
+		{ApiField: "updatePolicy.maxUnavailable.percent", Field: "update_policy.max_unavailable_percent"},
+		{ApiField: "versions.instanceTemplate", Field: "version.instance_template"},
+		{ApiField: "versions.name", Field: "version.name"},
+		{ApiField: "versions.targetSize.fixed", Field: "version.target_size.fixed"},
+		{ApiField: "versions.targetSize.percent", Field: "version.target_size.percent"},
+	},
+	"google_compute_instance_group_named_port": {
+		{ApiField: "namedPorts.name", Field: "name"},
+		{ApiField: "namedPorts.port", Field: "port"},
+	},
+	"google_compute_instance_template": {
+		{ApiField: "properties.advancedMachineFeatures.enableNestedVirtualization", Field: "advanced_machine_features.enable_nested_virtualization"},
+		{ApiField: "properties.advancedMachineFeatures.enableUefiNetworking", Field: "advanced_machine_features.enable_uefi_networking"},
+		{ApiField: "properties.advancedMachineFeatures.performanceMonitoringUnit", Field: "advanced_machine_features.performance_monitoring_unit"},
+		{ApiField: "properties.advancedMachineFeatures.threadsPerCore", Field: "advanced_machine_features.threads_per_core"},
+		{ApiField: "properties.advancedMachineFeatures.turboMode", Field: "advanced_machine_features.turbo_mode"},
+		{ApiField: "properties.advancedMachineFeatures.visibleCoreCount", Field: "advanced_machine_features.visible_core_count"},
+		{ApiField: "properties.canIpForward", Field: "can_ip_forward"},
+		{ApiField: "properties.confidentialInstanceConfig.confidentialInstanceType", Field: "confidential_instance_config.confidential_instance_type"},
+		{ApiField: "properties.confidentialInstanceConfig.enableConfidentialCompute", Field: "confidential_instance_config.enable_confidential_compute"},
+		{ApiField: "properties.disks.architecture", Field: "disk.architecture"},
+		{ApiField: "properties.disks.autoDelete", Field: "disk.auto_delete"},
+		{ApiField: "properties.disks.boot", Field: "disk.boot"},
+		{ApiField: "properties.disks.deviceName", Field: "disk.device_name"},
+		{ApiField: "properties.disks.diskEncryptionKey.kmsKeyName", Field: "disk.disk_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "disk.disk_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.diskName", Field: "disk.disk_name"},
+		{ApiField: "properties.disks.diskSizeGb", Field: "disk.disk_size_gb"},
+		{ApiField: "properties.disks.initializeParams.diskSizeGb", Field: "disk.disk_size_gb"},
+		{ApiField: "properties.disks.initializeParams.diskType", Field: "disk.disk_type"},
+		{ApiField: "properties.disks.guestOsFeatures.type", Field: "disk.guest_os_features"},
+		{ApiField: "properties.disks.interface", Field: "disk.interface"},
+		{ApiField: "properties.disks.initializeParams.labels", Field: "disk.labels"},
+		{ApiField: "properties.disks.mode", Field: "disk.mode"},
+		{ApiField: "properties.disks.initializeParams.provisionedIops", Field: "disk.provisioned_iops"},
+		{ApiField: "properties.disks.initializeParams.provisionedThroughput", Field: "disk.provisioned_throughput"},
+		{ApiField: "properties.disks.initializeParams.resourceManagerTags", Field: "disk.resource_manager_tags"},
+		{ApiField: "properties.disks.initializeParams.resourcePolicies", Field: "disk.resource_policies"},
+		{ApiField: "properties.disks.source", Field: "disk.source"},
+		{ApiField: "properties.disks.initializeParams.sourceImage", Field: "disk.source_image"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.kmsKeyName", Field: "disk.source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.kmsKeyServiceAccount", Field: "disk.source_image_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.rawKey", Field: "disk.source_image_encryption_key.raw_key"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.rsaEncryptedKey", Field: "disk.source_image_encryption_key.rsa_encrypted_key"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshot", Field: "disk.source_snapshot"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyName", Field: "disk.source_snapshot_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyServiceAccount", Field: "disk.source_snapshot_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.rawKey", Field: "disk.source_snapshot_encryption_key.raw_key"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.rsaEncryptedKey", Field: "disk.source_snapshot_encryption_key.rsa_encrypted_key"},
+		{ApiField: "properties.disks.initializeParams.storagePool", Field: "disk.storage_pool"},
+		{ApiField: "properties.disks.type", Field: "disk.type"},
+		{ApiField: "properties.guestAccelerators.acceleratorCount", Field: "guest_accelerator.count"},
+		{ApiField: "properties.guestAccelerators.acceleratorType", Field: "guest_accelerator.type"},
+		{ApiField: "properties.description", Field: "instance_description"},
+		{ApiField: "properties.keyRevocationActionType", Field: "key_revocation_action_type"},
+		{ApiField: "properties.labels", Field: "labels"},
+		{ApiField: "properties.machineType", Field: "machine_type"},
+		{ApiField: "properties.metadata.items", Field: "metadata"},
+		{ApiField: "properties.metadata.fingerprint", Field: "metadata_fingerprint"},
+		{ApiField: "properties.minCpuPlatform", Field: "min_cpu_platform"},
+		{ApiField: "name", Field: "name_prefix"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.natIP", Field: "network_interface.access_config.nat_ip"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.networkTier", Field: "network_interface.access_config.network_tier"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.publicPtrDomainName", Field: "network_interface.access_config.public_ptr_domain_name"},
+		{ApiField: "properties.networkInterfaces.aliasIpRanges.ipCidrRange", Field: "network_interface.alias_ip_range.ip_cidr_range"},
+		{ApiField: "properties.networkInterfaces.aliasIpRanges.subnetworkRangeName", Field: "network_interface.alias_ip_range.subnetwork_range_name"},
+		{ApiField: "properties.networkInterfaces.internalIpv6PrefixLength", Field: "network_interface.internal_ipv6_prefix_length"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.externalIpv6", Field: "network_interface.ipv6_access_config.external_ipv6"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.externalIpv6PrefixLength", Field: "network_interface.ipv6_access_config.external_ipv6_prefix_length"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.name", Field: "network_interface.ipv6_access_config.name"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.networkTier", Field: "network_interface.ipv6_access_config.network_tier"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.publicPtrDomainName", Field: "network_interface.ipv6_access_config.public_ptr_domain_name"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessType", Field: "network_interface.ipv6_access_type"},
+		{ApiField: "properties.networkInterfaces.ipv6Address", Field: "network_interface.ipv6_address"},
+		{ApiField: "properties.networkInterfaces.name", Field: "network_interface.name"},
+		{ApiField: "properties.networkInterfaces.network", Field: "network_interface.network"},
+		{ApiField: "properties.networkInterfaces.networkAttachment", Field: "network_interface.network_attachment"},
+		{ApiField: "networkInterfaces.parentNicName", Field: "network_interface.parent_nic_name"},
+		{ApiField: "networkInterfaces.vlan", Field: "network_interface.vlan"},
+		{ApiField: "properties.networkInterfaces.networkIP", Field: "network_interface.network_ip"},
+		{ApiField: "properties.networkInterfaces.nicType", Field: "network_interface.nic_type"},
+		{ApiField: "properties.networkInterfaces.igmpQuery", Field: "network_interface.igmp_query"},
+		{ApiField: "properties.networkInterfaces.queueCount", Field: "network_interface.queue_count"},
+		{ApiField: "properties.networkInterfaces.stackType", Field: "network_interface.stack_type"},
+		{ApiField: "properties.networkInterfaces.subnetwork", Field: "network_interface.subnetwork"},
+		{ApiField: "properties.networkInterfaces.subnetwork", Field: "network_interface.subnetwork_project"},
+		{ApiField: "properties.networkPerformanceConfig.totalEgressBandwidthTier", Field: "network_performance_config.total_egress_bandwidth_tier"},
+		{ApiField: "id", Field: "numeric_id"},
+		{ApiField: "properties.reservationAffinity.key", Field: "reservation_affinity.specific_reservation.key"},
+		{ApiField: "properties.reservationAffinity.values", Field: "reservation_affinity.specific_reservation.values"},
+		{ApiField: "properties.reservationAffinity.consumeReservationType", Field: "reservation_affinity.type"},
+		{ApiField: "properties.resourceManagerTags", Field: "resource_manager_tags"},
+		{ApiField: "properties.resourcePolicies", Field: "resource_policies"},
+		{ApiField: "properties.scheduling.automaticRestart", Field: "scheduling.automatic_restart"},
+		{ApiField: "properties.scheduling.availabilityDomain", Field: "scheduling.availability_domain"},
+		{ApiField: "properties.scheduling.instanceTerminationAction", Field: "scheduling.instance_termination_action"},
+		{ApiField: "properties.scheduling.localSsdRecoveryTimeout.nanos", Field: "scheduling.local_ssd_recovery_timeout.nanos"},
+		{ApiField: "properties.scheduling.localSsdRecoveryTimeout.seconds", Field: "scheduling.local_ssd_recovery_timeout.seconds"},
+		{ApiField: "properties.scheduling.maxRunDuration.nanos", Field: "scheduling.max_run_duration.nanos"},
+		{ApiField: "properties.scheduling.maxRunDuration.seconds", Field: "scheduling.max_run_duration.seconds"},
+		{ApiField: "properties.scheduling.minNodeCpus", Field: "scheduling.min_node_cpus"},
+		{ApiField: "properties.scheduling.nodeAffinities.key", Field: "scheduling.node_affinities.key"},
+		{ApiField: "properties.scheduling.nodeAffinities.operator", Field: "scheduling.node_affinities.operator"},
+		{ApiField: "properties.scheduling.nodeAffinities.values", Field: "scheduling.node_affinities.values"},
+		{ApiField: "properties.scheduling.onHostMaintenance", Field: "scheduling.on_host_maintenance"},
+		{ApiField: "properties.scheduling.onInstanceStopAction.discardLocalSsd", Field: "scheduling.on_instance_stop_action.discard_local_ssd"},
+		{ApiField: "properties.scheduling.preemptible", Field: "scheduling.preemptible"},
+		{ApiField: "properties.scheduling.provisioningModel", Field: "scheduling.provisioning_model"},
+		{ApiField: "properties.scheduling.terminationTime", Field: "scheduling.termination_time"},
+		{ApiField: "id", Field: "self_link_unique"},
+		{ApiField: "properties.serviceAccounts.email", Field: "service_account.email"},
+		{ApiField: "properties.serviceAccounts.scopes", Field: "service_account.scopes"},
+		{ApiField: "properties.shieldedInstanceConfig.enableIntegrityMonitoring", Field: "shielded_instance_config.enable_integrity_monitoring"},
+		{ApiField: "properties.shieldedInstanceConfig.enableSecureBoot", Field: "shielded_instance_config.enable_secure_boot"},
+		{ApiField: "properties.shieldedInstanceConfig.enableVtpm", Field: "shielded_instance_config.enable_vtpm"},
+		{ApiField: "properties.tags.items", Field: "tags"},
+		{ApiField: "properties.tags.fingerprint", Field: "tags_fingerprint"},
+	},
+	"google_compute_interconnect_attachment_group": {
+		{ApiField: "attachments.key", Field: "attachments.name"},
+		{ApiField: "attachments.value.attachment", Field: "attachments.attachment"},
+	},
+	"google_compute_interconnect_group": {
+		{ApiField: "interconnects.key", Field: "interconnects.name"},
+		{ApiField: "interconnects.value.interconnect", Field: "interconnects.interconnect"},
+	},
+	"google_compute_managed_ssl_certificate": {
+		{ApiField: "id", Field: "certificate_id"},
+	},
+	"google_compute_network": {
+		{ApiField: "routingConfig.bgpAlwaysCompareMed", Field: "bgp_always_compare_med"},
+		{ApiField: "routingConfig.bgpBestPathSelectionMode", Field: "bgp_best_path_selection_mode"},
+		{ApiField: "routingConfig.bgpInterRegionCost", Field: "bgp_inter_region_cost"},
+		{ApiField: "gatewayIPv4", Field: "gateway_ipv4"},
+		{ApiField: "id", Field: "network_id"},
+		{ApiField: "routingConfig.routingMode", Field: "routing_mode"},
+	},
+	"google_compute_network_endpoint_group": {
+		{ApiField: "id", Field: "generated_id"},
+	},
+	"google_compute_network_firewall_policy": {
+		{ApiField: "id", Field: "network_firewall_policy_id"},
+	},
+	"google_compute_network_firewall_policy_association": {
+		{ApiField: "associations.attachmentTarget", Field: "attachment_target"},
+		{ApiField: "associations.name", Field: "name"},
+		{ApiField: "associations.shortName", Field: "short_name"},
+	},
+	"google_compute_network_firewall_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.creationTimestamp", Field: "creation_timestamp"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.direction", Field: "direction"},
+		{ApiField: "rules.disabled", Field: "disabled"},
+		{ApiField: "rules.enableLogging", Field: "enable_logging"},
+		{ApiField: "rules.kind", Field: "kind"},
+		{ApiField: "rules.match.destAddressGroups", Field: "match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "match.dest_ip_ranges"},
+		{ApiField: "rules.match.destNetworkContext", Field: "match.dest_network_context"},
+		{ApiField: "rules.match.destRegionCodes", Field: "match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "match.layer4_configs.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "match.layer4_configs.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "match.src_ip_ranges"},
+		{ApiField: "rules.match.srcNetworkContext", Field: "match.src_network_context"},
+		{ApiField: "rules.match.srcNetworks", Field: "match.src_networks"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "match.src_secure_tags.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "match.src_secure_tags.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.ruleName", Field: "rule_name"},
+		{ApiField: "rules.ruleTupleCount", Field: "rule_tuple_count"},
+		{ApiField: "rules.securityProfileGroup", Field: "security_profile_group"},
+		{ApiField: "rules.targetSecureTags.name", Field: "target_secure_tags.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "target_secure_tags.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "target_service_accounts"},
+		{ApiField: "rules.tlsInspect", Field: "tls_inspect"},
+	},
+	"google_compute_network_firewall_policy_with_rules": {
+		{ApiField: "id", Field: "network_firewall_policy_id"},
+		{ApiField: "predefinedRules.match.layer4Configs.ipProtocol", Field: "predefined_rules.match.layer4_config.ip_protocol"},
+		{ApiField: "predefinedRules.match.layer4Configs.ports", Field: "predefined_rules.match.layer4_config.ports"},
+		{ApiField: "predefinedRules.match.srcSecureTags.name", Field: "predefined_rules.match.src_secure_tag.name"},
+		{ApiField: "predefinedRules.match.srcSecureTags.state", Field: "predefined_rules.match.src_secure_tag.state"},
+		{ApiField: "predefinedRules.targetSecureTags.name", Field: "predefined_rules.target_secure_tag.name"},
+		{ApiField: "predefinedRules.targetSecureTags.state", Field: "predefined_rules.target_secure_tag.state"},
+		{ApiField: "rules.action", Field: "rule.action"},
+		{ApiField: "rules.description", Field: "rule.description"},
+		{ApiField: "rules.direction", Field: "rule.direction"},
+		{ApiField: "rules.disabled", Field: "rule.disabled"},
+		{ApiField: "rules.enableLogging", Field: "rule.enable_logging"},
+		{ApiField: "rules.match.destAddressGroups", Field: "rule.match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "rule.match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "rule.match.dest_ip_ranges"},
+		{ApiField: "rules.match.destRegionCodes", Field: "rule.match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "rule.match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "rule.match.layer4_config.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "rule.match.layer4_config.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "rule.match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "rule.match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "rule.match.src_ip_ranges"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "rule.match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "rule.match.src_secure_tag.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "rule.match.src_secure_tag.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "rule.match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "rule.priority"},
+		{ApiField: "rules.ruleName", Field: "rule.rule_name"},
+		{ApiField: "rules.securityProfileGroup", Field: "rule.security_profile_group"},
+		{ApiField: "rules.targetSecureTags.name", Field: "rule.target_secure_tag.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "rule.target_secure_tag.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "rule.target_service_accounts"},
+		{ApiField: "rules.tlsInspect", Field: "rule.tls_inspect"},
+	},
+	"google_compute_network_peering": {
+		{ApiField: "peerings.exportCustomRoutes", Field: "export_custom_routes"},
+		{ApiField: "peerings.exportSubnetRoutesWithPublicIp", Field: "export_subnet_routes_with_public_ip"},
+		{ApiField: "peerings.importCustomRoutes", Field: "import_custom_routes"},
+		{ApiField: "peerings.importSubnetRoutesWithPublicIp", Field: "import_subnet_routes_with_public_ip"},
+		{ApiField: "peerings.name", Field: "name"},
+		{ApiField: "peerings.network", Field: "peer_network"},
+		{ApiField: "peerings.stackType", Field: "stack_type"},
+		{ApiField: "peerings.state", Field: "state"},
+		{ApiField: "peerings.stateDetails", Field: "state_details"},
+		{ApiField: "peerings.updateStrategy", Field: "update_strategy"},
+	},
+	"google_compute_network_peering_routes_config": {
+		{ApiField: "peerings.exportCustomRoutes", Field: "export_custom_routes"},
+		{ApiField: "peerings.exportSubnetRoutesWithPublicIp", Field: "export_subnet_routes_with_public_ip"},
+		{ApiField: "peerings.importCustomRoutes", Field: "import_custom_routes"},
+		{ApiField: "peerings.importSubnetRoutesWithPublicIp", Field: "import_subnet_routes_with_public_ip"},
+		{ApiField: "peerings.name", Field: "peering"},
+	},
+	"google_compute_node_group": {
+		{ApiField: "shareSettings.projectMap.key", Field: "share_settings.project_map.id"},
+		{ApiField: "shareSettings.projectMap.value.projectId", Field: "share_settings.project_map.project_id"},
+	},
+	"google_compute_organization_security_policy": {
+		{ApiField: "id", Field: "policy_id"},
+	},
+	"google_compute_organization_security_policy_association": {
+		{ApiField: "associations.attachmentId", Field: "attachment_id"},
+		{ApiField: "associations.displayName", Field: "display_name"},
+		{ApiField: "associations.excludedFolders", Field: "excluded_folders"},
+		{ApiField: "associations.excludedProjects", Field: "excluded_projects"},
+		{ApiField: "associations.name", Field: "name"},
+	},
+	"google_compute_organization_security_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerName", Field: "header_action.request_headers_to_adds.header_name"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerValue", Field: "header_action.request_headers_to_adds.header_value"},
+		{ApiField: "rules.match.config.srcIpRanges", Field: "match.config.src_ip_ranges"},
+		{ApiField: "rules.match.description", Field: "match.description"},
+		{ApiField: "rules.match.expr.expression", Field: "match.expr.expression"},
+		{ApiField: "rules.match.versionedExpr", Field: "match.versioned_expr"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.op", Field: "preconfigured_waf_config.exclusion.request_cookie.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.val", Field: "preconfigured_waf_config.exclusion.request_cookie.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.op", Field: "preconfigured_waf_config.exclusion.request_header.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.val", Field: "preconfigured_waf_config.exclusion.request_header.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.op", Field: "preconfigured_waf_config.exclusion.request_query_param.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.val", Field: "preconfigured_waf_config.exclusion.request_query_param.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.op", Field: "preconfigured_waf_config.exclusion.request_uri.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.val", Field: "preconfigured_waf_config.exclusion.request_uri.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleIds", Field: "preconfigured_waf_config.exclusion.target_rule_ids"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleSet", Field: "preconfigured_waf_config.exclusion.target_rule_set"},
+		{ApiField: "rules.preview", Field: "preview"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.redirectOptions.target", Field: "redirect_options.target"},
+		{ApiField: "rules.redirectOptions.type", Field: "redirect_options.type"},
+	},
+	"google_compute_per_instance_config": {
+		{ApiField: "preservedState.disks.deleteRule", Field: "preserved_state.disk.delete_rule"},
+		{ApiField: "preservedState.disks.deviceName", Field: "preserved_state.disk.device_name"},
+		{ApiField: "preservedState.disks.mode", Field: "preserved_state.disk.mode"},
+		{ApiField: "preservedState.disks.source", Field: "preserved_state.disk.source"},
+		{ApiField: "preservedState.externalIPs.key", Field: "preserved_state.external_ip.interface_name"},
+		{ApiField: "preservedState.externalIPs.value.autoDelete", Field: "preserved_state.external_ip.auto_delete"},
+		{ApiField: "preservedState.externalIPs.value.ipAddress.address", Field: "preserved_state.external_ip.ip_address.address"},
+		{ApiField: "preservedState.internalIPs.key", Field: "preserved_state.internal_ip.interface_name"},
+		{ApiField: "preservedState.internalIPs.value.autoDelete", Field: "preserved_state.internal_ip.auto_delete"},
+		{ApiField: "preservedState.internalIPs.value.ipAddress.address", Field: "preserved_state.internal_ip.ip_address.address"},
+	},
+	"google_compute_project_default_network_tier": {
+		{ApiField: "defaultNetworkTier", Field: "network_tier"},
+	},
+	"google_compute_project_metadata": {
+		{ApiField: "commonInstanceMetadata.items", Field: "metadata"},
+	},
+	"google_compute_project_metadata_item": {
+		{ApiField: "commonInstanceMetadata.items", Field: "key"},
+		{ApiField: "commonInstanceMetadata.items", Field: "value"},
+	},
+	"google_compute_region_autoscaler": {
+		{ApiField: "autoscalingPolicy.coolDownPeriodSec", Field: "autoscaling_policy.cooldown_period"},
+		{ApiField: "autoscalingPolicy.cpuUtilization.utilizationTarget", Field: "autoscaling_policy.cpu_utilization.target"},
+		{ApiField: "autoscalingPolicy.loadBalancingUtilization.utilizationTarget", Field: "autoscaling_policy.load_balancing_utilization.target"},
+		{ApiField: "autoscalingPolicy.maxNumReplicas", Field: "autoscaling_policy.max_replicas"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.filter", Field: "autoscaling_policy.metric.filter"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.metric", Field: "autoscaling_policy.metric.name"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.singleInstanceAssignment", Field: "autoscaling_policy.metric.single_instance_assignment"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.utilizationTarget", Field: "autoscaling_policy.metric.target"},
+		{ApiField: "autoscalingPolicy.customMetricUtilizations.utilizationTargetType", Field: "autoscaling_policy.metric.type"},
+		{ApiField: "autoscalingPolicy.minNumReplicas", Field: "autoscaling_policy.min_replicas"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.key", Field: "autoscaling_policy.scaling_schedules.name"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.description", Field: "autoscaling_policy.scaling_schedules.description"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.disabled", Field: "autoscaling_policy.scaling_schedules.disabled"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.durationSec", Field: "autoscaling_policy.scaling_schedules.duration_sec"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.minRequiredReplicas", Field: "autoscaling_policy.scaling_schedules.min_required_replicas"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.schedule", Field: "autoscaling_policy.scaling_schedules.schedule"},
+		{ApiField: "autoscalingPolicy.scalingSchedules.value.timeZone", Field: "autoscaling_policy.scaling_schedules.time_zone"},
+	},
+	"google_compute_region_backend_service": {
+		{ApiField: "backends.balancingMode", Field: "backend.balancing_mode"},
+		{ApiField: "backends.capacityScaler", Field: "backend.capacity_scaler"},
+		{ApiField: "backends.customMetrics.dryRun", Field: "backend.custom_metrics.dry_run"},
+		{ApiField: "backends.customMetrics.maxUtilization", Field: "backend.custom_metrics.max_utilization"},
+		{ApiField: "backends.customMetrics.name", Field: "backend.custom_metrics.name"},
+		{ApiField: "backends.description", Field: "backend.description"},
+		{ApiField: "backends.failover", Field: "backend.failover"},
+		{ApiField: "backends.group", Field: "backend.group"},
+		{ApiField: "backends.maxConnections", Field: "backend.max_connections"},
+		{ApiField: "backends.maxConnectionsPerEndpoint", Field: "backend.max_connections_per_endpoint"},
+		{ApiField: "backends.maxConnectionsPerInstance", Field: "backend.max_connections_per_instance"},
+		{ApiField: "backends.maxRate", Field: "backend.max_rate"},
+		{ApiField: "backends.maxRatePerEndpoint", Field: "backend.max_rate_per_endpoint"},
+		{ApiField: "backends.maxRatePerInstance", Field: "backend.max_rate_per_instance"},
+		{ApiField: "backends.maxUtilization", Field: "backend.max_utilization"},
+		{ApiField: "connectionDraining.drainingTimeoutSec", Field: "connection_draining_timeout_sec"},
+		{ApiField: "id", Field: "generated_id"},
+	},
+	"google_compute_region_commitment": {
+		{ApiField: "id", Field: "commitment_id"},
+	},
+	"google_compute_region_disk": {
+		{ApiField: "id", Field: "disk_id"},
+		{ApiField: "sourceImage", Field: "image"},
+		{ApiField: "sizeGb", Field: "size"},
+		{ApiField: "sourceSnapshot", Field: "snapshot"},
+	},
+	"google_compute_region_disk_resource_policy_attachment": {
+		{ApiField: "resourcePolicies.name", Field: "name"},
+	},
+	"google_compute_region_health_check": {
+		{ApiField: "id", Field: "health_check_id"},
+	},
+	"google_compute_region_instance_group_manager": {
+		{ApiField: "allInstancesConfig.properties.labels", Field: "all_instances_config.labels"},
+		{ApiField: "allInstancesConfig.properties.metadata", Field: "all_instances_config.metadata"},
+		{ApiField: "distributionPolicy.targetShape", Field: "distribution_policy_target_shape"},
+		{ApiField: "distributionPolicy.zones.zone", Field: "distribution_policy_zones"},
+		{ApiField: "instanceFlexibilityPolicy.instanceSelections.value.machineTypes", Field: "instance_flexibility_policy.instance_selections.machine_types"},
+		{ApiField: "instanceFlexibilityPolicy.instanceSelections.key", Field: "instance_flexibility_policy.instance_selections.name"},
+		{ApiField: "instanceFlexibilityPolicy.instanceSelections.value.rank", Field: "instance_flexibility_policy.instance_selections.rank"},
+		{ApiField: "id", Field: "instance_group_manager_id"},
+		{ApiField: "namedPorts.name", Field: "named_port.name"},
+		{ApiField: "namedPorts.port", Field: "named_port.port"},
+		{ApiField: "statefulPolicy.preservedState.disks.value.autoDelete", Field: "stateful_disk.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.disks.key", Field: "stateful_disk.device_name"},
+		{ApiField: "statefulPolicy.preservedState.externalIPs.value.autoDelete", Field: "stateful_external_ip.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.externalIPs.key", Field: "stateful_external_ip.interface_name"},
+		{ApiField: "statefulPolicy.preservedState.internalIPs.value.autoDelete", Field: "stateful_internal_ip.delete_rule"},
+		{ApiField: "statefulPolicy.preservedState.internalIPs.key", Field: "stateful_internal_ip.interface_name"},
+		{ApiField: "updatePolicy.maxSurge.fixed", Field: "update_policy.max_surge_fixed"},
+		{ApiField: "updatePolicy.maxSurge.percent", Field: "update_policy.max_surge_percent"},
+		{ApiField: "updatePolicy.maxUnavailable.fixed", Field: "update_policy.max_unavailable_fixed"},
+		{ApiField: "updatePolicy.maxUnavailable.percent", Field: "update_policy.max_unavailable_percent"},
+		{ApiField: "versions.instanceTemplate", Field: "version.instance_template"},
+		{ApiField: "versions.name", Field: "version.name"},
+		{ApiField: "versions.targetSize.fixed", Field: "version.target_size.fixed"},
+		{ApiField: "versions.targetSize.percent", Field: "version.target_size.percent"},
+	},
+	"google_compute_region_instance_template": {
+		{ApiField: "properties.advancedMachineFeatures.enableNestedVirtualization", Field: "advanced_machine_features.enable_nested_virtualization"},
+		{ApiField: "properties.advancedMachineFeatures.enableUefiNetworking", Field: "advanced_machine_features.enable_uefi_networking"},
+		{ApiField: "properties.advancedMachineFeatures.performanceMonitoringUnit", Field: "advanced_machine_features.performance_monitoring_unit"},
+		{ApiField: "properties.advancedMachineFeatures.threadsPerCore", Field: "advanced_machine_features.threads_per_core"},
+		{ApiField: "properties.advancedMachineFeatures.turboMode", Field: "advanced_machine_features.turbo_mode"},
+		{ApiField: "properties.advancedMachineFeatures.visibleCoreCount", Field: "advanced_machine_features.visible_core_count"},
+		{ApiField: "properties.canIpForward", Field: "can_ip_forward"},
+		{ApiField: "properties.confidentialInstanceConfig.confidentialInstanceType", Field: "confidential_instance_config.confidential_instance_type"},
+		{ApiField: "properties.confidentialInstanceConfig.enableConfidentialCompute", Field: "confidential_instance_config.enable_confidential_compute"},
+		{ApiField: "properties.disks.architecture", Field: "disk.architecture"},
+		{ApiField: "properties.disks.autoDelete", Field: "disk.auto_delete"},
+		{ApiField: "properties.disks.boot", Field: "disk.boot"},
+		{ApiField: "properties.disks.deviceName", Field: "disk.device_name"},
+		{ApiField: "properties.disks.diskEncryptionKey.kmsKeyName", Field: "disk.disk_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.diskEncryptionKey.kmsKeyServiceAccount", Field: "disk.disk_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.diskName", Field: "disk.disk_name"},
+		{ApiField: "properties.disks.diskSizeGb", Field: "disk.disk_size_gb"},
+		{ApiField: "properties.disks.initializeParams.diskSizeGb", Field: "disk.disk_size_gb"},
+		{ApiField: "properties.disks.initializeParams.diskType", Field: "disk.disk_type"},
+		{ApiField: "properties.disks.guestOsFeatures.type", Field: "disk.guest_os_features"},
+		{ApiField: "properties.disks.interface", Field: "disk.interface"},
+		{ApiField: "properties.disks.initializeParams.labels", Field: "disk.labels"},
+		{ApiField: "properties.disks.mode", Field: "disk.mode"},
+		{ApiField: "properties.disks.initializeParams.provisionedIops", Field: "disk.provisioned_iops"},
+		{ApiField: "properties.disks.initializeParams.provisionedThroughput", Field: "disk.provisioned_throughput"},
+		{ApiField: "properties.disks.initializeParams.resourceManagerTags", Field: "disk.resource_manager_tags"},
+		{ApiField: "properties.disks.initializeParams.resourcePolicies", Field: "disk.resource_policies"},
+		{ApiField: "properties.disks.source", Field: "disk.source"},
+		{ApiField: "properties.disks.initializeParams.sourceImage", Field: "disk.source_image"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.kmsKeyName", Field: "disk.source_image_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.kmsKeyServiceAccount", Field: "disk.source_image_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.rawKey", Field: "disk.source_image_encryption_key.raw_key"},
+		{ApiField: "properties.disks.initializeParams.sourceImageEncryptionKey.rsaEncryptedKey", Field: "disk.source_image_encryption_key.rsa_encrypted_key"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshot", Field: "disk.source_snapshot"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyName", Field: "disk.source_snapshot_encryption_key.kms_key_self_link"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.kmsKeyServiceAccount", Field: "disk.source_snapshot_encryption_key.kms_key_service_account"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.rawKey", Field: "disk.source_snapshot_encryption_key.raw_key"},
+		{ApiField: "properties.disks.initializeParams.sourceSnapshotEncryptionKey.rsaEncryptedKey", Field: "disk.source_snapshot_encryption_key.rsa_encrypted_key"},
+		{ApiField: "properties.disks.initializeParams.storagePool", Field: "disk.storage_pool"},
+		{ApiField: "properties.disks.type", Field: "disk.type"},
+		{ApiField: "properties.guestAccelerators.acceleratorCount", Field: "guest_accelerator.count"},
+		{ApiField: "properties.guestAccelerators.acceleratorType", Field: "guest_accelerator.type"},
+		{ApiField: "properties.description", Field: "instance_description"},
+		{ApiField: "properties.keyRevocationActionType", Field: "key_revocation_action_type"},
+		{ApiField: "properties.labels", Field: "labels"},
+		{ApiField: "properties.machineType", Field: "machine_type"},
+		{ApiField: "properties.metadata.items", Field: "metadata"},
+		{ApiField: "properties.metadata.fingerprint", Field: "metadata_fingerprint"},
+		{ApiField: "properties.minCpuPlatform", Field: "min_cpu_platform"},
+		{ApiField: "name", Field: "name_prefix"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.natIP", Field: "network_interface.access_config.nat_ip"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.networkTier", Field: "network_interface.access_config.network_tier"},
+		{ApiField: "properties.networkInterfaces.accessConfigs.publicPtrDomainName", Field: "network_interface.access_config.public_ptr_domain_name"},
+		{ApiField: "properties.networkInterfaces.aliasIpRanges.ipCidrRange", Field: "network_interface.alias_ip_range.ip_cidr_range"},
+		{ApiField: "properties.networkInterfaces.aliasIpRanges.subnetworkRangeName", Field: "network_interface.alias_ip_range.subnetwork_range_name"},
+		{ApiField: "properties.networkInterfaces.internalIpv6PrefixLength", Field: "network_interface.internal_ipv6_prefix_length"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.externalIpv6", Field: "network_interface.ipv6_access_config.external_ipv6"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.externalIpv6PrefixLength", Field: "network_interface.ipv6_access_config.external_ipv6_prefix_length"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.name", Field: "network_interface.ipv6_access_config.name"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.networkTier", Field: "network_interface.ipv6_access_config.network_tier"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessConfigs.publicPtrDomainName", Field: "network_interface.ipv6_access_config.public_ptr_domain_name"},
+		{ApiField: "properties.networkInterfaces.ipv6AccessType", Field: "network_interface.ipv6_access_type"},
+		{ApiField: "properties.networkInterfaces.ipv6Address", Field: "network_interface.ipv6_address"},
+		{ApiField: "properties.networkInterfaces.name", Field: "network_interface.name"},
+		{ApiField: "properties.networkInterfaces.network", Field: "network_interface.network"},
+		{ApiField: "properties.networkInterfaces.networkIP", Field: "network_interface.network_ip"},
+		{ApiField: "properties.networkInterfaces.nicType", Field: "network_interface.nic_type"},
+		{ApiField: "networkInterfaces.networkAttachment", Field: "network_interface.network_attachment"},
+		{ApiField: "networkInterfaces.parentNicName", Field: "network_interface.parent_nic_name"},
+		{ApiField: "networkInterfaces.vlan", Field: "network_interface.vlan"},
+		{ApiField: "properties.networkInterfaces.igmpQuery", Field: "network_interface.igmp_query"},
+		{ApiField: "properties.networkInterfaces.queueCount", Field: "network_interface.queue_count"},
+		{ApiField: "properties.networkInterfaces.stackType", Field: "network_interface.stack_type"},
+		{ApiField: "properties.networkInterfaces.subnetwork", Field: "network_interface.subnetwork"},
+		{ApiField: "properties.networkInterfaces.subnetwork", Field: "network_interface.subnetwork_project"},
+		{ApiField: "properties.networkPerformanceConfig.totalEgressBandwidthTier", Field: "network_performance_config.total_egress_bandwidth_tier"},
+		{ApiField: "id", Field: "numeric_id"},
+		{ApiField: "properties.reservationAffinity.key", Field: "reservation_affinity.specific_reservation.key"},
+		{ApiField: "properties.reservationAffinity.values", Field: "reservation_affinity.specific_reservation.values"},
+		{ApiField: "properties.reservationAffinity.consumeReservationType", Field: "reservation_affinity.type"},
+		{ApiField: "properties.resourceManagerTags", Field: "resource_manager_tags"},
+		{ApiField: "properties.resourcePolicies", Field: "resource_policies"},
+		{ApiField: "properties.scheduling.automaticRestart", Field: "scheduling.automatic_restart"},
+		{ApiField: "properties.scheduling.availabilityDomain", Field: "scheduling.availability_domain"},
+		{ApiField: "properties.scheduling.instanceTerminationAction", Field: "scheduling.instance_termination_action"},
+		{ApiField: "properties.scheduling.localSsdRecoveryTimeout.nanos", Field: "scheduling.local_ssd_recovery_timeout.nanos"},
+		{ApiField: "properties.scheduling.localSsdRecoveryTimeout.seconds", Field: "scheduling.local_ssd_recovery_timeout.seconds"},
+		{ApiField: "properties.scheduling.maxRunDuration.nanos", Field: "scheduling.max_run_duration.nanos"},
+		{ApiField: "properties.scheduling.maxRunDuration.seconds", Field: "scheduling.max_run_duration.seconds"},
+		{ApiField: "properties.scheduling.minNodeCpus", Field: "scheduling.min_node_cpus"},
+		{ApiField: "properties.scheduling.nodeAffinities.key", Field: "scheduling.node_affinities.key"},
+		{ApiField: "properties.scheduling.nodeAffinities.operator", Field: "scheduling.node_affinities.operator"},
+		{ApiField: "properties.scheduling.nodeAffinities.values", Field: "scheduling.node_affinities.values"},
+		{ApiField: "properties.scheduling.onHostMaintenance", Field: "scheduling.on_host_maintenance"},
+		{ApiField: "properties.scheduling.onInstanceStopAction.discardLocalSsd", Field: "scheduling.on_instance_stop_action.discard_local_ssd"},
+		{ApiField: "properties.scheduling.preemptible", Field: "scheduling.preemptible"},
+		{ApiField: "properties.scheduling.provisioningModel", Field: "scheduling.provisioning_model"},
+		{ApiField: "properties.scheduling.terminationTime", Field: "scheduling.termination_time"},
+		{ApiField: "properties.serviceAccounts.email", Field: "service_account.email"},
+		{ApiField: "properties.serviceAccounts.scopes", Field: "service_account.scopes"},
+		{ApiField: "properties.shieldedInstanceConfig.enableIntegrityMonitoring", Field: "shielded_instance_config.enable_integrity_monitoring"},
+		{ApiField: "properties.shieldedInstanceConfig.enableSecureBoot", Field: "shielded_instance_config.enable_secure_boot"},
+		{ApiField: "properties.shieldedInstanceConfig.enableVtpm", Field: "shielded_instance_config.enable_vtpm"},
+		{ApiField: "properties.tags.items", Field: "tags"},
+		{ApiField: "properties.tags.fingerprint", Field: "tags_fingerprint"},
+	},
+	"google_compute_region_network_endpoint": {
+		{ApiField: "id", Field: "network_endpoint_id"},
+	},
+	"google_compute_region_network_firewall_policy": {
+		{ApiField: "id", Field: "region_network_firewall_policy_id"},
+	},
+	"google_compute_region_network_firewall_policy_association": {
+		{ApiField: "associations.attachmentTarget", Field: "attachment_target"},
+		{ApiField: "associations.name", Field: "name"},
+		{ApiField: "associations.shortName", Field: "short_name"},
+	},
+	"google_compute_region_network_firewall_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.creationTimestamp", Field: "creation_timestamp"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.direction", Field: "direction"},
+		{ApiField: "rules.disabled", Field: "disabled"},
+		{ApiField: "rules.enableLogging", Field: "enable_logging"},
+		{ApiField: "rules.kind", Field: "kind"},
+		{ApiField: "rules.match.destAddressGroups", Field: "match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "match.dest_ip_ranges"},
+		{ApiField: "rules.match.destNetworkContext", Field: "match.dest_network_context"},
+		{ApiField: "rules.match.destRegionCodes", Field: "match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "match.layer4_configs.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "match.layer4_configs.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "match.src_ip_ranges"},
+		{ApiField: "rules.match.srcNetworkContext", Field: "match.src_network_context"},
+		{ApiField: "rules.match.srcNetworks", Field: "match.src_networks"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "match.src_secure_tags.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "match.src_secure_tags.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.ruleName", Field: "rule_name"},
+		{ApiField: "rules.ruleTupleCount", Field: "rule_tuple_count"},
+		{ApiField: "rules.securityProfileGroup", Field: "security_profile_group"},
+		{ApiField: "rules.targetForwardingRules", Field: "target_forwarding_rules"},
+		{ApiField: "rules.targetSecureTags.name", Field: "target_secure_tags.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "target_secure_tags.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "target_service_accounts"},
+		{ApiField: "rules.targetType", Field: "target_type"},
+		{ApiField: "rules.tlsInspect", Field: "tls_inspect"},
+	},
+	"google_compute_region_network_firewall_policy_with_rules": {
+		{ApiField: "id", Field: "network_firewall_policy_id"},
+		{ApiField: "predefinedRules.match.layer4Configs.ipProtocol", Field: "predefined_rules.match.layer4_config.ip_protocol"},
+		{ApiField: "predefinedRules.match.layer4Configs.ports", Field: "predefined_rules.match.layer4_config.ports"},
+		{ApiField: "predefinedRules.match.srcSecureTags.name", Field: "predefined_rules.match.src_secure_tag.name"},
+		{ApiField: "predefinedRules.match.srcSecureTags.state", Field: "predefined_rules.match.src_secure_tag.state"},
+		{ApiField: "predefinedRules.targetSecureTags.name", Field: "predefined_rules.target_secure_tag.name"},
+		{ApiField: "predefinedRules.targetSecureTags.state", Field: "predefined_rules.target_secure_tag.state"},
+		{ApiField: "rules.action", Field: "rule.action"},
+		{ApiField: "rules.description", Field: "rule.description"},
+		{ApiField: "rules.direction", Field: "rule.direction"},
+		{ApiField: "rules.disabled", Field: "rule.disabled"},
+		{ApiField: "rules.enableLogging", Field: "rule.enable_logging"},
+		{ApiField: "rules.match.destAddressGroups", Field: "rule.match.dest_address_groups"},
+		{ApiField: "rules.match.destFqdns", Field: "rule.match.dest_fqdns"},
+		{ApiField: "rules.match.destIpRanges", Field: "rule.match.dest_ip_ranges"},
+		{ApiField: "rules.match.destRegionCodes", Field: "rule.match.dest_region_codes"},
+		{ApiField: "rules.match.destThreatIntelligences", Field: "rule.match.dest_threat_intelligences"},
+		{ApiField: "rules.match.layer4Configs.ipProtocol", Field: "rule.match.layer4_config.ip_protocol"},
+		{ApiField: "rules.match.layer4Configs.ports", Field: "rule.match.layer4_config.ports"},
+		{ApiField: "rules.match.srcAddressGroups", Field: "rule.match.src_address_groups"},
+		{ApiField: "rules.match.srcFqdns", Field: "rule.match.src_fqdns"},
+		{ApiField: "rules.match.srcIpRanges", Field: "rule.match.src_ip_ranges"},
+		{ApiField: "rules.match.srcRegionCodes", Field: "rule.match.src_region_codes"},
+		{ApiField: "rules.match.srcSecureTags.name", Field: "rule.match.src_secure_tag.name"},
+		{ApiField: "rules.match.srcSecureTags.state", Field: "rule.match.src_secure_tag.state"},
+		{ApiField: "rules.match.srcThreatIntelligences", Field: "rule.match.src_threat_intelligences"},
+		{ApiField: "rules.priority", Field: "rule.priority"},
+		{ApiField: "rules.ruleName", Field: "rule.rule_name"},
+		{ApiField: "rules.securityProfileGroup", Field: "rule.security_profile_group"},
+		{ApiField: "rules.targetSecureTags.name", Field: "rule.target_secure_tag.name"},
+		{ApiField: "rules.targetSecureTags.state", Field: "rule.target_secure_tag.state"},
+		{ApiField: "rules.targetServiceAccounts", Field: "rule.target_service_accounts"},
+		{ApiField: "rules.tlsInspect", Field: "rule.tls_inspect"},
+	},
+	"google_compute_region_per_instance_config": {
+		{ApiField: "preservedState.disks.deleteRule", Field: "preserved_state.disk.delete_rule"},
+		{ApiField: "preservedState.disks.deviceName", Field: "preserved_state.disk.device_name"},
+		{ApiField: "preservedState.disks.mode", Field: "preserved_state.disk.mode"},
+		{ApiField: "preservedState.disks.source", Field: "preserved_state.disk.source"},
+		{ApiField: "preservedState.externalIPs.key", Field: "preserved_state.external_ip.interface_name"},
+		{ApiField: "preservedState.externalIPs.value.autoDelete", Field: "preserved_state.external_ip.auto_delete"},
+		{ApiField: "preservedState.externalIPs.value.ipAddress.address", Field: "preserved_state.external_ip.ip_address.address"},
+		{ApiField: "preservedState.internalIPs.key", Field: "preserved_state.internal_ip.interface_name"},
+		{ApiField: "preservedState.internalIPs.value.autoDelete", Field: "preserved_state.internal_ip.auto_delete"},
+		{ApiField: "preservedState.internalIPs.value.ipAddress.address", Field: "preserved_state.internal_ip.ip_address.address"},
+	},
+	"google_compute_region_security_policy": {
+		{ApiField: "id", Field: "policy_id"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.op", Field: "rules.preconfigured_waf_config.exclusion.request_cookie.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.val", Field: "rules.preconfigured_waf_config.exclusion.request_cookie.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.op", Field: "rules.preconfigured_waf_config.exclusion.request_header.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.val", Field: "rules.preconfigured_waf_config.exclusion.request_header.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.op", Field: "rules.preconfigured_waf_config.exclusion.request_query_param.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.val", Field: "rules.preconfigured_waf_config.exclusion.request_query_param.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.op", Field: "rules.preconfigured_waf_config.exclusion.request_uri.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.val", Field: "rules.preconfigured_waf_config.exclusion.request_uri.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleIds", Field: "rules.preconfigured_waf_config.exclusion.target_rule_ids"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleSet", Field: "rules.preconfigured_waf_config.exclusion.target_rule_set"},
+		{ApiField: "selfLinkWithId", Field: "self_link_with_policy_id"},
+	},
+	"google_compute_region_security_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.match.config.srcIpRanges", Field: "match.config.src_ip_ranges"},
+		{ApiField: "rules.match.expr.expression", Field: "match.expr.expression"},
+		{ApiField: "rules.match.versionedExpr", Field: "match.versioned_expr"},
+		{ApiField: "rules.networkMatch.destIpRanges", Field: "network_match.dest_ip_ranges"},
+		{ApiField: "rules.networkMatch.destPorts", Field: "network_match.dest_ports"},
+		{ApiField: "rules.networkMatch.ipProtocols", Field: "network_match.ip_protocols"},
+		{ApiField: "rules.networkMatch.srcAsns", Field: "network_match.src_asns"},
+		{ApiField: "rules.networkMatch.srcIpRanges", Field: "network_match.src_ip_ranges"},
+		{ApiField: "rules.networkMatch.srcPorts", Field: "network_match.src_ports"},
+		{ApiField: "rules.networkMatch.srcRegionCodes", Field: "network_match.src_region_codes"},
+		{ApiField: "rules.networkMatch.userDefinedFields.name", Field: "network_match.user_defined_fields.name"},
+		{ApiField: "rules.networkMatch.userDefinedFields.values", Field: "network_match.user_defined_fields.values"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.op", Field: "preconfigured_waf_config.exclusion.request_cookie.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.val", Field: "preconfigured_waf_config.exclusion.request_cookie.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.op", Field: "preconfigured_waf_config.exclusion.request_header.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.val", Field: "preconfigured_waf_config.exclusion.request_header.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.op", Field: "preconfigured_waf_config.exclusion.request_query_param.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.val", Field: "preconfigured_waf_config.exclusion.request_query_param.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.op", Field: "preconfigured_waf_config.exclusion.request_uri.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.val", Field: "preconfigured_waf_config.exclusion.request_uri.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleIds", Field: "preconfigured_waf_config.exclusion.target_rule_ids"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleSet", Field: "preconfigured_waf_config.exclusion.target_rule_set"},
+		{ApiField: "rules.preview", Field: "preview"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.rateLimitOptions.banDurationSec", Field: "rate_limit_options.ban_duration_sec"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.count", Field: "rate_limit_options.ban_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.intervalSec", Field: "rate_limit_options.ban_threshold.interval_sec"},
+		{ApiField: "rules.rateLimitOptions.conformAction", Field: "rate_limit_options.conform_action"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKey", Field: "rate_limit_options.enforce_on_key"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyName", Field: "rate_limit_options.enforce_on_key_configs.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyType", Field: "rate_limit_options.enforce_on_key_configs.enforce_on_key_type"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyName", Field: "rate_limit_options.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.exceedAction", Field: "rate_limit_options.exceed_action"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.count", Field: "rate_limit_options.rate_limit_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.intervalSec", Field: "rate_limit_options.rate_limit_threshold.interval_sec"},
+	},
+	"google_compute_region_ssl_certificate": {
+		{ApiField: "id", Field: "certificate_id"},
+		{ApiField: "privateKey", Field: "private_key_wo"},
+	},
+	"google_compute_region_target_http_proxy": {
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_region_target_https_proxy": {
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_region_target_tcp_proxy": {
+		{ApiField: "service", Field: "backend_service"},
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_region_url_map": {
+		{ApiField: "hostRules.description", Field: "host_rule.description"},
+		{ApiField: "hostRules.hosts", Field: "host_rule.hosts"},
+		{ApiField: "hostRules.pathMatcher", Field: "host_rule.path_matcher"},
+		{ApiField: "id", Field: "map_id"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowCredentials", Field: "path_matcher.default_route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowHeaders", Field: "path_matcher.default_route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowMethods", Field: "path_matcher.default_route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.default_route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowOrigins", Field: "path_matcher.default_route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.disabled", Field: "path_matcher.default_route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.exposeHeaders", Field: "path_matcher.default_route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.maxAge", Field: "path_matcher.default_route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.default_route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.default_route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.default_route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.default_route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.default_route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.defaultRouteAction.maxStreamDuration.nanos", Field: "path_matcher.default_route_action.max_stream_duration.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.maxStreamDuration.seconds", Field: "path_matcher.default_route_action.max_stream_duration.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.requestMirrorPolicy.backendService", Field: "path_matcher.default_route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.numRetries", Field: "path_matcher.default_route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.default_route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.default_route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.retryConditions", Field: "path_matcher.default_route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.defaultRouteAction.timeout.nanos", Field: "path_matcher.default_route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.timeout.seconds", Field: "path_matcher.default_route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.urlRewrite.hostRewrite", Field: "path_matcher.default_route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.defaultRouteAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.default_route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.defaultRouteAction.urlRewrite.pathTemplateRewrite", Field: "path_matcher.default_route_action.url_rewrite.path_template_rewrite"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.backendService", Field: "path_matcher.default_route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.weight", Field: "path_matcher.default_route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.defaultService", Field: "path_matcher.default_service"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.hostRedirect", Field: "path_matcher.default_url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.httpsRedirect", Field: "path_matcher.default_url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.pathRedirect", Field: "path_matcher.default_url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.prefixRedirect", Field: "path_matcher.default_url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.redirectResponseCode", Field: "path_matcher.default_url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.stripQuery", Field: "path_matcher.default_url_redirect.strip_query"},
+		{ApiField: "pathMatchers.description", Field: "path_matcher.description"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToRemove", Field: "path_matcher.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToRemove", Field: "path_matcher.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.name", Field: "path_matcher.name"},
+		{ApiField: "pathMatchers.pathRules.paths", Field: "path_matcher.path_rule.paths"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowCredentials", Field: "path_matcher.path_rule.route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowHeaders", Field: "path_matcher.path_rule.route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowMethods", Field: "path_matcher.path_rule.route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.path_rule.route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowOrigins", Field: "path_matcher.path_rule.route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.disabled", Field: "path_matcher.path_rule.route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.exposeHeaders", Field: "path_matcher.path_rule.route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.maxAge", Field: "path_matcher.path_rule.route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.path_rule.route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.path_rule.route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.pathRules.routeAction.requestMirrorPolicy.backendService", Field: "path_matcher.path_rule.route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.numRetries", Field: "path_matcher.path_rule.route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.path_rule.route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.path_rule.route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.retryConditions", Field: "path_matcher.path_rule.route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.pathRules.routeAction.timeout.nanos", Field: "path_matcher.path_rule.route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.timeout.seconds", Field: "path_matcher.path_rule.route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.urlRewrite.hostRewrite", Field: "path_matcher.path_rule.route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.pathRules.routeAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.path_rule.route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.backendService", Field: "path_matcher.path_rule.route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.weight", Field: "path_matcher.path_rule.route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.pathRules.service", Field: "path_matcher.path_rule.service"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.hostRedirect", Field: "path_matcher.path_rule.url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.httpsRedirect", Field: "path_matcher.path_rule.url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.pathRedirect", Field: "path_matcher.path_rule.url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.prefixRedirect", Field: "path_matcher.path_rule.url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.redirectResponseCode", Field: "path_matcher.path_rule.url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.stripQuery", Field: "path_matcher.path_rule.url_redirect.strip_query"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.route_rules.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.route_rules.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.route_rules.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToRemove", Field: "path_matcher.route_rules.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.route_rules.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.route_rules.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.route_rules.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToRemove", Field: "path_matcher.route_rules.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.matchRules.fullPathMatch", Field: "path_matcher.route_rules.match_rules.full_path_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.exactMatch", Field: "path_matcher.route_rules.match_rules.header_matches.exact_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.headerName", Field: "path_matcher.route_rules.match_rules.header_matches.header_name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.invertMatch", Field: "path_matcher.route_rules.match_rules.header_matches.invert_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.prefixMatch", Field: "path_matcher.route_rules.match_rules.header_matches.prefix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.presentMatch", Field: "path_matcher.route_rules.match_rules.header_matches.present_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.rangeMatch.rangeEnd", Field: "path_matcher.route_rules.match_rules.header_matches.range_match.range_end"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.rangeMatch.rangeStart", Field: "path_matcher.route_rules.match_rules.header_matches.range_match.range_start"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.regexMatch", Field: "path_matcher.route_rules.match_rules.header_matches.regex_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.suffixMatch", Field: "path_matcher.route_rules.match_rules.header_matches.suffix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.ignoreCase", Field: "path_matcher.route_rules.match_rules.ignore_case"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterLabels.name", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_labels.name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterLabels.value", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_labels.value"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterMatchCriteria", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_match_criteria"},
+		{ApiField: "pathMatchers.routeRules.matchRules.pathTemplateMatch", Field: "path_matcher.route_rules.match_rules.path_template_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.prefixMatch", Field: "path_matcher.route_rules.match_rules.prefix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.exactMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.exact_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.name", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.presentMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.present_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.regexMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.regex_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.regexMatch", Field: "path_matcher.route_rules.match_rules.regex_match"},
+		{ApiField: "pathMatchers.routeRules.priority", Field: "path_matcher.route_rules.priority"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowCredentials", Field: "path_matcher.route_rules.route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowHeaders", Field: "path_matcher.route_rules.route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowMethods", Field: "path_matcher.route_rules.route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.route_rules.route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowOrigins", Field: "path_matcher.route_rules.route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.disabled", Field: "path_matcher.route_rules.route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.exposeHeaders", Field: "path_matcher.route_rules.route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.maxAge", Field: "path_matcher.route_rules.route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.route_rules.route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.route_rules.route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.routeRules.routeAction.requestMirrorPolicy.backendService", Field: "path_matcher.route_rules.route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.numRetries", Field: "path_matcher.route_rules.route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.route_rules.route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.route_rules.route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.retryConditions", Field: "path_matcher.route_rules.route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.routeRules.routeAction.timeout.nanos", Field: "path_matcher.route_rules.route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.timeout.seconds", Field: "path_matcher.route_rules.route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.hostRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.pathTemplateRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.path_template_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.backendService", Field: "path_matcher.route_rules.route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.weight", Field: "path_matcher.route_rules.route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.routeRules.service", Field: "path_matcher.route_rules.service"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.hostRedirect", Field: "path_matcher.route_rules.url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.httpsRedirect", Field: "path_matcher.route_rules.url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.pathRedirect", Field: "path_matcher.route_rules.url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.prefixRedirect", Field: "path_matcher.route_rules.url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.redirectResponseCode", Field: "path_matcher.route_rules.url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.stripQuery", Field: "path_matcher.route_rules.url_redirect.strip_query"},
+		{ApiField: "tests.description", Field: "test.description"},
+		{ApiField: "tests.host", Field: "test.host"},
+		{ApiField: "tests.path", Field: "test.path"},
+		{ApiField: "tests.service", Field: "test.service"},
+	},
+	"google_compute_reservation": {
+		{ApiField: "shareSettings.projectMap.key", Field: "share_settings.project_map.id"},
+		{ApiField: "shareSettings.projectMap.value.projectId", Field: "share_settings.project_map.project_id"},
+	},
+	"google_compute_resource_policy_attachment": {
+		{ApiField: "resourcePolicies.name", Field: "name"},
+	},
+	"google_compute_router_interface": {
+		{ApiField: "interfaces.linkedInterconnectAttachment", Field: "interconnect_attachment"},
+		{ApiField: "interfaces.ipRange", Field: "ip_range"},
+		{ApiField: "interfaces.ipVersion", Field: "ip_version"},
+		{ApiField: "interfaces.privateIpAddress", Field: "private_ip_address"},
+		{ApiField: "interfaces.redundantInterface", Field: "redundant_interface"},
+		{ApiField: "interfaces.subnetwork", Field: "subnetwork"},
+		{ApiField: "interfaces.linkedVpnTunnel", Field: "vpn_tunnel"},
+	},
+	"google_compute_router_nat": {
+		{ApiField: "nats.autoNetworkTier", Field: "auto_network_tier"},
+		{ApiField: "nats.drainNatIps", Field: "drain_nat_ips"},
+		{ApiField: "nats.enableDynamicPortAllocation", Field: "enable_dynamic_port_allocation"},
+		{ApiField: "nats.enableEndpointIndependentMapping", Field: "enable_endpoint_independent_mapping"},
+		{ApiField: "nats.endpointTypes", Field: "endpoint_types"},
+		{ApiField: "nats.icmpIdleTimeoutSec", Field: "icmp_idle_timeout_sec"},
+		{ApiField: "nats.initialNatIps", Field: "initial_nat_ips"},
+		{ApiField: "nats.logConfig.enable", Field: "log_config.enable"},
+		{ApiField: "nats.logConfig.filter", Field: "log_config.filter"},
+		{ApiField: "nats.maxPortsPerVm", Field: "max_ports_per_vm"},
+		{ApiField: "nats.minPortsPerVm", Field: "min_ports_per_vm"},
+		{ApiField: "nats.name", Field: "name"},
+		{ApiField: "nats.nat64Subnetworks.name", Field: "nat64_subnetwork.name"},
+		{ApiField: "nats.natIpAllocateOption", Field: "nat_ip_allocate_option"},
+		{ApiField: "nats.natIps", Field: "nat_ips"},
+		{ApiField: "nats.rules.action.sourceNatActiveIps", Field: "rules.action.source_nat_active_ips"},
+		{ApiField: "nats.rules.action.sourceNatActiveRanges", Field: "rules.action.source_nat_active_ranges"},
+		{ApiField: "nats.rules.action.sourceNatDrainIps", Field: "rules.action.source_nat_drain_ips"},
+		{ApiField: "nats.rules.action.sourceNatDrainRanges", Field: "rules.action.source_nat_drain_ranges"},
+		{ApiField: "nats.rules.description", Field: "rules.description"},
+		{ApiField: "nats.rules.match", Field: "rules.match"},
+		{ApiField: "nats.rules.ruleNumber", Field: "rules.rule_number"},
+		{ApiField: "nats.sourceSubnetworkIpRangesToNat", Field: "source_subnetwork_ip_ranges_to_nat"},
+		{ApiField: "nats.sourceSubnetworkIpRangesToNat64", Field: "source_subnetwork_ip_ranges_to_nat64"},
+		{ApiField: "nats.subnetworks.name", Field: "subnetwork.name"},
+		{ApiField: "nats.subnetworks.secondaryIpRangeNames", Field: "subnetwork.secondary_ip_range_names"},
+		{ApiField: "nats.subnetworks.sourceIpRangesToNat", Field: "subnetwork.source_ip_ranges_to_nat"},
+		{ApiField: "nats.tcpEstablishedIdleTimeoutSec", Field: "tcp_established_idle_timeout_sec"},
+		{ApiField: "nats.tcpTimeWaitTimeoutSec", Field: "tcp_time_wait_timeout_sec"},
+		{ApiField: "nats.tcpTransitoryIdleTimeoutSec", Field: "tcp_transitory_idle_timeout_sec"},
+		{ApiField: "nats.type", Field: "type"},
+		{ApiField: "nats.udpIdleTimeoutSec", Field: "udp_idle_timeout_sec"},
+	},
+	"google_compute_router_nat_address": {
+		{ApiField: "nats.drainNatIps", Field: "drain_nat_ips"},
+		{ApiField: "nats.natIps", Field: "nat_ips"},
+		{ApiField: "nats.name", Field: "router_nat"},
+	},
+	"google_compute_router_peer": {
+		{ApiField: "bgpPeers.advertiseMode", Field: "advertise_mode"},
+		{ApiField: "bgpPeers.advertisedGroups", Field: "advertised_groups"},
+		{ApiField: "bgpPeers.advertisedIpRanges.description", Field: "advertised_ip_ranges.description"},
+		{ApiField: "bgpPeers.advertisedIpRanges.range", Field: "advertised_ip_ranges.range"},
+		{ApiField: "bgpPeers.advertisedRoutePriority", Field: "advertised_route_priority"},
+		{ApiField: "bgpPeers.bfd.minReceiveInterval", Field: "bfd.min_receive_interval"},
+		{ApiField: "bgpPeers.bfd.minTransmitInterval", Field: "bfd.min_transmit_interval"},
+		{ApiField: "bgpPeers.bfd.multiplier", Field: "bfd.multiplier"},
+		{ApiField: "bgpPeers.bfd.sessionInitializationMode", Field: "bfd.session_initialization_mode"},
+		{ApiField: "bgpPeers.customLearnedIpRanges.range", Field: "custom_learned_ip_ranges.range"},
+		{ApiField: "bgpPeers.custom_learned_route_priority", Field: "custom_learned_route_priority"},
+		{ApiField: "bgpPeers.enable", Field: "enable"},
+		{ApiField: "bgpPeers.enableIpv4", Field: "enable_ipv4"},
+		{ApiField: "bgpPeers.enableIpv6", Field: "enable_ipv6"},
+		{ApiField: "bgpPeers.exportPolicies", Field: "export_policies"},
+		{ApiField: "bgpPeers.importPolicies", Field: "import_policies"},
+		{ApiField: "bgpPeers.interfaceName", Field: "interface"},
+		{ApiField: "bgpPeers.ipAddress", Field: "ip_address"},
+		{ApiField: "bgpPeers.ipv4NexthopAddress", Field: "ipv4_nexthop_address"},
+		{ApiField: "bgpPeers.ipv6NexthopAddress", Field: "ipv6_nexthop_address"},
+		{ApiField: "bgpPeers.managementType", Field: "management_type"},
+		{ApiField: "md5AuthenticationKeys.key", Field: "md5_authentication_key.key"},
+		{ApiField: "bgpPeers.md5AuthenticationKeyName", Field: "md5_authentication_key.name"},
+		{ApiField: "bgpPeers.peerAsn", Field: "peer_asn"},
+		{ApiField: "bgpPeers.peerIpAddress", Field: "peer_ip_address"},
+		{ApiField: "bgpPeers.peerIpv4NexthopAddress", Field: "peer_ipv4_nexthop_address"},
+		{ApiField: "bgpPeers.peerIpv6NexthopAddress", Field: "peer_ipv6_nexthop_address"},
+		{ApiField: "bgpPeers.routerApplianceInstance", Field: "router_appliance_instance"},
+	},
+	"google_compute_security_policy": {
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.enable", Field: "adaptive_protection_config.layer_7_ddos_defense_config.enable"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.ruleVisibility", Field: "adaptive_protection_config.layer_7_ddos_defense_config.rule_visibility"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.autoDeployConfidenceThreshold", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.auto_deploy_confidence_threshold"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.autoDeployExpirationSec", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.auto_deploy_expiration_sec"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.autoDeployImpactedBaselineThreshold", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.auto_deploy_impacted_baseline_threshold"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.autoDeployLoadThreshold", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.auto_deploy_load_threshold"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.detectionAbsoluteQps", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.detection_absolute_qps"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.detectionLoadThreshold", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.detection_load_threshold"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.detectionRelativeToBaselineQps", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.detection_relative_to_baseline_qps"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.name", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.name"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.trafficGranularityConfigs.enableEachUniqueValue", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.traffic_granularity_configs.enable_each_unique_value"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.trafficGranularityConfigs.type", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.traffic_granularity_configs.type"},
+		{ApiField: "adaptiveProtectionConfig.layer7DdosDefenseConfig.thresholdConfigs.trafficGranularityConfigs.value", Field: "adaptive_protection_config.layer_7_ddos_defense_config.threshold_configs.traffic_granularity_configs.value"},
+		{ApiField: "rules.action", Field: "rule.action"},
+		{ApiField: "rules.description", Field: "rule.description"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerName", Field: "rule.header_action.request_headers_to_adds.header_name"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerValue", Field: "rule.header_action.request_headers_to_adds.header_value"},
+		{ApiField: "rules.match.config.srcIpRanges", Field: "rule.match.config.src_ip_ranges"},
+		{ApiField: "rules.match.expr.expression", Field: "rule.match.expr.expression"},
+		{ApiField: "rules.match.exprOptions.recaptchaOptions.actionTokenSiteKeys", Field: "rule.match.expr_options.recaptcha_options.action_token_site_keys"},
+		{ApiField: "rules.match.exprOptions.recaptchaOptions.sessionTokenSiteKeys", Field: "rule.match.expr_options.recaptcha_options.session_token_site_keys"},
+		{ApiField: "rules.match.versionedExpr", Field: "rule.match.versioned_expr"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.op", Field: "rule.preconfigured_waf_config.exclusion.request_cookie.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.val", Field: "rule.preconfigured_waf_config.exclusion.request_cookie.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.op", Field: "rule.preconfigured_waf_config.exclusion.request_header.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.val", Field: "rule.preconfigured_waf_config.exclusion.request_header.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.op", Field: "rule.preconfigured_waf_config.exclusion.request_query_param.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.val", Field: "rule.preconfigured_waf_config.exclusion.request_query_param.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.op", Field: "rule.preconfigured_waf_config.exclusion.request_uri.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.val", Field: "rule.preconfigured_waf_config.exclusion.request_uri.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleIds", Field: "rule.preconfigured_waf_config.exclusion.target_rule_ids"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleSet", Field: "rule.preconfigured_waf_config.exclusion.target_rule_set"},
+		{ApiField: "rules.preview", Field: "rule.preview"},
+		{ApiField: "rules.priority", Field: "rule.priority"},
+		{ApiField: "rules.rateLimitOptions.banDurationSec", Field: "rule.rate_limit_options.ban_duration_sec"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.count", Field: "rule.rate_limit_options.ban_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.intervalSec", Field: "rule.rate_limit_options.ban_threshold.interval_sec"},
+		{ApiField: "rules.rateLimitOptions.conformAction", Field: "rule.rate_limit_options.conform_action"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKey", Field: "rule.rate_limit_options.enforce_on_key"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyName", Field: "rule.rate_limit_options.enforce_on_key_configs.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyType", Field: "rule.rate_limit_options.enforce_on_key_configs.enforce_on_key_type"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyName", Field: "rule.rate_limit_options.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.exceedAction", Field: "rule.rate_limit_options.exceed_action"},
+		{ApiField: "rules.rateLimitOptions.exceedRedirectOptions.target", Field: "rule.rate_limit_options.exceed_redirect_options.target"},
+		{ApiField: "rules.rateLimitOptions.exceedRedirectOptions.type", Field: "rule.rate_limit_options.exceed_redirect_options.type"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.count", Field: "rule.rate_limit_options.rate_limit_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.intervalSec", Field: "rule.rate_limit_options.rate_limit_threshold.interval_sec"},
+		{ApiField: "rules.redirectOptions.target", Field: "rule.redirect_options.target"},
+		{ApiField: "rules.redirectOptions.type", Field: "rule.redirect_options.type"},
+	},
+	"google_compute_security_policy_rule": {
+		{ApiField: "rules.action", Field: "action"},
+		{ApiField: "rules.description", Field: "description"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerName", Field: "header_action.request_headers_to_adds.header_name"},
+		{ApiField: "rules.headerAction.requestHeadersToAdds.headerValue", Field: "header_action.request_headers_to_adds.header_value"},
+		{ApiField: "rules.match.config.srcIpRanges", Field: "match.config.src_ip_ranges"},
+		{ApiField: "rules.match.expr.expression", Field: "match.expr.expression"},
+		{ApiField: "rules.match.exprOptions.recaptchaOptions.actionTokenSiteKeys", Field: "match.expr_options.recaptcha_options.action_token_site_keys"},
+		{ApiField: "rules.match.exprOptions.recaptchaOptions.sessionTokenSiteKeys", Field: "match.expr_options.recaptcha_options.session_token_site_keys"},
+		{ApiField: "rules.match.versionedExpr", Field: "match.versioned_expr"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.op", Field: "preconfigured_waf_config.exclusion.request_cookie.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestCookiesToExclude.val", Field: "preconfigured_waf_config.exclusion.request_cookie.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.op", Field: "preconfigured_waf_config.exclusion.request_header.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestHeadersToExclude.val", Field: "preconfigured_waf_config.exclusion.request_header.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.op", Field: "preconfigured_waf_config.exclusion.request_query_param.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestQueryParamsToExclude.val", Field: "preconfigured_waf_config.exclusion.request_query_param.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.op", Field: "preconfigured_waf_config.exclusion.request_uri.operator"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.requestUrisToExclude.val", Field: "preconfigured_waf_config.exclusion.request_uri.value"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleIds", Field: "preconfigured_waf_config.exclusion.target_rule_ids"},
+		{ApiField: "rules.preconfiguredWafConfig.exclusions.targetRuleSet", Field: "preconfigured_waf_config.exclusion.target_rule_set"},
+		{ApiField: "rules.preview", Field: "preview"},
+		{ApiField: "rules.priority", Field: "priority"},
+		{ApiField: "rules.rateLimitOptions.banDurationSec", Field: "rate_limit_options.ban_duration_sec"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.count", Field: "rate_limit_options.ban_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.banThreshold.intervalSec", Field: "rate_limit_options.ban_threshold.interval_sec"},
+		{ApiField: "rules.rateLimitOptions.conformAction", Field: "rate_limit_options.conform_action"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKey", Field: "rate_limit_options.enforce_on_key"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyName", Field: "rate_limit_options.enforce_on_key_configs.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyConfigs.enforceOnKeyType", Field: "rate_limit_options.enforce_on_key_configs.enforce_on_key_type"},
+		{ApiField: "rules.rateLimitOptions.enforceOnKeyName", Field: "rate_limit_options.enforce_on_key_name"},
+		{ApiField: "rules.rateLimitOptions.exceedAction", Field: "rate_limit_options.exceed_action"},
+		{ApiField: "rules.rateLimitOptions.exceedRedirectOptions.target", Field: "rate_limit_options.exceed_redirect_options.target"},
+		{ApiField: "rules.rateLimitOptions.exceedRedirectOptions.type", Field: "rate_limit_options.exceed_redirect_options.type"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.count", Field: "rate_limit_options.rate_limit_threshold.count"},
+		{ApiField: "rules.rateLimitOptions.rateLimitThreshold.intervalSec", Field: "rate_limit_options.rate_limit_threshold.interval_sec"},
+		{ApiField: "rules.redirectOptions.target", Field: "redirect_options.target"},
+		{ApiField: "rules.redirectOptions.type", Field: "redirect_options.type"},
+	},
+	"google_compute_snapshot": {
+		{ApiField: "snapshotEncryptionKey.kmsKeyName", Field: "snapshot_encryption_key.kms_key_self_link"},
+		{ApiField: "id", Field: "snapshot_id"},
+		{ApiField: "sourceDiskEncryptionKey.kmsKeyName", Field: "source_disk_encryption_key.kms_key_self_link"},
+	},
+	"google_compute_snapshot_settings": {
+		{ApiField: "storageLocation.locations.key", Field: "storage_location.locations.location"},
+		{ApiField: "storageLocation.locations.value.name", Field: "storage_location.locations.name"},
+	},
+	"google_compute_ssl_certificate": {
+		{ApiField: "id", Field: "certificate_id"},
+		{ApiField: "privateKey", Field: "private_key_wo"},
+	},
+	"google_compute_subnetwork": {
+		{ApiField: "secondaryIpRanges.ipCidrRange", Field: "secondary_ip_range.ip_cidr_range"},
+		{ApiField: "secondaryIpRanges.rangeName", Field: "secondary_ip_range.range_name"},
+		{ApiField: "secondaryIpRanges.reservedInternalRange", Field: "secondary_ip_range.reserved_internal_range"},
+		{ApiField: "id", Field: "subnetwork_id"},
+	},
+	"google_compute_target_http_proxy": {
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_target_https_proxy": {
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_target_ssl_proxy": {
+		{ApiField: "service", Field: "backend_service"},
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_target_tcp_proxy": {
+		{ApiField: "service", Field: "backend_service"},
+		{ApiField: "id", Field: "proxy_id"},
+	},
+	"google_compute_url_map": {
+		{ApiField: "defaultCustomErrorResponsePolicy.errorResponseRules.matchResponseCodes", Field: "default_custom_error_response_policy.error_response_rule.match_response_codes"},
+		{ApiField: "defaultCustomErrorResponsePolicy.errorResponseRules.overrideResponseCode", Field: "default_custom_error_response_policy.error_response_rule.override_response_code"},
+		{ApiField: "defaultCustomErrorResponsePolicy.errorResponseRules.path", Field: "default_custom_error_response_policy.error_response_rule.path"},
+		{ApiField: "hostRules.description", Field: "host_rule.description"},
+		{ApiField: "hostRules.hosts", Field: "host_rule.hosts"},
+		{ApiField: "hostRules.pathMatcher", Field: "host_rule.path_matcher"},
+		{ApiField: "id", Field: "map_id"},
+		{ApiField: "pathMatchers.defaultCustomErrorResponsePolicy.errorResponseRules.matchResponseCodes", Field: "path_matcher.default_custom_error_response_policy.error_response_rule.match_response_codes"},
+		{ApiField: "pathMatchers.defaultCustomErrorResponsePolicy.errorResponseRules.overrideResponseCode", Field: "path_matcher.default_custom_error_response_policy.error_response_rule.override_response_code"},
+		{ApiField: "pathMatchers.defaultCustomErrorResponsePolicy.errorResponseRules.path", Field: "path_matcher.default_custom_error_response_policy.error_response_rule.path"},
+		{ApiField: "pathMatchers.defaultCustomErrorResponsePolicy.errorService", Field: "path_matcher.default_custom_error_response_policy.error_service"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheBypassRequestHeaderNames", Field: "path_matcher.default_route_action.cache_policy.cache_bypass_request_header_names"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.excludedQueryParameters", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.excluded_query_parameters"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includeHost", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.include_host"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includeProtocol", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.include_protocol"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includeQueryString", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.include_query_string"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includedCookieNames", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.included_cookie_names"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includedHeaderNames", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.included_header_names"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheKeyPolicy.includedQueryParameters", Field: "path_matcher.default_route_action.cache_policy.cache_key_policy.included_query_parameters"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.cacheMode", Field: "path_matcher.default_route_action.cache_policy.cache_mode"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.clientTtl.nanos", Field: "path_matcher.default_route_action.cache_policy.client_ttl.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.clientTtl.seconds", Field: "path_matcher.default_route_action.cache_policy.client_ttl.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.defaultTtl.nanos", Field: "path_matcher.default_route_action.cache_policy.default_ttl.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.defaultTtl.seconds", Field: "path_matcher.default_route_action.cache_policy.default_ttl.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.maxTtl.nanos", Field: "path_matcher.default_route_action.cache_policy.max_ttl.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.maxTtl.seconds", Field: "path_matcher.default_route_action.cache_policy.max_ttl.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.negativeCaching", Field: "path_matcher.default_route_action.cache_policy.negative_caching"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.negativeCachingPolicy.code", Field: "path_matcher.default_route_action.cache_policy.negative_caching_policy.code"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.negativeCachingPolicy.ttl.nanos", Field: "path_matcher.default_route_action.cache_policy.negative_caching_policy.ttl.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.negativeCachingPolicy.ttl.seconds", Field: "path_matcher.default_route_action.cache_policy.negative_caching_policy.ttl.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.requestCoalescing", Field: "path_matcher.default_route_action.cache_policy.request_coalescing"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.serveWhileStale.nanos", Field: "path_matcher.default_route_action.cache_policy.serve_while_stale.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.cachePolicy.serveWhileStale.seconds", Field: "path_matcher.default_route_action.cache_policy.serve_while_stale.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowCredentials", Field: "path_matcher.default_route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowHeaders", Field: "path_matcher.default_route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowMethods", Field: "path_matcher.default_route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.default_route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.allowOrigins", Field: "path_matcher.default_route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.disabled", Field: "path_matcher.default_route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.exposeHeaders", Field: "path_matcher.default_route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.defaultRouteAction.corsPolicy.maxAge", Field: "path_matcher.default_route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.default_route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.default_route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.default_route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.default_route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.default_route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.defaultRouteAction.maxStreamDuration.nanos", Field: "path_matcher.default_route_action.max_stream_duration.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.maxStreamDuration.seconds", Field: "path_matcher.default_route_action.max_stream_duration.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.requestMirrorPolicy.backendService", Field: "path_matcher.default_route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.numRetries", Field: "path_matcher.default_route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.default_route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.default_route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.retryPolicy.retryConditions", Field: "path_matcher.default_route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.defaultRouteAction.timeout.nanos", Field: "path_matcher.default_route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.defaultRouteAction.timeout.seconds", Field: "path_matcher.default_route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.defaultRouteAction.urlRewrite.hostRewrite", Field: "path_matcher.default_route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.defaultRouteAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.default_route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.backendService", Field: "path_matcher.default_route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.default_route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.defaultRouteAction.weightedBackendServices.weight", Field: "path_matcher.default_route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.defaultService", Field: "path_matcher.default_service"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.hostRedirect", Field: "path_matcher.default_url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.httpsRedirect", Field: "path_matcher.default_url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.pathRedirect", Field: "path_matcher.default_url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.prefixRedirect", Field: "path_matcher.default_url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.redirectResponseCode", Field: "path_matcher.default_url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.defaultUrlRedirect.stripQuery", Field: "path_matcher.default_url_redirect.strip_query"},
+		{ApiField: "pathMatchers.description", Field: "path_matcher.description"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.headerAction.requestHeadersToRemove", Field: "path_matcher.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.headerAction.responseHeadersToRemove", Field: "path_matcher.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.name", Field: "path_matcher.name"},
+		{ApiField: "pathMatchers.pathRules.customErrorResponsePolicy.errorResponseRules.matchResponseCodes", Field: "path_matcher.path_rule.custom_error_response_policy.error_response_rule.match_response_codes"},
+		{ApiField: "pathMatchers.pathRules.customErrorResponsePolicy.errorResponseRules.overrideResponseCode", Field: "path_matcher.path_rule.custom_error_response_policy.error_response_rule.override_response_code"},
+		{ApiField: "pathMatchers.pathRules.customErrorResponsePolicy.errorResponseRules.path", Field: "path_matcher.path_rule.custom_error_response_policy.error_response_rule.path"},
+		{ApiField: "pathMatchers.pathRules.customErrorResponsePolicy.errorService", Field: "path_matcher.path_rule.custom_error_response_policy.error_service"},
+		{ApiField: "pathMatchers.pathRules.paths", Field: "path_matcher.path_rule.paths"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheBypassRequestHeaderNames", Field: "path_matcher.path_rule.route_action.cache_policy.cache_bypass_request_header_names"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.excludedQueryParameters", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.excluded_query_parameters"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includeHost", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.include_host"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includeProtocol", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.include_protocol"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includeQueryString", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.include_query_string"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includedCookieNames", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.included_cookie_names"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includedHeaderNames", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.included_header_names"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheKeyPolicy.includedQueryParameters", Field: "path_matcher.path_rule.route_action.cache_policy.cache_key_policy.included_query_parameters"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.cacheMode", Field: "path_matcher.path_rule.route_action.cache_policy.cache_mode"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.clientTtl.nanos", Field: "path_matcher.path_rule.route_action.cache_policy.client_ttl.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.clientTtl.seconds", Field: "path_matcher.path_rule.route_action.cache_policy.client_ttl.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.defaultTtl.nanos", Field: "path_matcher.path_rule.route_action.cache_policy.default_ttl.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.defaultTtl.seconds", Field: "path_matcher.path_rule.route_action.cache_policy.default_ttl.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.maxTtl.nanos", Field: "path_matcher.path_rule.route_action.cache_policy.max_ttl.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.maxTtl.seconds", Field: "path_matcher.path_rule.route_action.cache_policy.max_ttl.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.negativeCaching", Field: "path_matcher.path_rule.route_action.cache_policy.negative_caching"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.negativeCachingPolicy.code", Field: "path_matcher.path_rule.route_action.cache_policy.negative_caching_policy.code"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.negativeCachingPolicy.ttl.nanos", Field: "path_matcher.path_rule.route_action.cache_policy.negative_caching_policy.ttl.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.negativeCachingPolicy.ttl.seconds", Field: "path_matcher.path_rule.route_action.cache_policy.negative_caching_policy.ttl.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.requestCoalescing", Field: "path_matcher.path_rule.route_action.cache_policy.request_coalescing"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.serveWhileStale.nanos", Field: "path_matcher.path_rule.route_action.cache_policy.serve_while_stale.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.cachePolicy.serveWhileStale.seconds", Field: "path_matcher.path_rule.route_action.cache_policy.serve_while_stale.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowCredentials", Field: "path_matcher.path_rule.route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowHeaders", Field: "path_matcher.path_rule.route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowMethods", Field: "path_matcher.path_rule.route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.path_rule.route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.allowOrigins", Field: "path_matcher.path_rule.route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.disabled", Field: "path_matcher.path_rule.route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.exposeHeaders", Field: "path_matcher.path_rule.route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.pathRules.routeAction.corsPolicy.maxAge", Field: "path_matcher.path_rule.route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.path_rule.route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.path_rule.route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.path_rule.route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.pathRules.routeAction.maxStreamDuration.nanos", Field: "path_matcher.path_rule.route_action.max_stream_duration.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.maxStreamDuration.seconds", Field: "path_matcher.path_rule.route_action.max_stream_duration.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.requestMirrorPolicy.backendService", Field: "path_matcher.path_rule.route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.numRetries", Field: "path_matcher.path_rule.route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.path_rule.route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.path_rule.route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.retryPolicy.retryConditions", Field: "path_matcher.path_rule.route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.pathRules.routeAction.timeout.nanos", Field: "path_matcher.path_rule.route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.pathRules.routeAction.timeout.seconds", Field: "path_matcher.path_rule.route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.pathRules.routeAction.urlRewrite.hostRewrite", Field: "path_matcher.path_rule.route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.pathRules.routeAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.path_rule.route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.backendService", Field: "path_matcher.path_rule.route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.path_rule.route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.pathRules.routeAction.weightedBackendServices.weight", Field: "path_matcher.path_rule.route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.pathRules.service", Field: "path_matcher.path_rule.service"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.hostRedirect", Field: "path_matcher.path_rule.url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.httpsRedirect", Field: "path_matcher.path_rule.url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.pathRedirect", Field: "path_matcher.path_rule.url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.prefixRedirect", Field: "path_matcher.path_rule.url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.redirectResponseCode", Field: "path_matcher.path_rule.url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.pathRules.urlRedirect.stripQuery", Field: "path_matcher.path_rule.url_redirect.strip_query"},
+		{ApiField: "pathMatchers.routeRules.customErrorResponsePolicy.errorResponseRules.matchResponseCodes", Field: "path_matcher.route_rules.custom_error_response_policy.error_response_rule.match_response_codes"},
+		{ApiField: "pathMatchers.routeRules.customErrorResponsePolicy.errorResponseRules.overrideResponseCode", Field: "path_matcher.route_rules.custom_error_response_policy.error_response_rule.override_response_code"},
+		{ApiField: "pathMatchers.routeRules.customErrorResponsePolicy.errorResponseRules.path", Field: "path_matcher.route_rules.custom_error_response_policy.error_response_rule.path"},
+		{ApiField: "pathMatchers.routeRules.customErrorResponsePolicy.errorService", Field: "path_matcher.route_rules.custom_error_response_policy.error_service"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.route_rules.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.route_rules.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.route_rules.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.headerAction.requestHeadersToRemove", Field: "path_matcher.route_rules.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.route_rules.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.route_rules.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.route_rules.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.headerAction.responseHeadersToRemove", Field: "path_matcher.route_rules.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.matchRules.fullPathMatch", Field: "path_matcher.route_rules.match_rules.full_path_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.exactMatch", Field: "path_matcher.route_rules.match_rules.header_matches.exact_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.headerName", Field: "path_matcher.route_rules.match_rules.header_matches.header_name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.invertMatch", Field: "path_matcher.route_rules.match_rules.header_matches.invert_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.prefixMatch", Field: "path_matcher.route_rules.match_rules.header_matches.prefix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.presentMatch", Field: "path_matcher.route_rules.match_rules.header_matches.present_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.rangeMatch.rangeEnd", Field: "path_matcher.route_rules.match_rules.header_matches.range_match.range_end"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.rangeMatch.rangeStart", Field: "path_matcher.route_rules.match_rules.header_matches.range_match.range_start"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.regexMatch", Field: "path_matcher.route_rules.match_rules.header_matches.regex_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.headerMatches.suffixMatch", Field: "path_matcher.route_rules.match_rules.header_matches.suffix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.ignoreCase", Field: "path_matcher.route_rules.match_rules.ignore_case"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterLabels.name", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_labels.name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterLabels.value", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_labels.value"},
+		{ApiField: "pathMatchers.routeRules.matchRules.metadataFilters.filterMatchCriteria", Field: "path_matcher.route_rules.match_rules.metadata_filters.filter_match_criteria"},
+		{ApiField: "pathMatchers.routeRules.matchRules.pathTemplateMatch", Field: "path_matcher.route_rules.match_rules.path_template_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.prefixMatch", Field: "path_matcher.route_rules.match_rules.prefix_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.exactMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.exact_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.name", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.name"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.presentMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.present_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.queryParameterMatches.regexMatch", Field: "path_matcher.route_rules.match_rules.query_parameter_matches.regex_match"},
+		{ApiField: "pathMatchers.routeRules.matchRules.regexMatch", Field: "path_matcher.route_rules.match_rules.regex_match"},
+		{ApiField: "pathMatchers.routeRules.priority", Field: "path_matcher.route_rules.priority"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheBypassRequestHeaderNames", Field: "path_matcher.route_rules.route_action.cache_policy.cache_bypass_request_header_names"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.excludedQueryParameters", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.excluded_query_parameters"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includeHost", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.include_host"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includeProtocol", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.include_protocol"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includeQueryString", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.include_query_string"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includedCookieNames", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.included_cookie_names"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includedHeaderNames", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.included_header_names"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheKeyPolicy.includedQueryParameters", Field: "path_matcher.route_rules.route_action.cache_policy.cache_key_policy.included_query_parameters"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.cacheMode", Field: "path_matcher.route_rules.route_action.cache_policy.cache_mode"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.clientTtl.nanos", Field: "path_matcher.route_rules.route_action.cache_policy.client_ttl.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.clientTtl.seconds", Field: "path_matcher.route_rules.route_action.cache_policy.client_ttl.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.defaultTtl.nanos", Field: "path_matcher.route_rules.route_action.cache_policy.default_ttl.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.defaultTtl.seconds", Field: "path_matcher.route_rules.route_action.cache_policy.default_ttl.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.maxTtl.nanos", Field: "path_matcher.route_rules.route_action.cache_policy.max_ttl.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.maxTtl.seconds", Field: "path_matcher.route_rules.route_action.cache_policy.max_ttl.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.negativeCaching", Field: "path_matcher.route_rules.route_action.cache_policy.negative_caching"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.negativeCachingPolicy.code", Field: "path_matcher.route_rules.route_action.cache_policy.negative_caching_policy.code"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.negativeCachingPolicy.ttl.nanos", Field: "path_matcher.route_rules.route_action.cache_policy.negative_caching_policy.ttl.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.negativeCachingPolicy.ttl.seconds", Field: "path_matcher.route_rules.route_action.cache_policy.negative_caching_policy.ttl.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.requestCoalescing", Field: "path_matcher.route_rules.route_action.cache_policy.request_coalescing"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.serveWhileStale.nanos", Field: "path_matcher.route_rules.route_action.cache_policy.serve_while_stale.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.cachePolicy.serveWhileStale.seconds", Field: "path_matcher.route_rules.route_action.cache_policy.serve_while_stale.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowCredentials", Field: "path_matcher.route_rules.route_action.cors_policy.allow_credentials"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowHeaders", Field: "path_matcher.route_rules.route_action.cors_policy.allow_headers"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowMethods", Field: "path_matcher.route_rules.route_action.cors_policy.allow_methods"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowOriginRegexes", Field: "path_matcher.route_rules.route_action.cors_policy.allow_origin_regexes"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.allowOrigins", Field: "path_matcher.route_rules.route_action.cors_policy.allow_origins"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.disabled", Field: "path_matcher.route_rules.route_action.cors_policy.disabled"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.exposeHeaders", Field: "path_matcher.route_rules.route_action.cors_policy.expose_headers"},
+		{ApiField: "pathMatchers.routeRules.routeAction.corsPolicy.maxAge", Field: "path_matcher.route_rules.route_action.cors_policy.max_age"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.abort.httpStatus", Field: "path_matcher.route_rules.route_action.fault_injection_policy.abort.http_status"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.abort.percentage", Field: "path_matcher.route_rules.route_action.fault_injection_policy.abort.percentage"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.fixedDelay.nanos", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.fixed_delay.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.fixedDelay.seconds", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.fixed_delay.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.faultInjectionPolicy.delay.percentage", Field: "path_matcher.route_rules.route_action.fault_injection_policy.delay.percentage"},
+		{ApiField: "pathMatchers.routeRules.routeAction.maxStreamDuration.nanos", Field: "path_matcher.route_rules.route_action.max_stream_duration.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.maxStreamDuration.seconds", Field: "path_matcher.route_rules.route_action.max_stream_duration.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.requestMirrorPolicy.backendService", Field: "path_matcher.route_rules.route_action.request_mirror_policy.backend_service"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.numRetries", Field: "path_matcher.route_rules.route_action.retry_policy.num_retries"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.perTryTimeout.nanos", Field: "path_matcher.route_rules.route_action.retry_policy.per_try_timeout.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.perTryTimeout.seconds", Field: "path_matcher.route_rules.route_action.retry_policy.per_try_timeout.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.retryPolicy.retryConditions", Field: "path_matcher.route_rules.route_action.retry_policy.retry_conditions"},
+		{ApiField: "pathMatchers.routeRules.routeAction.timeout.nanos", Field: "path_matcher.route_rules.route_action.timeout.nanos"},
+		{ApiField: "pathMatchers.routeRules.routeAction.timeout.seconds", Field: "path_matcher.route_rules.route_action.timeout.seconds"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.hostRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.host_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.pathPrefixRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.urlRewrite.pathTemplateRewrite", Field: "path_matcher.route_rules.route_action.url_rewrite.path_template_rewrite"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.backendService", Field: "path_matcher.route_rules.route_action.weighted_backend_services.backend_service"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerName", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.headerValue", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToAdd.replace", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.requestHeadersToRemove", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.request_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerName", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.header_name"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.headerValue", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.header_value"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToAdd.replace", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_add.replace"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.headerAction.responseHeadersToRemove", Field: "path_matcher.route_rules.route_action.weighted_backend_services.header_action.response_headers_to_remove"},
+		{ApiField: "pathMatchers.routeRules.routeAction.weightedBackendServices.weight", Field: "path_matcher.route_rules.route_action.weighted_backend_services.weight"},
+		{ApiField: "pathMatchers.routeRules.service", Field: "path_matcher.route_rules.service"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.hostRedirect", Field: "path_matcher.route_rules.url_redirect.host_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.httpsRedirect", Field: "path_matcher.route_rules.url_redirect.https_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.pathRedirect", Field: "path_matcher.route_rules.url_redirect.path_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.prefixRedirect", Field: "path_matcher.route_rules.url_redirect.prefix_redirect"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.redirectResponseCode", Field: "path_matcher.route_rules.url_redirect.redirect_response_code"},
+		{ApiField: "pathMatchers.routeRules.urlRedirect.stripQuery", Field: "path_matcher.route_rules.url_redirect.strip_query"},
+		{ApiField: "tests.description", Field: "test.description"},
+		{ApiField: "tests.expectedOutputUrl", Field: "test.expected_output_url"},
+		{ApiField: "tests.expectedRedirectResponseCode", Field: "test.expected_redirect_response_code"},
+		{ApiField: "tests.headers.name", Field: "test.headers.name"},
+		{ApiField: "tests.headers.value", Field: "test.headers.value"},
+		{ApiField: "tests.host", Field: "test.host"},
+		{ApiField: "tests.path", Field: "test.path"},
+		{ApiField: "tests.service", Field: "test.service"},
+	},
+	"google_compute_vpn_gateway": {
+		{ApiField: "id", Field: "gateway_id"},
+	},
+	"google_compute_vpn_tunnel": {
+		{ApiField: "sharedSecret", Field: "shared_secret_wo"},
+		{ApiField: "id", Field: "tunnel_id"},
+	},
+	"google_compute_wire_group": {
+		{ApiField: "endpoints.key", Field: "endpoints.endpoint"},
+		{ApiField: "endpoints.value.interconnects.key", Field: "endpoints.interconnects.interconnect_name"},
+		{ApiField: "endpoints.value.interconnects.value.interconnect", Field: "endpoints.interconnects.interconnect"},
+		{ApiField: "endpoints.value.interconnects.value.vlanTags", Field: "endpoints.interconnects.vlan_tags"},
+	},
+	"google_container_cluster": {
+		{ApiField: "addonsConfig.cloudRunConfig.disabled", Field: "addons_config.cloudrun_config.disabled"},
+		{ApiField: "addonsConfig.cloudRunConfig.loadBalancerType", Field: "addons_config.cloudrun_config.load_balancer_type"},
+		{ApiField: "autopilot.workloadPolicyConfig.allowNetAdmin", Field: "allow_net_admin"},
+		{ApiField: "autopilot.privilegedAdmissionConfig.allowlistPaths", Field: "autopilot_privileged_admission"},
+		{ApiField: "autopilot.clusterPolicyConfig.noSystemMutation", Field: "autopilot_cluster_policy_config.no_system_mutation"},
+		{ApiField: "autopilot.clusterPolicyConfig.noSystemImpersonation", Field: "autopilot_cluster_policy_config.no_system_impersonation"},
+		{ApiField: "autopilot.clusterPolicyConfig.noUnsafeWebhooks", Field: "autopilot_cluster_policy_config.no_unsafe_webhooks"},
+		{ApiField: "autopilot.clusterPolicyConfig.noStandardNodePools", Field: "autopilot_cluster_policy_config.no_standard_node_pools"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.bootDiskKmsKey", Field: "cluster_autoscaling.auto_provisioning_defaults.boot_disk_kms_key"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.diskSizeGb", Field: "cluster_autoscaling.auto_provisioning_defaults.disk_size"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.diskType", Field: "cluster_autoscaling.auto_provisioning_defaults.disk_type"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.imageType", Field: "cluster_autoscaling.auto_provisioning_defaults.image_type"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.management.autoRepair", Field: "cluster_autoscaling.auto_provisioning_defaults.management.auto_repair"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.management.autoUpgrade", Field: "cluster_autoscaling.auto_provisioning_defaults.management.auto_upgrade"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.management.upgradeOptions.autoUpgradeStartTime", Field: "cluster_autoscaling.auto_provisioning_defaults.management.upgrade_options.auto_upgrade_start_time"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.management.upgradeOptions.description", Field: "cluster_autoscaling.auto_provisioning_defaults.management.upgrade_options.description"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.minCpuPlatform", Field: "cluster_autoscaling.auto_provisioning_defaults.min_cpu_platform"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.oauthScopes", Field: "cluster_autoscaling.auto_provisioning_defaults.oauth_scopes"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.serviceAccount", Field: "cluster_autoscaling.auto_provisioning_defaults.service_account"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.shieldedInstanceConfig.enableIntegrityMonitoring", Field: "cluster_autoscaling.auto_provisioning_defaults.shielded_instance_config.enable_integrity_monitoring"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.shieldedInstanceConfig.enableSecureBoot", Field: "cluster_autoscaling.auto_provisioning_defaults.shielded_instance_config.enable_secure_boot"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.blueGreenSettings.nodePoolSoakDuration", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.blue_green_settings.node_pool_soak_duration"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchNodeCount", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_node_count"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchPercentage", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_percentage"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchSoakDuration", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_soak_duration"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.maxSurge", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.max_surge"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.maxUnavailable", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.max_unavailable"},
+		{ApiField: "autoscaling.autoprovisioningNodePoolDefaults.upgradeSettings.strategy", Field: "cluster_autoscaling.auto_provisioning_defaults.upgrade_settings.strategy"},
+		{ApiField: "autoscaling.autoprovisioningLocations", Field: "cluster_autoscaling.auto_provisioning_locations"},
+		{ApiField: "autoscaling.autoscalingProfile", Field: "cluster_autoscaling.autoscaling_profile"},
+		{ApiField: "autoscaling.defaultComputeClassConfig.enabled", Field: "cluster_autoscaling.default_compute_class_enabled"},
+		{ApiField: "autoscaling.enableNodeAutoprovisioning", Field: "cluster_autoscaling.enabled"},
+		{ApiField: "autoscaling.resourceLimits.maximum", Field: "cluster_autoscaling.resource_limits.maximum"},
+		{ApiField: "autoscaling.resourceLimits.minimum", Field: "cluster_autoscaling.resource_limits.minimum"},
+		{ApiField: "autoscaling.resourceLimits.resourceType", Field: "cluster_autoscaling.resource_limits.resource_type"},
+		{ApiField: "networkConfig.datapathProvider", Field: "datapath_provider"},
+		{ApiField: "defaultMaxPodsConstraint.maxPodsPerNode", Field: "default_max_pods_per_node"},
+		{ApiField: "networkConfig.defaultSnatStatus.disabled", Field: "default_snat_status.disabled"},
+		{ApiField: "networkConfig.dnsConfig.additiveVpcScopeDnsDomain", Field: "dns_config.additive_vpc_scope_dns_domain"},
+		{ApiField: "networkConfig.dnsConfig.clusterDns", Field: "dns_config.cluster_dns"},
+		{ApiField: "networkConfig.dnsConfig.clusterDnsDomain", Field: "dns_config.cluster_dns_domain"},
+		{ApiField: "networkConfig.dnsConfig.clusterDnsScope", Field: "dns_config.cluster_dns_scope"},
+		{ApiField: "autopilot.enabled", Field: "enable_autopilot"},
+		{ApiField: "networkConfig.enableCiliumClusterwideNetworkPolicy", Field: "enable_cilium_clusterwide_network_policy"},
+		{ApiField: "networkConfig.enableFqdnNetworkPolicy", Field: "enable_fqdn_network_policy"},
+		{ApiField: "networkConfig.enableIntraNodeVisibility", Field: "enable_intranode_visibility"},
+		{ApiField: "networkConfig.enableL4ilbSubsetting", Field: "enable_l4_ilb_subsetting"},
+		{ApiField: "networkConfig.disableL4LbFirewallReconciliation", Field: "disable_l4_lb_firewall_reconciliation"},
+		{ApiField: "legacyAbac.enabled", Field: "enable_legacy_abac"},
+		{ApiField: "networkConfig.enableMultiNetworking", Field: "enable_multi_networking"},
+		{ApiField: "shieldedNodes.enabled", Field: "enable_shielded_nodes"},
+		{ApiField: "networkConfig.gatewayApiConfig.channel", Field: "gateway_api_config.channel"},
+		{ApiField: "networkConfig.inTransitEncryptionConfig", Field: "in_transit_encryption_config"},
+		{ApiField: "ipAllocationPolicy.podCidrOverprovisionConfig.disable", Field: "ip_allocation_policy.pod_cidr_overprovision_config.disabled"},
+		{ApiField: "loggingConfig.componentConfig.enableComponents", Field: "logging_config.enable_components"},
+		{ApiField: "maintenancePolicy.window.dailyMaintenanceWindow.duration", Field: "maintenance_policy.daily_maintenance_window.duration"},
+		{ApiField: "maintenancePolicy.window.dailyMaintenanceWindow.startTime", Field: "maintenance_policy.daily_maintenance_window.start_time"},
+		{ApiField: "maintenancePolicy.window.maintenanceExclusions.value.endTime", Field: "maintenance_policy.maintenance_exclusion.end_time"},
+		{ApiField: "maintenancePolicy.window.maintenanceExclusions.key", Field: "maintenance_policy.maintenance_exclusion.exclusion_name"},
+		{ApiField: "maintenancePolicy.window.maintenanceExclusions.value.maintenanceExclusionOptions.endTimeBehavior", Field: "maintenance_policy.maintenance_exclusion.exclusion_options.end_time_behavior"},
+		{ApiField: "maintenancePolicy.window.maintenanceExclusions.value.maintenanceExclusionOptions.scope", Field: "maintenance_policy.maintenance_exclusion.exclusion_options.scope"},
+		{ApiField: "maintenancePolicy.window.maintenanceExclusions.value.startTime", Field: "maintenance_policy.maintenance_exclusion.start_time"},
+		{ApiField: "maintenancePolicy.window.recurringWindow.window.endTime", Field: "maintenance_policy.recurring_window.end_time"},
+		{ApiField: "maintenancePolicy.window.recurringWindow.recurrence", Field: "maintenance_policy.recurring_window.recurrence"},
+		{ApiField: "maintenancePolicy.window.recurringWindow.window.startTime", Field: "maintenance_policy.recurring_window.start_time"},
+		{ApiField: "currentMasterVersion", Field: "master_version"},
+		{ApiField: "initialClusterVersion", Field: "min_master_version"},
+		{ApiField: "monitoringConfig.componentConfig.enableComponents", Field: "monitoring_config.enable_components"},
+		{ApiField: "monitoringConfig.managedPrometheusConfig.autoMonitoringConfig.scope", Field: "monitoring_config.managed_prometheus.auto_monitoring_config.scope"},
+		{ApiField: "monitoringConfig.managedPrometheusConfig.enabled", Field: "monitoring_config.managed_prometheus.enabled"},
+		{ApiField: "networkConfig.networkPerformanceConfig.totalEgressBandwidthTier", Field: "network_performance_config.total_egress_bandwidth_tier"},
+		{ApiField: "ipAllocationPolicy.useIpAliases", Field: "networking_mode"},
+		{ApiField: "nodeConfig.accelerators.acceleratorCount", Field: "node_config.guest_accelerator.count"},
+		{ApiField: "nodeConfig.accelerators.gpuDriverInstallationConfig.gpuDriverVersion", Field: "node_config.guest_accelerator.gpu_driver_installation_config.gpu_driver_version"},
+		{ApiField: "nodeConfig.accelerators.gpuPartitionSize", Field: "node_config.guest_accelerator.gpu_partition_size"},
+		{ApiField: "nodeConfig.accelerators.gpuSharingConfig.gpuSharingStrategy", Field: "node_config.guest_accelerator.gpu_sharing_config.gpu_sharing_strategy"},
+		{ApiField: "nodeConfig.accelerators.gpuSharingConfig.maxSharedClientsPerGpu", Field: "node_config.guest_accelerator.gpu_sharing_config.max_shared_clients_per_gpu"},
+		{ApiField: "nodeConfig.accelerators.acceleratorType", Field: "node_config.guest_accelerator.type"},
+		{ApiField: "nodeConfig.linuxNodeConfig.hugepages.hugepageSize1g", Field: "node_config.linux_node_config.hugepages_config.hugepage_size_1g"},
+		{ApiField: "nodeConfig.linuxNodeConfig.hugepages.hugepageSize2m", Field: "node_config.linux_node_config.hugepages_config.hugepage_size_2m"},
+		{ApiField: "nodeConfig.loggingConfig.variantConfig.variant", Field: "node_config.logging_variant"},
+		{ApiField: "nodeConfig.resourceManagerTags.tags", Field: "node_config.resource_manager_tags"},
+		{ApiField: "nodeConfig.soleTenantConfig.nodeAffinities.key", Field: "node_config.sole_tenant_config.node_affinity.key"},
+		{ApiField: "nodeConfig.soleTenantConfig.nodeAffinities.operator", Field: "node_config.sole_tenant_config.node_affinity.operator"},
+		{ApiField: "nodeConfig.soleTenantConfig.nodeAffinities.values", Field: "node_config.sole_tenant_config.node_affinity.values"},
+		{ApiField: "nodeConfig.taints.effect", Field: "node_config.taint.effect"},
+		{ApiField: "nodeConfig.taints.key", Field: "node_config.taint.key"},
+		{ApiField: "nodeConfig.taints.value", Field: "node_config.taint.value"},
+		{ApiField: "nodeConfig.windowsNodeConfig.osVersion", Field: "node_config.windows_node_config.osversion"},
+		{ApiField: "locations", Field: "node_locations"},
+		{ApiField: "nodePools.autoscaling.locationPolicy", Field: "node_pool.autoscaling.location_policy"},
+		{ApiField: "nodePools.autoscaling.maxNodeCount", Field: "node_pool.autoscaling.max_node_count"},
+		{ApiField: "nodePools.autoscaling.minNodeCount", Field: "node_pool.autoscaling.min_node_count"},
+		{ApiField: "nodePools.autoscaling.totalMaxNodeCount", Field: "node_pool.autoscaling.total_max_node_count"},
+		{ApiField: "nodePools.autoscaling.totalMinNodeCount", Field: "node_pool.autoscaling.total_min_node_count"},
+		{ApiField: "nodePools.initialNodeCount", Field: "node_pool.initial_node_count"},
+		{ApiField: "nodePools.instanceGroupUrls", Field: "node_pool.instance_group_urls"},
+		{ApiField: "nodePools.management.autoRepair", Field: "node_pool.management.auto_repair"},
+		{ApiField: "nodePools.management.autoUpgrade", Field: "node_pool.management.auto_upgrade"},
+		{ApiField: "nodePools.maxPodsConstraint.maxPodsPerNode", Field: "node_pool.max_pods_per_node"},
+		{ApiField: "nodePools.name", Field: "node_pool.name"},
+		{ApiField: "nodePools.networkConfig.acceleratorNetworkProfile", Field: "node_pool.network_config.accelerator_network_profile"},
+		{ApiField: "nodePools.networkConfig.additionalNodeNetworkConfigs.network", Field: "node_pool.network_config.additional_node_network_configs.network"},
+		{ApiField: "nodePools.networkConfig.additionalNodeNetworkConfigs.subnetwork", Field: "node_pool.network_config.additional_node_network_configs.subnetwork"},
+		{ApiField: "nodePools.networkConfig.additionalPodNetworkConfigs.maxPodsPerNode.maxPodsPerNode", Field: "node_pool.network_config.additional_pod_network_configs.max_pods_per_node"},
+		{ApiField: "nodePools.networkConfig.additionalPodNetworkConfigs.secondaryPodRange", Field: "node_pool.network_config.additional_pod_network_configs.secondary_pod_range"},
+		{ApiField: "nodePools.networkConfig.additionalPodNetworkConfigs.subnetwork", Field: "node_pool.network_config.additional_pod_network_configs.subnetwork"},
+		{ApiField: "nodePools.networkConfig.createPodRange", Field: "node_pool.network_config.create_pod_range"},
+		{ApiField: "nodePools.networkConfig.enablePrivateNodes", Field: "node_pool.network_config.enable_private_nodes"},
+		{ApiField: "nodePools.networkConfig.networkPerformanceConfig.totalEgressBandwidthTier", Field: "node_pool.network_config.network_performance_config.total_egress_bandwidth_tier"},
+		{ApiField: "nodePools.networkConfig.podCidrOverprovisionConfig.disable", Field: "node_pool.network_config.pod_cidr_overprovision_config.disabled"},
+		{ApiField: "nodePools.networkConfig.podIpv4CidrBlock", Field: "node_pool.network_config.pod_ipv4_cidr_block"},
+		{ApiField: "nodePools.networkConfig.podRange", Field: "node_pool.network_config.pod_range"},
+		{ApiField: "nodePools.networkConfig.subnetwork", Field: "node_pool.network_config.subnetwork"},
+		{ApiField: "nodePools.config.advancedMachineFeatures.enableNestedVirtualization", Field: "node_pool.node_config.advanced_machine_features.enable_nested_virtualization"},
+		{ApiField: "nodePools.config.advancedMachineFeatures.performanceMonitoringUnit", Field: "node_pool.node_config.advanced_machine_features.performance_monitoring_unit"},
+		{ApiField: "nodePools.config.advancedMachineFeatures.threadsPerCore", Field: "node_pool.node_config.advanced_machine_features.threads_per_core"},
+		{ApiField: "nodePools.config.bootDiskKmsKey", Field: "node_pool.node_config.boot_disk_kms_key"},
+		{ApiField: "nodePools.config.bootDisk.diskType", Field: "node_pool.node_config.boot_disk.disk_type"},
+		{ApiField: "nodePools.config.bootDisk.provisionedIops", Field: "node_pool.node_config.boot_disk.provisioned_iops"},
+		{ApiField: "nodePools.config.bootDisk.provisionedThroughput", Field: "node_pool.node_config.boot_disk.provisioned_throughput"},
+		{ApiField: "nodePools.config.bootDisk.sizeGb", Field: "node_pool.node_config.boot_disk.size_gb"},
+		{ApiField: "nodePools.config.confidentialNodes.enabled", Field: "node_pool.node_config.confidential_nodes.enabled"},
+		{ApiField: "nodePools.config.confidentialNodes.confidentialInstanceType", Field: "node_pool.node_config.confidential_nodes.confidential_instance_type"},
+		{ApiField: "nodePools.config.containerdConfig.privateRegistryAccessConfig.certificateAuthorityDomainConfig.fqdns", Field: "node_pool.node_config.containerd_config.private_registry_access_config.certificate_authority_domain_config.fqdns"},
+		{ApiField: "nodePools.config.containerdConfig.privateRegistryAccessConfig.certificateAuthorityDomainConfig.gcpSecretManagerCertificateConfig.secretUri", Field: "node_pool.node_config.containerd_config.private_registry_access_config.certificate_authority_domain_config.gcp_secret_manager_certificate_config.secret_uri"},
+		{ApiField: "nodePools.config.containerdConfig.privateRegistryAccessConfig.enabled", Field: "node_pool.node_config.containerd_config.private_registry_access_config.enabled"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.ca.gcpSecretManagerSecretUri", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.ca.gcp_secret_manager_secret_uri"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.capabilities", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.capabilities"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.client.cert.gcpSecretManagerSecretUri", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.client.cert.gcp_secret_manager_secret_uri"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.client.key.gcpSecretManagerSecretUri", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.client.key.gcp_secret_manager_secret_uri"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.dialTimeout", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.dial_timeout"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.header.key", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.header.key"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.header.value", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.header.value"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.host", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.host"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.hosts.overridePath", Field: "node_pool.node_config.containerd_config.registry_hosts.hosts.override_path"},
+		{ApiField: "nodePools.config.containerdConfig.registryHosts.server", Field: "node_pool.node_config.containerd_config.registry_hosts.server"},
+		{ApiField: "nodePools.config.containerdConfig.writableCgroups.enabled", Field: "node_pool.node_config.containerd_config.writable_cgroups.enabled"},
+		{ApiField: "nodePools.config.diskSizeGb", Field: "node_pool.node_config.disk_size_gb"},
+		{ApiField: "nodePools.config.diskType", Field: "node_pool.node_config.disk_type"},
+		{ApiField: "nodePools.config.enableConfidentialStorage", Field: "node_pool.node_config.enable_confidential_storage"},
+		{ApiField: "nodePools.config.ephemeralStorageLocalSsdConfig.dataCacheCount", Field: "node_pool.node_config.ephemeral_storage_local_ssd_config.data_cache_count"},
+		{ApiField: "nodePools.config.ephemeralStorageLocalSsdConfig.localSsdCount", Field: "node_pool.node_config.ephemeral_storage_local_ssd_config.local_ssd_count"},
+		{ApiField: "nodePools.config.fastSocket.enabled", Field: "node_pool.node_config.fast_socket.enabled"},
+		{ApiField: "nodePools.config.flexStart", Field: "node_pool.node_config.flex_start"},
+		{ApiField: "nodePools.config.gcfsConfig.enabled", Field: "node_pool.node_config.gcfs_config.enabled"},
+		{ApiField: "nodePools.config.accelerators.acceleratorCount", Field: "node_pool.node_config.guest_accelerator.count"},
+		{ApiField: "nodePools.config.accelerators.gpuDriverInstallationConfig.gpuDriverVersion", Field: "node_pool.node_config.guest_accelerator.gpu_driver_installation_config.gpu_driver_version"},
+		{ApiField: "nodePools.config.accelerators.gpuPartitionSize", Field: "node_pool.node_config.guest_accelerator.gpu_partition_size"},
+		{ApiField: "nodePools.config.accelerators.gpuSharingConfig.gpuSharingStrategy", Field: "node_pool.node_config.guest_accelerator.gpu_sharing_config.gpu_sharing_strategy"},
+		{ApiField: "nodePools.config.accelerators.gpuSharingConfig.maxSharedClientsPerGpu", Field: "node_pool.node_config.guest_accelerator.gpu_sharing_config.max_shared_clients_per_gpu"},
+		{ApiField: "nodePools.config.accelerators.acceleratorType", Field: "node_pool.node_config.guest_accelerator.type"},
+		{ApiField: "nodePools.config.gvnic.enabled", Field: "node_pool.node_config.gvnic.enabled"},
+		{ApiField: "nodePools.config.hostMaintenancePolicy.maintenanceInterval", Field: "node_pool.node_config.host_maintenance_policy.maintenance_interval"},
+		{ApiField: "nodePools.config.imageType", Field: "node_pool.node_config.image_type"},
+		{ApiField: "nodePools.config.kubeletConfig.allowedUnsafeSysctls", Field: "node_pool.node_config.kubelet_config.allowed_unsafe_sysctls"},
+		{ApiField: "nodePools.config.kubeletConfig.containerLogMaxFiles", Field: "node_pool.node_config.kubelet_config.container_log_max_files"},
+		{ApiField: "nodePools.config.kubeletConfig.containerLogMaxSize", Field: "node_pool.node_config.kubelet_config.container_log_max_size"},
+		{ApiField: "nodePools.config.kubeletConfig.cpuCfsQuota", Field: "node_pool.node_config.kubelet_config.cpu_cfs_quota"},
+		{ApiField: "nodePools.config.kubeletConfig.cpuCfsQuotaPeriod", Field: "node_pool.node_config.kubelet_config.cpu_cfs_quota_period"},
+		{ApiField: "nodePools.config.kubeletConfig.cpuManagerPolicy", Field: "node_pool.node_config.kubelet_config.cpu_manager_policy"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMaxPodGracePeriodSeconds", Field: "node_pool.node_config.kubelet_config.eviction_max_pod_grace_period_seconds"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.imagefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.imagefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.imagefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.imagefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.memoryAvailable", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.memory_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.nodefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.nodefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.nodefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.nodefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionMinimumReclaim.pidAvailable", Field: "node_pool.node_config.kubelet_config.eviction_minimum_reclaim.pid_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.imagefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft.imagefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.imagefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_soft.imagefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.memoryAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft.memory_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.nodefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft.nodefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.nodefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_soft.nodefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoft.pidAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft.pid_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.imagefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.imagefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.imagefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.imagefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.memoryAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.memory_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.nodefsAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.nodefs_available"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.nodefsInodesFree", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.nodefs_inodes_free"},
+		{ApiField: "nodePools.config.kubeletConfig.evictionSoftGracePeriod.pidAvailable", Field: "node_pool.node_config.kubelet_config.eviction_soft_grace_period.pid_available"},
+		{ApiField: "nodePools.config.kubeletConfig.imageGcHighThresholdPercent", Field: "node_pool.node_config.kubelet_config.image_gc_high_threshold_percent"},
+		{ApiField: "nodePools.config.kubeletConfig.imageGcLowThresholdPercent", Field: "node_pool.node_config.kubelet_config.image_gc_low_threshold_percent"},
+		{ApiField: "nodePools.config.kubeletConfig.imageMaximumGcAge", Field: "node_pool.node_config.kubelet_config.image_maximum_gc_age"},
+		{ApiField: "nodePools.config.kubeletConfig.imageMinimumGcAge", Field: "node_pool.node_config.kubelet_config.image_minimum_gc_age"},
+		{ApiField: "nodePools.config.kubeletConfig.insecureKubeletReadonlyPortEnabled", Field: "node_pool.node_config.kubelet_config.insecure_kubelet_readonly_port_enabled"},
+		{ApiField: "nodePools.config.kubeletConfig.maxParallelImagePulls", Field: "node_pool.node_config.kubelet_config.max_parallel_image_pulls"},
+		{ApiField: "nodePools.config.kubeletConfig.memoryManager.policy", Field: "node_pool.node_config.kubelet_config.memory_manager.policy"},
+		{ApiField: "nodePools.config.kubeletConfig.podPidsLimit", Field: "node_pool.node_config.kubelet_config.pod_pids_limit"},
+		{ApiField: "nodePools.config.kubeletConfig.singleProcessOomKill", Field: "node_pool.node_config.kubelet_config.single_process_oom_kill"},
+		{ApiField: "nodePools.config.kubeletConfig.topologyManager.policy", Field: "node_pool.node_config.kubelet_config.topology_manager.policy"},
+		{ApiField: "nodePools.config.kubeletConfig.topologyManager.scope", Field: "node_pool.node_config.kubelet_config.topology_manager.scope"},
+		{ApiField: "nodePools.config.labels", Field: "node_pool.node_config.labels"},
+		{ApiField: "nodePools.config.linuxNodeConfig.accurateTimeConfig.enablePtpKvmTimeSync", Field: "node_pool.node_config.linux_node_config.accurate_time_config.enable_ptp_kvm_time_sync"},
+		{ApiField: "nodePools.config.linuxNodeConfig.cgroupMode", Field: "node_pool.node_config.linux_node_config.cgroup_mode"},
+		{ApiField: "nodePools.config.linuxNodeConfig.hugepages.hugepageSize1g", Field: "node_pool.node_config.linux_node_config.hugepages_config.hugepage_size_1g"},
+		{ApiField: "nodePools.config.linuxNodeConfig.hugepages.hugepageSize2m", Field: "node_pool.node_config.linux_node_config.hugepages_config.hugepage_size_2m"},
+		{ApiField: "nodePools.config.linuxNodeConfig.nodeKernelModuleLoading.policy", Field: "node_pool.node_config.linux_node_config.node_kernel_module_loading.policy"},
+		{ApiField: "nodePools.config.linuxNodeConfig.sysctls", Field: "node_pool.node_config.linux_node_config.sysctls"},
+		{ApiField: "nodePools.config.linuxNodeConfig.transparentHugepageDefrag", Field: "node_pool.node_config.linux_node_config.transparent_hugepage_defrag"},
+		{ApiField: "nodePools.config.linuxNodeConfig.transparentHugepageEnabled", Field: "node_pool.node_config.linux_node_config.transparent_hugepage_enabled"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.bootDiskProfile.swapSizeGib", Field: "node_pool.node_config.linux_node_config.swap_config.boot_disk_profile.swap_size_gib"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.bootDiskProfile.swapSizePercent", Field: "node_pool.node_config.linux_node_config.swap_config.boot_disk_profile.swap_size_percent"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.dedicatedLocalSsdProfile.diskCount", Field: "node_pool.node_config.linux_node_config.swap_config.dedicated_local_ssd_profile.disk_count"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.enabled", Field: "node_pool.node_config.linux_node_config.swap_config.enabled"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.encryptionConfig.disabled", Field: "node_pool.node_config.linux_node_config.swap_config.encryption_config.disabled"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.ephemeralLocalSsdProfile.swapSizeGib", Field: "node_pool.node_config.linux_node_config.swap_config.ephemeral_local_ssd_profile.swap_size_gib"},
+		{ApiField: "nodePools.config.linuxNodeConfig.swapConfig.ephemeralLocalSsdProfile.swapSizePercent", Field: "node_pool.node_config.linux_node_config.swap_config.ephemeral_local_ssd_profile.swap_size_percent"},
+		{ApiField: "nodePools.config.localNvmeSsdBlockConfig.localSsdCount", Field: "node_pool.node_config.local_nvme_ssd_block_config.local_ssd_count"},
+		{ApiField: "nodePools.config.localSsdCount", Field: "node_pool.node_config.local_ssd_count"},
+		{ApiField: "nodePools.config.localSsdEncryptionMode", Field: "node_pool.node_config.local_ssd_encryption_mode"},
+		{ApiField: "nodePools.config.loggingConfig.variantConfig.variant", Field: "node_pool.node_config.logging_variant"},
+		{ApiField: "nodePools.config.machineType", Field: "node_pool.node_config.machine_type"},
+		{ApiField: "nodePools.config.maxRunDuration", Field: "node_pool.node_config.max_run_duration"},
+		{ApiField: "nodePools.config.metadata", Field: "node_pool.node_config.metadata"},
+		{ApiField: "nodePools.config.minCpuPlatform", Field: "node_pool.node_config.min_cpu_platform"},
+		{ApiField: "nodePools.config.nodeGroup", Field: "node_pool.node_config.node_group"},
+		{ApiField: "nodePools.config.oauthScopes", Field: "node_pool.node_config.oauth_scopes"},
+		{ApiField: "nodePools.config.preemptible", Field: "node_pool.node_config.preemptible"},
+		{ApiField: "nodePools.config.reservationAffinity.consumeReservationType", Field: "node_pool.node_config.reservation_affinity.consume_reservation_type"},
+		{ApiField: "nodePools.config.reservationAffinity.key", Field: "node_pool.node_config.reservation_affinity.key"},
+		{ApiField: "nodePools.config.reservationAffinity.values", Field: "node_pool.node_config.reservation_affinity.values"},
+		{ApiField: "nodePools.config.resourceLabels", Field: "node_pool.node_config.resource_labels"},
+		{ApiField: "nodePools.config.resourceManagerTags.tags", Field: "node_pool.node_config.resource_manager_tags"},
+		{ApiField: "nodePools.config.sandboxConfig.type", Field: "node_pool.node_config.sandbox_config.type"},
+		{ApiField: "nodePools.config.secondaryBootDisks.diskImage", Field: "node_pool.node_config.secondary_boot_disks.disk_image"},
+		{ApiField: "nodePools.config.secondaryBootDisks.mode", Field: "node_pool.node_config.secondary_boot_disks.mode"},
+		{ApiField: "nodePools.config.serviceAccount", Field: "node_pool.node_config.service_account"},
+		{ApiField: "nodePools.config.shieldedInstanceConfig.enableIntegrityMonitoring", Field: "node_pool.node_config.shielded_instance_config.enable_integrity_monitoring"},
+		{ApiField: "nodePools.config.shieldedInstanceConfig.enableSecureBoot", Field: "node_pool.node_config.shielded_instance_config.enable_secure_boot"},
+		{ApiField: "nodePools.config.soleTenantConfig.minNodeCpus", Field: "node_pool.node_config.sole_tenant_config.min_node_cpus"},
+		{ApiField: "nodePools.config.soleTenantConfig.nodeAffinities.key", Field: "node_pool.node_config.sole_tenant_config.node_affinity.key"},
+		{ApiField: "nodePools.config.soleTenantConfig.nodeAffinities.operator", Field: "node_pool.node_config.sole_tenant_config.node_affinity.operator"},
+		{ApiField: "nodePools.config.soleTenantConfig.nodeAffinities.values", Field: "node_pool.node_config.sole_tenant_config.node_affinity.values"},
+		{ApiField: "nodePools.config.spot", Field: "node_pool.node_config.spot"},
+		{ApiField: "nodePools.config.storagePools", Field: "node_pool.node_config.storage_pools"},
+		{ApiField: "nodePools.config.tags", Field: "node_pool.node_config.tags"},
+		{ApiField: "nodePools.config.taints.effect", Field: "node_pool.node_config.taint.effect"},
+		{ApiField: "nodePools.config.taints.key", Field: "node_pool.node_config.taint.key"},
+		{ApiField: "nodePools.config.taints.value", Field: "node_pool.node_config.taint.value"},
+		{ApiField: "nodePools.config.windowsNodeConfig.osVersion", Field: "node_pool.node_config.windows_node_config.osversion"},
+		{ApiField: "nodePools.config.workloadMetadataConfig.mode", Field: "node_pool.node_config.workload_metadata_config.mode"},
+		{ApiField: "nodePools.nodeDrainConfig.respectPdbDuringNodePoolDeletion", Field: "node_pool.node_drain_config.respect_pdb_during_node_pool_deletion"},
+		{ApiField: "nodePools.locations", Field: "node_pool.node_locations"},
+		{ApiField: "nodePools.placementPolicy.policyName", Field: "node_pool.placement_policy.policy_name"},
+		{ApiField: "nodePools.placementPolicy.tpuTopology", Field: "node_pool.placement_policy.tpu_topology"},
+		{ApiField: "nodePools.placementPolicy.type", Field: "node_pool.placement_policy.type"},
+		{ApiField: "nodePools.queuedProvisioning.enabled", Field: "node_pool.queued_provisioning.enabled"},
+		{ApiField: "nodePools.upgradeSettings.blueGreenSettings.nodePoolSoakDuration", Field: "node_pool.upgrade_settings.blue_green_settings.node_pool_soak_duration"},
+		{ApiField: "nodePools.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchNodeCount", Field: "node_pool.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_node_count"},
+		{ApiField: "nodePools.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchPercentage", Field: "node_pool.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_percentage"},
+		{ApiField: "nodePools.upgradeSettings.blueGreenSettings.standardRolloutPolicy.batchSoakDuration", Field: "node_pool.upgrade_settings.blue_green_settings.standard_rollout_policy.batch_soak_duration"},
+		{ApiField: "nodePools.upgradeSettings.maxSurge", Field: "node_pool.upgrade_settings.max_surge"},
+		{ApiField: "nodePools.upgradeSettings.maxUnavailable", Field: "node_pool.upgrade_settings.max_unavailable"},
+		{ApiField: "nodePools.upgradeSettings.strategy", Field: "node_pool.upgrade_settings.strategy"},
+		{ApiField: "nodePools.version", Field: "node_pool.version"},
+		{ApiField: "nodePoolAutoConfig.resourceManagerTags.tags", Field: "node_pool_auto_config.resource_manager_tags"},
+		{ApiField: "nodePoolDefaults.nodeConfigDefaults.nodeKubeletConfig.insecureKubeletReadonlyPortEnabled", Field: "node_pool_defaults.node_config_defaults.insecure_kubelet_readonly_port_enabled"},
+		{ApiField: "nodePoolDefaults.nodeConfigDefaults.loggingConfig.variantConfig.variant", Field: "node_pool_defaults.node_config_defaults.logging_variant"},
+		{ApiField: "currentNodeVersion", Field: "node_version"},
+		{ApiField: "networkConfig.privateIpv6GoogleAccess", Field: "private_ipv6_google_access"},
+		{ApiField: "resourceUsageExportConfig.consumptionMeteringConfig.enabled", Field: "resource_usage_export_config.enable_resource_consumption_metering"},
+		{ApiField: "networkConfig.serviceExternalIpsConfig.enabled", Field: "service_external_ips_config.enabled"},
+	},
+	"google_container_node_pool": {
+		{ApiField: "maxPodsConstraint.maxPodsPerNode", Field: "max_pods_per_node"},
+		{ApiField: "networkConfig.additionalPodNetworkConfigs.maxPodsPerNode.maxPodsPerNode", Field: "network_config.additional_pod_network_configs.max_pods_per_node"},
+		{ApiField: "networkConfig.podCidrOverprovisionConfig.disable", Field: "network_config.pod_cidr_overprovision_config.disabled"},
+		{ApiField: "config.advancedMachineFeatures.enableNestedVirtualization", Field: "node_config.advanced_machine_features.enable_nested_virtualization"},
+		{ApiField: "config.advancedMachineFeatures.performanceMonitoringUnit", Field: "node_config.advanced_machine_features.performance_monitoring_unit"},
+		{ApiField: "config.advancedMachineFeatures.threadsPerCore", Field: "node_config.advanced_machine_features.threads_per_core"},
+		{ApiField: "config.bootDiskKmsKey", Field: "node_config.boot_disk_kms_key"},
+		{ApiField: "config.bootDisk.diskType", Field: "node_config.boot_disk.disk_type"},
+		{ApiField: "config.bootDisk.provisionedIops", Field: "node_config.boot_disk.provisioned_iops"},
+		{ApiField: "config.bootDisk.provisionedThroughput", Field: "node_config.boot_disk.provisioned_throughput"},
+		{ApiField: "config.bootDisk.sizeGb", Field: "node_config.boot_disk.size_gb"},
+		{ApiField: "config.confidentialNodes.enabled", Field: "node_config.confidential_nodes.enabled"},
+		{ApiField: "config.confidentialNodes.confidentialInstanceType", Field: "node_config.confidential_nodes.confidential_instance_type"},
+		{ApiField: "config.containerdConfig.privateRegistryAccessConfig.certificateAuthorityDomainConfig.fqdns", Field: "node_config.containerd_config.private_registry_access_config.certificate_authority_domain_config.fqdns"},
+		{ApiField: "config.containerdConfig.privateRegistryAccessConfig.certificateAuthorityDomainConfig.gcpSecretManagerCertificateConfig.secretUri", Field: "node_config.containerd_config.private_registry_access_config.certificate_authority_domain_config.gcp_secret_manager_certificate_config.secret_uri"},
+		{ApiField: "config.containerdConfig.privateRegistryAccessConfig.enabled", Field: "node_config.containerd_config.private_registry_access_config.enabled"},
+		{ApiField: "config.containerdConfig.writableCgroups.enabled", Field: "node_config.containerd_config.writable_cgroups.enabled"},
+		{ApiField: "config.containerdConfig.registryHosts.server", Field: "node_config.containerd_config.registry_hosts.server"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.host", Field: "node_config.containerd_config.registry_hosts.hosts.host"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.capabilities", Field: "node_config.containerd_config.registry_hosts.hosts.capabilities"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.overridePath", Field: "node_config.containerd_config.registry_hosts.hosts.override_path"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.header.key", Field: "node_config.containerd_config.registry_hosts.hosts.header.key"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.header.value", Field: "node_config.containerd_config.registry_hosts.hosts.header.value"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.ca.gcpSecretManagerSecretUri", Field: "node_config.containerd_config.registry_hosts.hosts.ca.gcp_secret_manager_secret_uri"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.client.cert.gcpSecretManagerSecretUri", Field: "node_config.containerd_config.registry_hosts.hosts.client.cert.gcp_secret_manager_secret_uri"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.client.key.gcpSecretManagerSecretUri", Field: "node_config.containerd_config.registry_hosts.hosts.client.key.gcp_secret_manager_secret_uri"},
+		{ApiField: "config.containerdConfig.registryHosts.hosts.dialTimeout", Field: "node_config.containerd_config.registry_hosts.hosts.dial_timeout"},
+		{ApiField: "config.diskSizeGb", Field: "node_config.disk_size_gb"},
+		{ApiField: "config.diskType", Field: "node_config.disk_type"},
+		{ApiField: "config.enableConfidentialStorage", Field: "node_config.enable_confidential_storage"},
+		{ApiField: "config.ephemeralStorageLocalSsdConfig.dataCacheCount", Field: "node_config.ephemeral_storage_local_ssd_config.data_cache_count"},
+		{ApiField: "config.ephemeralStorageLocalSsdConfig.localSsdCount", Field: "node_config.ephemeral_storage_local_ssd_config.local_ssd_count"},
+		{ApiField: "config.fastSocket.enabled", Field: "node_config.fast_socket.enabled"},
+		{ApiField: "config.flexStart", Field: "node_config.flex_start"},
+		{ApiField: "config.gcfsConfig.enabled", Field: "node_config.gcfs_config.enabled"},
+		{ApiField: "config.accelerators.acceleratorCount", Field: "node_config.guest_accelerator.count"},
+		{ApiField: "config.accelerators.gpuDriverInstallationConfig.gpuDriverVersion", Field: "node_config.guest_accelerator.gpu_driver_installation_config.gpu_driver_version"},
+		{ApiField: "config.accelerators.gpuPartitionSize", Field: "node_config.guest_accelerator.gpu_partition_size"},
+		{ApiField: "config.accelerators.gpuSharingConfig.gpuSharingStrategy", Field: "node_config.guest_accelerator.gpu_sharing_config.gpu_sharing_strategy"},
+		{ApiField: "config.accelerators.gpuSharingConfig.maxSharedClientsPerGpu", Field: "node_config.guest_accelerator.gpu_sharing_config.max_shared_clients_per_gpu"},
+		{ApiField: "config.accelerators.acceleratorType", Field: "node_config.guest_accelerator.type"},
+		{ApiField: "config.gvnic.enabled", Field: "node_config.gvnic.enabled"},
+		{ApiField: "config.hostMaintenancePolicy.maintenanceInterval", Field: "node_config.host_maintenance_policy.maintenance_interval"},
+		{ApiField: "config.imageType", Field: "node_config.image_type"},
+		{ApiField: "config.kubeletConfig.allowedUnsafeSysctls", Field: "node_config.kubelet_config.allowed_unsafe_sysctls"},
+		{ApiField: "config.kubeletConfig.containerLogMaxFiles", Field: "node_config.kubelet_config.container_log_max_files"},
+		{ApiField: "config.kubeletConfig.containerLogMaxSize", Field: "node_config.kubelet_config.container_log_max_size"},
+		{ApiField: "config.kubeletConfig.cpuCfsQuota", Field: "node_config.kubelet_config.cpu_cfs_quota"},
+		{ApiField: "config.kubeletConfig.cpuCfsQuotaPeriod", Field: "node_config.kubelet_config.cpu_cfs_quota_period"},
+		{ApiField: "config.kubeletConfig.cpuManagerPolicy", Field: "node_config.kubelet_config.cpu_manager_policy"},
+		{ApiField: "config.kubeletConfig.evictionMaxPodGracePeriodSeconds", Field: "node_config.kubelet_config.eviction_max_pod_grace_period_seconds"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.imagefsAvailable", Field: "node_config.kubelet_config.eviction_minimum_reclaim.imagefs_available"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.imagefsInodesFree", Field: "node_config.kubelet_config.eviction_minimum_reclaim.imagefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.memoryAvailable", Field: "node_config.kubelet_config.eviction_minimum_reclaim.memory_available"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.nodefsAvailable", Field: "node_config.kubelet_config.eviction_minimum_reclaim.nodefs_available"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.nodefsInodesFree", Field: "node_config.kubelet_config.eviction_minimum_reclaim.nodefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionMinimumReclaim.pidAvailable", Field: "node_config.kubelet_config.eviction_minimum_reclaim.pid_available"},
+		{ApiField: "config.kubeletConfig.evictionSoft.imagefsAvailable", Field: "node_config.kubelet_config.eviction_soft.imagefs_available"},
+		{ApiField: "config.kubeletConfig.evictionSoft.imagefsInodesFree", Field: "node_config.kubelet_config.eviction_soft.imagefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionSoft.memoryAvailable", Field: "node_config.kubelet_config.eviction_soft.memory_available"},
+		{ApiField: "config.kubeletConfig.evictionSoft.nodefsAvailable", Field: "node_config.kubelet_config.eviction_soft.nodefs_available"},
+		{ApiField: "config.kubeletConfig.evictionSoft.nodefsInodesFree", Field: "node_config.kubelet_config.eviction_soft.nodefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionSoft.pidAvailable", Field: "node_config.kubelet_config.eviction_soft.pid_available"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.imagefsAvailable", Field: "node_config.kubelet_config.eviction_soft_grace_period.imagefs_available"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.imagefsInodesFree", Field: "node_config.kubelet_config.eviction_soft_grace_period.imagefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.memoryAvailable", Field: "node_config.kubelet_config.eviction_soft_grace_period.memory_available"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.nodefsAvailable", Field: "node_config.kubelet_config.eviction_soft_grace_period.nodefs_available"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.nodefsInodesFree", Field: "node_config.kubelet_config.eviction_soft_grace_period.nodefs_inodes_free"},
+		{ApiField: "config.kubeletConfig.evictionSoftGracePeriod.pidAvailable", Field: "node_config.kubelet_config.eviction_soft_grace_period.pid_available"},
+		{ApiField: "config.kubeletConfig.imageGcHighThresholdPercent", Field: "node_config.kubelet_config.image_gc_high_threshold_percent"},
+		{ApiField: "config.kubeletConfig.imageGcLowThresholdPercent", Field: "node_config.kubelet_config.image_gc_low_threshold_percent"},
+		{ApiField: "config.kubeletConfig.imageMaximumGcAge", Field: "node_config.kubelet_config.image_maximum_gc_age"},
+		{ApiField: "config.kubeletConfig.imageMinimumGcAge", Field: "node_config.kubelet_config.image_minimum_gc_age"},
+		{ApiField: "config.kubeletConfig.insecureKubeletReadonlyPortEnabled", Field: "node_config.kubelet_config.insecure_kubelet_readonly_port_enabled"},
+		{ApiField: "config.kubeletConfig.maxParallelImagePulls", Field: "node_config.kubelet_config.max_parallel_image_pulls"},
+		{ApiField: "config.kubeletConfig.memoryManager.policy", Field: "node_config.kubelet_config.memory_manager.policy"},
+		{ApiField: "config.kubeletConfig.podPidsLimit", Field: "node_config.kubelet_config.pod_pids_limit"},
+		{ApiField: "config.kubeletConfig.singleProcessOomKill", Field: "node_config.kubelet_config.single_process_oom_kill"},
+		{ApiField: "config.kubeletConfig.topologyManager.policy", Field: "node_config.kubelet_config.topology_manager.policy"},
+		{ApiField: "config.kubeletConfig.topologyManager.scope", Field: "node_config.kubelet_config.topology_manager.scope"},
+		{ApiField: "config.labels", Field: "node_config.labels"},
+		{ApiField: "config.linuxNodeConfig.accurateTimeConfig.enablePtpKvmTimeSync", Field: "node_config.linux_node_config.accurate_time_config.enable_ptp_kvm_time_sync"},
+		{ApiField: "config.linuxNodeConfig.cgroupMode", Field: "node_config.linux_node_config.cgroup_mode"},
+		{ApiField: "config.linuxNodeConfig.hugepages.hugepageSize1g", Field: "node_config.linux_node_config.hugepages_config.hugepage_size_1g"},
+		{ApiField: "config.linuxNodeConfig.hugepages.hugepageSize2m", Field: "node_config.linux_node_config.hugepages_config.hugepage_size_2m"},
+		{ApiField: "config.linuxNodeConfig.nodeKernelModuleLoading.policy", Field: "node_config.linux_node_config.node_kernel_module_loading.policy"},
+		{ApiField: "config.linuxNodeConfig.sysctls", Field: "node_config.linux_node_config.sysctls"},
+		{ApiField: "config.linuxNodeConfig.transparentHugepageDefrag", Field: "node_config.linux_node_config.transparent_hugepage_defrag"},
+		{ApiField: "config.linuxNodeConfig.transparentHugepageEnabled", Field: "node_config.linux_node_config.transparent_hugepage_enabled"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.bootDiskProfile.swapSizeGib", Field: "node_config.linux_node_config.swap_config.boot_disk_profile.swap_size_gib"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.bootDiskProfile.swapSizePercent", Field: "node_config.linux_node_config.swap_config.boot_disk_profile.swap_size_percent"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.dedicatedLocalSsdProfile.diskCount", Field: "node_config.linux_node_config.swap_config.dedicated_local_ssd_profile.disk_count"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.enabled", Field: "node_config.linux_node_config.swap_config.enabled"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.encryptionConfig.disabled", Field: "node_config.linux_node_config.swap_config.encryption_config.disabled"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.ephemeralLocalSsdProfile.swapSizeGib", Field: "node_config.linux_node_config.swap_config.ephemeral_local_ssd_profile.swap_size_gib"},
+		{ApiField: "config.linuxNodeConfig.swapConfig.ephemeralLocalSsdProfile.swapSizePercent", Field: "node_config.linux_node_config.swap_config.ephemeral_local_ssd_profile.swap_size_percent"},
+		{ApiField: "config.localNvmeSsdBlockConfig.localSsdCount", Field: "node_config.local_nvme_ssd_block_config.local_ssd_count"},
+		{ApiField: "config.localSsdCount", Field: "node_config.local_ssd_count"},
+		{ApiField: "config.localSsdEncryptionMode", Field: "node_config.local_ssd_encryption_mode"},
+		{ApiField: "config.loggingConfig.variantConfig.variant", Field: "node_config.logging_variant"},
+		{ApiField: "config.machineType", Field: "node_config.machine_type"},
+		{ApiField: "config.maxRunDuration", Field: "node_config.max_run_duration"},
+		{ApiField: "config.metadata", Field: "node_config.metadata"},
+		{ApiField: "config.minCpuPlatform", Field: "node_config.min_cpu_platform"},
+		{ApiField: "config.nodeGroup", Field: "node_config.node_group"},
+		{ApiField: "config.oauthScopes", Field: "node_config.oauth_scopes"},
+		{ApiField: "config.preemptible", Field: "node_config.preemptible"},
+		{ApiField: "config.reservationAffinity.consumeReservationType", Field: "node_config.reservation_affinity.consume_reservation_type"},
+		{ApiField: "config.reservationAffinity.key", Field: "node_config.reservation_affinity.key"},
+		{ApiField: "config.reservationAffinity.values", Field: "node_config.reservation_affinity.values"},
+		{ApiField: "config.resourceLabels", Field: "node_config.resource_labels"},
+		{ApiField: "config.resourceManagerTags.tags", Field: "node_config.resource_manager_tags"},
+		{ApiField: "config.sandboxConfig.type", Field: "node_config.sandbox_config.type"},
+		{ApiField: "config.secondaryBootDisks.diskImage", Field: "node_config.secondary_boot_disks.disk_image"},
+		{ApiField: "config.secondaryBootDisks.mode", Field: "node_config.secondary_boot_disks.mode"},
+		{ApiField: "config.serviceAccount", Field: "node_config.service_account"},
+		{ApiField: "config.shieldedInstanceConfig.enableIntegrityMonitoring", Field: "node_config.shielded_instance_config.enable_integrity_monitoring"},
+		{ApiField: "config.shieldedInstanceConfig.enableSecureBoot", Field: "node_config.shielded_instance_config.enable_secure_boot"},
+		{ApiField: "config.soleTenantConfig.minNodeCpus", Field: "node_config.sole_tenant_config.min_node_cpus"},
+		{ApiField: "config.soleTenantConfig.nodeAffinities.key", Field: "node_config.sole_tenant_config.node_affinity.key"},
+		{ApiField: "config.soleTenantConfig.nodeAffinities.operator", Field: "node_config.sole_tenant_config.node_affinity.operator"},
+		{ApiField: "config.soleTenantConfig.nodeAffinities.values", Field: "node_config.sole_tenant_config.node_affinity.values"},
+		{ApiField: "config.spot", Field: "node_config.spot"},
+		{ApiField: "config.storagePools", Field: "node_config.storage_pools"},
+		{ApiField: "config.tags", Field: "node_config.tags"},
+		{ApiField: "config.taints.effect", Field: "node_config.taint.effect"},
+		{ApiField: "config.taints.key", Field: "node_config.taint.key"},
+		{ApiField: "config.taints.value", Field: "node_config.taint.value"},
+		{ApiField: "config.windowsNodeConfig.osVersion", Field: "node_config.windows_node_config.osversion"},
+		{ApiField: "config.workloadMetadataConfig.mode", Field: "node_config.workload_metadata_config.mode"},
+		{ApiField: "locations", Field: "node_locations"},
+	},
+	"google_data_catalog_tag": {
+		{ApiField: "fields.key", Field: "fields.field_name"},
+		{ApiField: "fields.value.boolValue", Field: "fields.bool_value"},
+		{ApiField: "fields.value.displayName", Field: "fields.display_name"},
+		{ApiField: "fields.value.doubleValue", Field: "fields.double_value"},
+		{ApiField: "fields.value.order", Field: "fields.order"},
+		{ApiField: "fields.value.stringValue", Field: "fields.string_value"},
+		{ApiField: "fields.value.timestampValue", Field: "fields.timestamp_value"},
+		{ApiField: "templateDisplayName", Field: "template_displayname"},
+	},
+	"google_data_catalog_tag_template": {
+		{ApiField: "fields.key", Field: "fields.field_id"},
+		{ApiField: "fields.value.description", Field: "fields.description"},
+		{ApiField: "fields.value.displayName", Field: "fields.display_name"},
+		{ApiField: "fields.value.isRequired", Field: "fields.is_required"},
+		{ApiField: "fields.value.name", Field: "fields.name"},
+		{ApiField: "fields.value.order", Field: "fields.order"},
+		{ApiField: "fields.value.type.enumType.allowedValues.displayName", Field: "fields.type.enum_type.allowed_values.display_name"},
+		{ApiField: "fields.value.type.primitiveType", Field: "fields.type.primitive_type"},
+	},
+	"google_data_lineage_config": {
+		{ApiField: "ingestion.rules.integrationSelector.integration", Field: "ingestion.rule.integration_selector.integration"},
+		{ApiField: "ingestion.rules.lineageEnablement.enabled", Field: "ingestion.rule.lineage_enablement.enabled"},
+	},
+	"google_database_migration_service_connection_profile": {
+		{ApiField: "provider", Field: "dbprovider"},
+	},
+	"google_dataform_folder": {
+		{ApiField: "name", Field: "folder_id"},
+	},
+	"google_dataform_team_folder": {
+		{ApiField: "name", Field: "teamfolder_id"},
+	},
+	"google_dataplex_datascan": {
+		{ApiField: "dataQualitySpec.rules.templateReference.values.key", Field: "data_quality_spec.rules.template_reference.values.name"},
+		{ApiField: "dataQualitySpec.rules.templateReference.values.value.value", Field: "data_quality_spec.rules.template_reference.values.value"},
+	},
+	"google_dataproc_autoscaling_policy": {
+		{ApiField: "id", Field: "policy_id"},
+	},
+	"google_dataproc_metastore_federation": {
+		{ApiField: "backendMetastores.key", Field: "backend_metastores.rank"},
+		{ApiField: "backendMetastores.value.metastoreType", Field: "backend_metastores.metastore_type"},
+		{ApiField: "backendMetastores.value.name", Field: "backend_metastores.name"},
+	},
+	"google_dataproc_metastore_service": {
+		{ApiField: "hiveMetastoreConfig.auxiliaryVersions.value.configOverrides", Field: "hive_metastore_config.auxiliary_versions.config_overrides"},
+		{ApiField: "hiveMetastoreConfig.auxiliaryVersions.value.version", Field: "hive_metastore_config.auxiliary_versions.version"},
+	},
+	"google_deployment_manager_deployment": {
+		{ApiField: "id", Field: "deployment_id"},
+	},
+	"google_developer_connect_insights_config": {
+		{ApiField: "projects.projectIds", Field: "target_projects.project_ids"},
+	},
+	"google_dialogflow_agent": {
+		{ApiField: "avatarUri", Field: "avatar_uri_backend"},
+	},
+	"google_dialogflow_cx_webhook": {
+		{ApiField: "genericWebService.secretVersionsForRequestHeaders.value.secretVersion", Field: "generic_web_service.secret_versions_for_request_headers.secret_version"},
+		{ApiField: "serviceDirectory.genericWebService.secretVersionsForRequestHeaders.value.secretVersion", Field: "service_directory.generic_web_service.secret_versions_for_request_headers.secret_version"},
+	},
+	"google_dialogflow_environment": {
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.key", Field: "text_to_speech_settings.synthesize_speech_configs.language"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.effectsProfileId", Field: "text_to_speech_settings.synthesize_speech_configs.effects_profile_id"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.pitch", Field: "text_to_speech_settings.synthesize_speech_configs.pitch"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.speakingRate", Field: "text_to_speech_settings.synthesize_speech_configs.speaking_rate"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.voice.name", Field: "text_to_speech_settings.synthesize_speech_configs.voice.name"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.voice.ssmlGender", Field: "text_to_speech_settings.synthesize_speech_configs.voice.ssml_gender"},
+		{ApiField: "textToSpeechSettings.synthesizeSpeechConfigs.value.volumeGainDb", Field: "text_to_speech_settings.synthesize_speech_configs.volume_gain_db"},
+	},
+	"google_discovery_engine_data_store": {
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.key", Field: "document_processing_config.parsing_config_overrides.file_type"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.digitalParsingConfig", Field: "document_processing_config.parsing_config_overrides.digital_parsing_config"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.enableImageAnnotation", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.enable_image_annotation"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.enableTableAnnotation", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.enable_table_annotation"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.excludeHtmlClasses", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.exclude_html_classes"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.excludeHtmlElements", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.exclude_html_elements"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.excludeHtmlIds", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.exclude_html_ids"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.layoutParsingConfig.structuredContentTypes", Field: "document_processing_config.parsing_config_overrides.layout_parsing_config.structured_content_types"},
+		{ApiField: "documentProcessingConfig.parsingConfigOverrides.value.ocrParsingConfig.useNativeText", Field: "document_processing_config.parsing_config_overrides.ocr_parsing_config.use_native_text"},
+	},
+	"google_discovery_engine_widget_config": {
+		{ApiField: "uiSettings.dataStoreUiConfigs.fieldsUiComponentsMap.key", Field: "ui_settings.data_store_ui_configs.fields_ui_components_map.ui_component"},
+		{ApiField: "uiSettings.dataStoreUiConfigs.fieldsUiComponentsMap.value.deviceVisibility", Field: "ui_settings.data_store_ui_configs.fields_ui_components_map.device_visibility"},
+		{ApiField: "uiSettings.dataStoreUiConfigs.fieldsUiComponentsMap.value.displayTemplate", Field: "ui_settings.data_store_ui_configs.fields_ui_components_map.display_template"},
+		{ApiField: "uiSettings.dataStoreUiConfigs.fieldsUiComponentsMap.value.field", Field: "ui_settings.data_store_ui_configs.fields_ui_components_map.field"},
+	},
+	"google_dns_managed_zone": {
+		{ApiField: "id", Field: "managed_zone_id"},
+	},
+	"google_document_ai_processor_default_version": {
+		{ApiField: "defaultProcessorVersion", Field: "version"},
+	},
+	"google_eventarc_channel": {
+		{ApiField: "provider", Field: "third_party_provider"},
+	},
+	"google_eventarc_trigger": {
+		{ApiField: "destination.cloudRun.path", Field: "destination.cloud_run_service.path"},
+		{ApiField: "destination.cloudRun.region", Field: "destination.cloud_run_service.region"},
+		{ApiField: "destination.cloudRun.service", Field: "destination.cloud_run_service.service"},
+		{ApiField: "eventFilters.attribute", Field: "matching_criteria.attribute"},
+		{ApiField: "eventFilters.operator", Field: "matching_criteria.operator"},
+		{ApiField: "eventFilters.value", Field: "matching_criteria.value"},
+	},
+	"google_filestore_instance": {
+		{ApiField: "replication.replicas.lastActiveSyncTime", Field: "effective_replication.replicas.last_active_sync_time"},
+		{ApiField: "replication.replicas.peerInstance", Field: "effective_replication.replicas.peer_instance"},
+		{ApiField: "replication.replicas.state", Field: "effective_replication.replicas.state"},
+		{ApiField: "replication.replicas.stateReasons", Field: "effective_replication.replicas.state_reasons"},
+		{ApiField: "replication.role", Field: "effective_replication.role"},
+		{ApiField: "replication.replicas.peerInstance", Field: "initial_replication.replicas.peer_instance"},
+		{ApiField: "replication.role", Field: "initial_replication.role"},
+	},
+	"google_firebase_app_check_debug_token": {
+		{ApiField: "name", Field: "debug_token_id"},
+	},
+	"google_firebase_app_check_resource_policy": {
+		{ApiField: "name", Field: "resource_policy_id"},
+	},
+	"google_firebase_remote_config_remote_config": {
+		{ApiField: "parameterGroups.key", Field: "parameter_groups.parameter_group_name"},
+		{ApiField: "parameterGroups.value.description", Field: "parameter_groups.description"},
+		{ApiField: "parameterGroups.value.parameters.key", Field: "parameter_groups.parameters.parameter_name"},
+		{ApiField: "parameterGroups.value.parameters.value.conditionalValues.key", Field: "parameter_groups.parameters.conditional_values.condition_name"},
+		{ApiField: "parameterGroups.value.parameters.value.conditionalValues.value.useInAppDefault", Field: "parameter_groups.parameters.conditional_values.use_in_app_default"},
+		{ApiField: "parameterGroups.value.parameters.value.conditionalValues.value.value", Field: "parameter_groups.parameters.conditional_values.value"},
+		{ApiField: "parameterGroups.value.parameters.value.defaultValue.useInAppDefault", Field: "parameter_groups.parameters.default_value.use_in_app_default"},
+		{ApiField: "parameterGroups.value.parameters.value.defaultValue.value", Field: "parameter_groups.parameters.default_value.value"},
+		{ApiField: "parameterGroups.value.parameters.value.description", Field: "parameter_groups.parameters.description"},
+		{ApiField: "parameterGroups.value.parameters.value.valueType", Field: "parameter_groups.parameters.value_type"},
+		{ApiField: "parameters.key", Field: "parameters.parameter_name"},
+		{ApiField: "parameters.value.conditionalValues.key", Field: "parameters.conditional_values.condition_name"},
+		{ApiField: "parameters.value.conditionalValues.value.useInAppDefault", Field: "parameters.conditional_values.use_in_app_default"},
+		{ApiField: "parameters.value.conditionalValues.value.value", Field: "parameters.conditional_values.value"},
+		{ApiField: "parameters.value.defaultValue.useInAppDefault", Field: "parameters.default_value.use_in_app_default"},
+		{ApiField: "parameters.value.defaultValue.value", Field: "parameters.default_value.value"},
+		{ApiField: "parameters.value.description", Field: "parameters.description"},
+		{ApiField: "parameters.value.valueType", Field: "parameters.value_type"},
+	},
+	"google_gke_hub_feature": {
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.key", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.component"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.containerResources.limits.cpu", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.container_resources.limits.cpu"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.containerResources.limits.memory", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.container_resources.limits.memory"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.containerResources.requests.cpu", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.container_resources.requests.cpu"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.containerResources.requests.memory", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.container_resources.requests.memory"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.podAffinity", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.pod_affinity"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.podTolerations.effect", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.pod_toleration.effect"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.podTolerations.key", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.pod_toleration.key"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.podTolerations.operator", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.pod_toleration.operator"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.podTolerations.value", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.pod_toleration.value"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.deploymentConfigs.value.replicaCount", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.deployment_configs.replica_count"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.policyContent.bundles.key", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.policy_content.bundles.bundle"},
+		{ApiField: "fleetDefaultMemberConfig.policycontroller.policyControllerHubConfig.policyContent.bundles.value.exemptedNamespaces", Field: "fleet_default_member_config.policycontroller.policy_controller_hub_config.policy_content.bundles.exempted_namespaces"},
+	},
+	"google_hypercomputecluster_cluster": {
+		{ApiField: "computeResources.key", Field: "compute_resources.id"},
+		{ApiField: "computeResources.value.config.newFlexStartInstances.machineType", Field: "compute_resources.config.new_flex_start_instances.machine_type"},
+		{ApiField: "computeResources.value.config.newFlexStartInstances.maxDuration", Field: "compute_resources.config.new_flex_start_instances.max_duration"},
+		{ApiField: "computeResources.value.config.newFlexStartInstances.zone", Field: "compute_resources.config.new_flex_start_instances.zone"},
+		{ApiField: "computeResources.value.config.newOnDemandInstances.machineType", Field: "compute_resources.config.new_on_demand_instances.machine_type"},
+		{ApiField: "computeResources.value.config.newOnDemandInstances.zone", Field: "compute_resources.config.new_on_demand_instances.zone"},
+		{ApiField: "computeResources.value.config.newReservedInstances.reservation", Field: "compute_resources.config.new_reserved_instances.reservation"},
+		{ApiField: "computeResources.value.config.newSpotInstances.machineType", Field: "compute_resources.config.new_spot_instances.machine_type"},
+		{ApiField: "computeResources.value.config.newSpotInstances.terminationAction", Field: "compute_resources.config.new_spot_instances.termination_action"},
+		{ApiField: "computeResources.value.config.newSpotInstances.zone", Field: "compute_resources.config.new_spot_instances.zone"},
+		{ApiField: "networkResources.key", Field: "network_resources.id"},
+		{ApiField: "networkResources.value.config.existingNetwork.network", Field: "network_resources.config.existing_network.network"},
+		{ApiField: "networkResources.value.config.existingNetwork.subnetwork", Field: "network_resources.config.existing_network.subnetwork"},
+		{ApiField: "networkResources.value.config.newNetwork.description", Field: "network_resources.config.new_network.description"},
+		{ApiField: "networkResources.value.config.newNetwork.network", Field: "network_resources.config.new_network.network"},
+		{ApiField: "networkResources.value.network.network", Field: "network_resources.network.network"},
+		{ApiField: "networkResources.value.network.subnetwork", Field: "network_resources.network.subnetwork"},
+		{ApiField: "storageResources.key", Field: "storage_resources.id"},
+		{ApiField: "storageResources.value.bucket.bucket", Field: "storage_resources.bucket.bucket"},
+		{ApiField: "storageResources.value.config.existingBucket.bucket", Field: "storage_resources.config.existing_bucket.bucket"},
+		{ApiField: "storageResources.value.config.existingFilestore.filestore", Field: "storage_resources.config.existing_filestore.filestore"},
+		{ApiField: "storageResources.value.config.existingLustre.lustre", Field: "storage_resources.config.existing_lustre.lustre"},
+		{ApiField: "storageResources.value.config.newBucket.autoclass.enabled", Field: "storage_resources.config.new_bucket.autoclass.enabled"},
+		{ApiField: "storageResources.value.config.newBucket.bucket", Field: "storage_resources.config.new_bucket.bucket"},
+		{ApiField: "storageResources.value.config.newBucket.hierarchicalNamespace.enabled", Field: "storage_resources.config.new_bucket.hierarchical_namespace.enabled"},
+		{ApiField: "storageResources.value.config.newBucket.storageClass", Field: "storage_resources.config.new_bucket.storage_class"},
+		{ApiField: "storageResources.value.config.newFilestore.description", Field: "storage_resources.config.new_filestore.description"},
+		{ApiField: "storageResources.value.config.newFilestore.fileShares.capacityGb", Field: "storage_resources.config.new_filestore.file_shares.capacity_gb"},
+		{ApiField: "storageResources.value.config.newFilestore.fileShares.fileShare", Field: "storage_resources.config.new_filestore.file_shares.file_share"},
+		{ApiField: "storageResources.value.config.newFilestore.filestore", Field: "storage_resources.config.new_filestore.filestore"},
+		{ApiField: "storageResources.value.config.newFilestore.protocol", Field: "storage_resources.config.new_filestore.protocol"},
+		{ApiField: "storageResources.value.config.newFilestore.tier", Field: "storage_resources.config.new_filestore.tier"},
+		{ApiField: "storageResources.value.config.newLustre.capacityGb", Field: "storage_resources.config.new_lustre.capacity_gb"},
+		{ApiField: "storageResources.value.config.newLustre.description", Field: "storage_resources.config.new_lustre.description"},
+		{ApiField: "storageResources.value.config.newLustre.filesystem", Field: "storage_resources.config.new_lustre.filesystem"},
+		{ApiField: "storageResources.value.config.newLustre.lustre", Field: "storage_resources.config.new_lustre.lustre"},
+		{ApiField: "storageResources.value.filestore.filestore", Field: "storage_resources.filestore.filestore"},
+		{ApiField: "storageResources.value.lustre.lustre", Field: "storage_resources.lustre.lustre"},
+	},
+	"google_iam_workload_identity_pool": {
+		{ApiField: "inlineTrustConfig.additionalTrustBundles.key", Field: "inline_trust_config.additional_trust_bundles.trust_domain"},
+		{ApiField: "inlineTrustConfig.additionalTrustBundles.value.trustAnchors.pemCertificate", Field: "inline_trust_config.additional_trust_bundles.trust_anchors.pem_certificate"},
+		{ApiField: "inlineTrustConfig.additionalTrustBundles.value.trustDefaultSharedCa", Field: "inline_trust_config.additional_trust_bundles.trust_default_shared_ca"},
+	},
+	"google_iap_client": {
+		{ApiField: "name", Field: "client_id"},
+	},
+	"google_identity_platform_config": {
+		{ApiField: "blockingFunctions.triggers.key", Field: "blocking_functions.triggers.event_type"},
+		{ApiField: "blockingFunctions.triggers.value.functionUri", Field: "blocking_functions.triggers.function_uri"},
+		{ApiField: "blockingFunctions.triggers.value.updateTime", Field: "blocking_functions.triggers.update_time"},
+	},
+	"google_integration_connectors_connection": {
+		{ApiField: "authConfig.additionalVariables.boolValue", Field: "auth_config.additional_variable.boolean_value"},
+		{ApiField: "authConfig.additionalVariables.encryptionKeyValue.kmsKeyName", Field: "auth_config.additional_variable.encryption_key_value.kms_key_name"},
+		{ApiField: "authConfig.additionalVariables.encryptionKeyValue.type", Field: "auth_config.additional_variable.encryption_key_value.type"},
+		{ApiField: "authConfig.additionalVariables.intValue", Field: "auth_config.additional_variable.integer_value"},
+		{ApiField: "authConfig.additionalVariables.key", Field: "auth_config.additional_variable.key"},
+		{ApiField: "authConfig.additionalVariables.secretValue.secretVersion", Field: "auth_config.additional_variable.secret_value.secret_version"},
+		{ApiField: "authConfig.additionalVariables.stringValue", Field: "auth_config.additional_variable.string_value"},
+		{ApiField: "configVariables.boolValue", Field: "config_variable.boolean_value"},
+		{ApiField: "configVariables.encryptionKeyValue.kmsKeyName", Field: "config_variable.encryption_key_value.kms_key_name"},
+		{ApiField: "configVariables.encryptionKeyValue.type", Field: "config_variable.encryption_key_value.type"},
+		{ApiField: "configVariables.intValue", Field: "config_variable.integer_value"},
+		{ApiField: "configVariables.key", Field: "config_variable.key"},
+		{ApiField: "configVariables.secretValue.secretVersion", Field: "config_variable.secret_value.secret_version"},
+		{ApiField: "configVariables.stringValue", Field: "config_variable.string_value"},
+		{ApiField: "destinationConfigs.destinations.host", Field: "destination_config.destination.host"},
+		{ApiField: "destinationConfigs.destinations.port", Field: "destination_config.destination.port"},
+		{ApiField: "destinationConfigs.destinations.serviceAttachment", Field: "destination_config.destination.service_attachment"},
+		{ApiField: "destinationConfigs.key", Field: "destination_config.key"},
+		{ApiField: "eventingConfig.additionalVariables.boolValue", Field: "eventing_config.additional_variable.boolean_value"},
+		{ApiField: "eventingConfig.additionalVariables.encryptionKeyValue.kmsKeyName", Field: "eventing_config.additional_variable.encryption_key_value.kms_key_name"},
+		{ApiField: "eventingConfig.additionalVariables.encryptionKeyValue.type", Field: "eventing_config.additional_variable.encryption_key_value.type"},
+		{ApiField: "eventingConfig.additionalVariables.intValue", Field: "eventing_config.additional_variable.integer_value"},
+		{ApiField: "eventingConfig.additionalVariables.key", Field: "eventing_config.additional_variable.key"},
+		{ApiField: "eventingConfig.additionalVariables.secretValue.secretVersion", Field: "eventing_config.additional_variable.secret_value.secret_version"},
+		{ApiField: "eventingConfig.additionalVariables.stringValue", Field: "eventing_config.additional_variable.string_value"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.boolValue", Field: "eventing_config.auth_config.additional_variable.boolean_value"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.encryptionKeyValue.kmsKeyName", Field: "eventing_config.auth_config.additional_variable.encryption_key_value.kms_key_name"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.encryptionKeyValue.type", Field: "eventing_config.auth_config.additional_variable.encryption_key_value.type"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.intValue", Field: "eventing_config.auth_config.additional_variable.integer_value"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.key", Field: "eventing_config.auth_config.additional_variable.key"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.secretValue.secretVersion", Field: "eventing_config.auth_config.additional_variable.secret_value.secret_version"},
+		{ApiField: "eventingConfig.authConfig.additionalVariables.stringValue", Field: "eventing_config.auth_config.additional_variable.string_value"},
+		{ApiField: "eventingConfig.registrationDestinationConfig.destinations.host", Field: "eventing_config.registration_destination_config.destination.host"},
+		{ApiField: "eventingConfig.registrationDestinationConfig.destinations.port", Field: "eventing_config.registration_destination_config.destination.port"},
+		{ApiField: "eventingConfig.registrationDestinationConfig.destinations.serviceAttachment", Field: "eventing_config.registration_destination_config.destination.service_attachment"},
+		{ApiField: "sslConfig.additionalVariables.boolValue", Field: "ssl_config.additional_variable.boolean_value"},
+		{ApiField: "sslConfig.additionalVariables.encryptionKeyValue.kmsKeyName", Field: "ssl_config.additional_variable.encryption_key_value.kms_key_name"},
+		{ApiField: "sslConfig.additionalVariables.encryptionKeyValue.type", Field: "ssl_config.additional_variable.encryption_key_value.type"},
+		{ApiField: "sslConfig.additionalVariables.intValue", Field: "ssl_config.additional_variable.integer_value"},
+		{ApiField: "sslConfig.additionalVariables.key", Field: "ssl_config.additional_variable.key"},
+		{ApiField: "sslConfig.additionalVariables.secretValue.secretVersion", Field: "ssl_config.additional_variable.secret_value.secret_version"},
+		{ApiField: "sslConfig.additionalVariables.stringValue", Field: "ssl_config.additional_variable.string_value"},
+	},
+	"google_memcache_instance": {
+		{ApiField: "parameters.id", Field: "memcache_parameters.id"},
+		{ApiField: "parameters.params", Field: "memcache_parameters.params"},
+	},
+	"google_memorystore_instance_desired_user_created_endpoints": {
+		{ApiField: "endpoints.connections.pscConnection.connectionType", Field: "desired_user_created_endpoints.connections.psc_connection.connection_type"},
+		{ApiField: "endpoints.connections.pscConnection.forwardingRule", Field: "desired_user_created_endpoints.connections.psc_connection.forwarding_rule"},
+		{ApiField: "endpoints.connections.pscConnection.ipAddress", Field: "desired_user_created_endpoints.connections.psc_connection.ip_address"},
+		{ApiField: "endpoints.connections.pscConnection.network", Field: "desired_user_created_endpoints.connections.psc_connection.network"},
+		{ApiField: "endpoints.connections.pscConnection.projectId", Field: "desired_user_created_endpoints.connections.psc_connection.project_id"},
+		{ApiField: "endpoints.connections.pscConnection.pscConnectionId", Field: "desired_user_created_endpoints.connections.psc_connection.psc_connection_id"},
+		{ApiField: "endpoints.connections.pscConnection.pscConnectionStatus", Field: "desired_user_created_endpoints.connections.psc_connection.psc_connection_status"},
+		{ApiField: "endpoints.connections.pscConnection.serviceAttachment", Field: "desired_user_created_endpoints.connections.psc_connection.service_attachment"},
+	},
+	"google_monitoring_custom_service": {
+		{ApiField: "name", Field: "service_id"},
+	},
+	"google_monitoring_dashboard": {
+		{ApiField: "*", Field: "dashboard_json"},
+	},
+	"google_monitoring_notification_channel": {
+		{ApiField: "sensitiveLabels.auth_token", Field: "sensitive_labels.auth_token_wo"},
+		{ApiField: "sensitiveLabels.password", Field: "sensitive_labels.password_wo"},
+		{ApiField: "sensitiveLabels.service_key", Field: "sensitive_labels.service_key_wo"},
+	},
+	"google_monitoring_slo": {
+		{ApiField: "serviceLevelIndicator.basicSli.availability.enabled", Field: "basic_sli.availability.enabled"},
+		{ApiField: "serviceLevelIndicator.basicSli.latency.threshold", Field: "basic_sli.latency.threshold"},
+		{ApiField: "serviceLevelIndicator.basicSli.location", Field: "basic_sli.location"},
+		{ApiField: "serviceLevelIndicator.basicSli.method", Field: "basic_sli.method"},
+		{ApiField: "serviceLevelIndicator.basicSli.version", Field: "basic_sli.version"},
+		{ApiField: "serviceLevelIndicator.requestBased.distributionCut.distributionFilter", Field: "request_based_sli.distribution_cut.distribution_filter"},
+		{ApiField: "serviceLevelIndicator.requestBased.distributionCut.range.max", Field: "request_based_sli.distribution_cut.range.max"},
+		{ApiField: "serviceLevelIndicator.requestBased.distributionCut.range.min", Field: "request_based_sli.distribution_cut.range.min"},
+		{ApiField: "serviceLevelIndicator.requestBased.goodTotalRatio.badServiceFilter", Field: "request_based_sli.good_total_ratio.bad_service_filter"},
+		{ApiField: "serviceLevelIndicator.requestBased.goodTotalRatio.goodServiceFilter", Field: "request_based_sli.good_total_ratio.good_service_filter"},
+		{ApiField: "serviceLevelIndicator.requestBased.goodTotalRatio.totalServiceFilter", Field: "request_based_sli.good_total_ratio.total_service_filter"},
+		{ApiField: "rollingPeriod", Field: "rolling_period_days"},
+		{ApiField: "name", Field: "slo_id"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodBadMetricFilter", Field: "windows_based_sli.good_bad_metric_filter"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.basicSliPerformance.availability.enabled", Field: "windows_based_sli.good_total_ratio_threshold.basic_sli_performance.availability.enabled"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.basicSliPerformance.latency.threshold", Field: "windows_based_sli.good_total_ratio_threshold.basic_sli_performance.latency.threshold"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.basicSliPerformance.location", Field: "windows_based_sli.good_total_ratio_threshold.basic_sli_performance.location"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.basicSliPerformance.method", Field: "windows_based_sli.good_total_ratio_threshold.basic_sli_performance.method"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.basicSliPerformance.version", Field: "windows_based_sli.good_total_ratio_threshold.basic_sli_performance.version"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.distributionCut.distributionFilter", Field: "windows_based_sli.good_total_ratio_threshold.performance.distribution_cut.distribution_filter"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.distributionCut.range.max", Field: "windows_based_sli.good_total_ratio_threshold.performance.distribution_cut.range.max"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.distributionCut.range.min", Field: "windows_based_sli.good_total_ratio_threshold.performance.distribution_cut.range.min"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.goodTotalRatio.badServiceFilter", Field: "windows_based_sli.good_total_ratio_threshold.performance.good_total_ratio.bad_service_filter"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.goodTotalRatio.goodServiceFilter", Field: "windows_based_sli.good_total_ratio_threshold.performance.good_total_ratio.good_service_filter"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.performance.goodTotalRatio.totalServiceFilter", Field: "windows_based_sli.good_total_ratio_threshold.performance.good_total_ratio.total_service_filter"},
+		{ApiField: "serviceLevelIndicator.windowsBased.goodTotalRatioThreshold.threshold", Field: "windows_based_sli.good_total_ratio_threshold.threshold"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricMeanInRange.range.max", Field: "windows_based_sli.metric_mean_in_range.range.max"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricMeanInRange.range.min", Field: "windows_based_sli.metric_mean_in_range.range.min"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricMeanInRange.timeSeries", Field: "windows_based_sli.metric_mean_in_range.time_series"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricSumInRange.range.max", Field: "windows_based_sli.metric_sum_in_range.range.max"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricSumInRange.range.min", Field: "windows_based_sli.metric_sum_in_range.range.min"},
+		{ApiField: "serviceLevelIndicator.windowsBased.metricSumInRange.timeSeries", Field: "windows_based_sli.metric_sum_in_range.time_series"},
+		{ApiField: "serviceLevelIndicator.windowsBased.windowPeriod", Field: "windows_based_sli.window_period"},
+	},
+	"google_monitoring_uptime_check_config": {
+		{ApiField: "id", Field: "uptime_check_id"},
+	},
+	"google_network_connectivity_multicloud_data_transfer_config": {
+		{ApiField: "services.key", Field: "services.service_name"},
+		{ApiField: "services.value.states.effectiveTime", Field: "services.states.effective_time"},
+		{ApiField: "services.value.states.state", Field: "services.states.state"},
+	},
+	"google_network_security_dns_threat_detector": {
+		{ApiField: "provider", Field: "threat_detector_provider"},
+	},
+	"google_network_services_edge_cache_keyset": {
+		{ApiField: "publicKeys.id", Field: "public_key.id"},
+		{ApiField: "publicKeys.managed", Field: "public_key.managed"},
+		{ApiField: "publicKeys.value", Field: "public_key.value"},
+	},
+	"google_network_services_edge_cache_service": {
+		{ApiField: "routing.hostRules.description", Field: "routing.host_rule.description"},
+		{ApiField: "routing.hostRules.hosts", Field: "routing.host_rule.hosts"},
+		{ApiField: "routing.hostRules.pathMatcher", Field: "routing.host_rule.path_matcher"},
+		{ApiField: "routing.pathMatchers.description", Field: "routing.path_matcher.description"},
+		{ApiField: "routing.pathMatchers.name", Field: "routing.path_matcher.name"},
+		{ApiField: "routing.pathMatchers.routeRules.description", Field: "routing.path_matcher.route_rule.description"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerName", Field: "routing.path_matcher.route_rule.header_action.request_header_to_add.header_name"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.requestHeadersToAdd.headerValue", Field: "routing.path_matcher.route_rule.header_action.request_header_to_add.header_value"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.requestHeadersToAdd.replace", Field: "routing.path_matcher.route_rule.header_action.request_header_to_add.replace"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.requestHeadersToRemove.headerName", Field: "routing.path_matcher.route_rule.header_action.request_header_to_remove.header_name"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerName", Field: "routing.path_matcher.route_rule.header_action.response_header_to_add.header_name"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.responseHeadersToAdd.headerValue", Field: "routing.path_matcher.route_rule.header_action.response_header_to_add.header_value"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.responseHeadersToAdd.replace", Field: "routing.path_matcher.route_rule.header_action.response_header_to_add.replace"},
+		{ApiField: "routing.pathMatchers.routeRules.headerAction.responseHeadersToRemove.headerName", Field: "routing.path_matcher.route_rule.header_action.response_header_to_remove.header_name"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.fullPathMatch", Field: "routing.path_matcher.route_rule.match_rule.full_path_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.exactMatch", Field: "routing.path_matcher.route_rule.match_rule.header_match.exact_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.headerName", Field: "routing.path_matcher.route_rule.match_rule.header_match.header_name"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.invertMatch", Field: "routing.path_matcher.route_rule.match_rule.header_match.invert_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.prefixMatch", Field: "routing.path_matcher.route_rule.match_rule.header_match.prefix_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.presentMatch", Field: "routing.path_matcher.route_rule.match_rule.header_match.present_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.headerMatches.suffixMatch", Field: "routing.path_matcher.route_rule.match_rule.header_match.suffix_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.ignoreCase", Field: "routing.path_matcher.route_rule.match_rule.ignore_case"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.pathTemplateMatch", Field: "routing.path_matcher.route_rule.match_rule.path_template_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.prefixMatch", Field: "routing.path_matcher.route_rule.match_rule.prefix_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.queryParameterMatches.exactMatch", Field: "routing.path_matcher.route_rule.match_rule.query_parameter_match.exact_match"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.queryParameterMatches.name", Field: "routing.path_matcher.route_rule.match_rule.query_parameter_match.name"},
+		{ApiField: "routing.pathMatchers.routeRules.matchRules.queryParameterMatches.presentMatch", Field: "routing.path_matcher.route_rule.match_rule.query_parameter_match.present_match"},
+		{ApiField: "routing.pathMatchers.routeRules.origin", Field: "routing.path_matcher.route_rule.origin"},
+		{ApiField: "routing.pathMatchers.routeRules.priority", Field: "routing.path_matcher.route_rule.priority"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.addSignatures.actions", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.add_signatures.actions"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.addSignatures.copiedParameters", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.add_signatures.copied_parameters"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.addSignatures.keyset", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.add_signatures.keyset"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.addSignatures.tokenQueryParameter", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.add_signatures.token_query_parameter"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.addSignatures.tokenTtl", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.add_signatures.token_ttl"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.excludeHost", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.exclude_host"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.excludeQueryString", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.exclude_query_string"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.excludedQueryParameters", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.excluded_query_parameters"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.includeProtocol", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.include_protocol"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.includedCookieNames", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.included_cookie_names"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.includedHeaderNames", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.included_header_names"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheKeyPolicy.includedQueryParameters", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_key_policy.included_query_parameters"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.cacheMode", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.cache_mode"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.clientTtl", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.client_ttl"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.defaultTtl", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.default_ttl"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.maxTtl", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.max_ttl"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.negativeCaching", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.negative_caching"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.negativeCachingPolicy", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.negative_caching_policy"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.signedRequestKeyset", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.signed_request_keyset"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.signedRequestMaximumExpirationTtl", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.signed_request_maximum_expiration_ttl"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.signedRequestMode", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.signed_request_mode"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.signedTokenOptions.allowedSignatureAlgorithms", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.signed_token_options.allowed_signature_algorithms"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.cdnPolicy.signedTokenOptions.tokenQueryParameter", Field: "routing.path_matcher.route_rule.route_action.cdn_policy.signed_token_options.token_query_parameter"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.compressionMode", Field: "routing.path_matcher.route_rule.route_action.compression_mode"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.allowCredentials", Field: "routing.path_matcher.route_rule.route_action.cors_policy.allow_credentials"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.allowHeaders", Field: "routing.path_matcher.route_rule.route_action.cors_policy.allow_headers"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.allowMethods", Field: "routing.path_matcher.route_rule.route_action.cors_policy.allow_methods"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.allowOrigins", Field: "routing.path_matcher.route_rule.route_action.cors_policy.allow_origins"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.disabled", Field: "routing.path_matcher.route_rule.route_action.cors_policy.disabled"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.exposeHeaders", Field: "routing.path_matcher.route_rule.route_action.cors_policy.expose_headers"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.corsPolicy.maxAge", Field: "routing.path_matcher.route_rule.route_action.cors_policy.max_age"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.urlRewrite.hostRewrite", Field: "routing.path_matcher.route_rule.route_action.url_rewrite.host_rewrite"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.urlRewrite.pathPrefixRewrite", Field: "routing.path_matcher.route_rule.route_action.url_rewrite.path_prefix_rewrite"},
+		{ApiField: "routing.pathMatchers.routeRules.routeAction.urlRewrite.pathTemplateRewrite", Field: "routing.path_matcher.route_rule.route_action.url_rewrite.path_template_rewrite"},
+		{ApiField: "routing.pathMatchers.routeRules.routeMethods.allowedMethods", Field: "routing.path_matcher.route_rule.route_methods.allowed_methods"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.hostRedirect", Field: "routing.path_matcher.route_rule.url_redirect.host_redirect"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.httpsRedirect", Field: "routing.path_matcher.route_rule.url_redirect.https_redirect"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.pathRedirect", Field: "routing.path_matcher.route_rule.url_redirect.path_redirect"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.prefixRedirect", Field: "routing.path_matcher.route_rule.url_redirect.prefix_redirect"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.redirectResponseCode", Field: "routing.path_matcher.route_rule.url_redirect.redirect_response_code"},
+		{ApiField: "routing.pathMatchers.routeRules.urlRedirect.stripQuery", Field: "routing.path_matcher.route_rule.url_redirect.strip_query"},
+	},
+	"google_network_services_multicast_domain": {
+		{ApiField: "connection.connectionType", Field: "connection_config.connection_type"},
+		{ApiField: "connection.nccHub", Field: "connection_config.ncc_hub"},
+	},
+	"google_network_services_wasm_plugin": {
+		{ApiField: "versions.key", Field: "versions.version_name"},
+		{ApiField: "versions.value.createTime", Field: "versions.create_time"},
+		{ApiField: "versions.value.description", Field: "versions.description"},
+		{ApiField: "versions.value.imageDigest", Field: "versions.image_digest"},
+		{ApiField: "versions.value.imageUri", Field: "versions.image_uri"},
+		{ApiField: "versions.value.labels", Field: "versions.labels"},
+		{ApiField: "versions.value.pluginConfigData", Field: "versions.plugin_config_data"},
+		{ApiField: "versions.value.pluginConfigDigest", Field: "versions.plugin_config_digest"},
+		{ApiField: "versions.value.pluginConfigUri", Field: "versions.plugin_config_uri"},
+		{ApiField: "versions.value.updateTime", Field: "versions.update_time"},
+	},
+	"google_os_config_patch_deployment": {
+		{ApiField: "rollout.disruptionBudget.percent", Field: "rollout.disruption_budget.percentage"},
+	},
+	"google_parameter_manager_parameter_version": {
+		{ApiField: "payload.data", Field: "parameter_data"},
+	},
+	"google_parameter_manager_regional_parameter_version": {
+		{ApiField: "payload.data", Field: "parameter_data"},
+	},
+	"google_project_usage_export_bucket": {
+		{ApiField: "usageExportLocation.bucketName", Field: "bucket_name"},
+		{ApiField: "usageExportLocation.reportNamePrefix", Field: "prefix"},
+	},
+	"google_public_ca_external_account_key": {
+		{ApiField: "b64MacKey", Field: "b64url_mac_key"},
+		{ApiField: "b64MacKey", Field: "mac_key"},
+	},
+	"google_redis_instance": {
+		{ApiField: "reservedIpRange", Field: "effective_reserved_ip_range"},
+	},
+	"google_secret_manager_regional_secret_version": {
+		{ApiField: "state", Field: "enabled"},
+		{ApiField: "payload.data", Field: "secret_data"},
+	},
+	"google_secret_manager_secret": {
+		{ApiField: "replication.automatic.customerManagedEncryption.kmsKeyName", Field: "replication.auto.customer_managed_encryption.kms_key_name"},
+	},
+	"google_secret_manager_secret_version": {
+		{ApiField: "state", Field: "enabled"},
+		{ApiField: "payload.data", Field: "secret_data"},
+		{ApiField: "payload.data", Field: "secret_data_wo"},
+	},
+	"google_site_verification_web_resource": {
+		{ApiField: "id", Field: "web_resource_id"},
+	},
+	"google_sourcerepo_repository": {
+		{ApiField: "pubsubConfigs.key", Field: "pubsub_configs.topic"},
+		{ApiField: "pubsubConfigs.value.messageFormat", Field: "pubsub_configs.message_format"},
+		{ApiField: "pubsubConfigs.value.serviceAccountEmail", Field: "pubsub_configs.service_account_email"},
+	},
+	"google_spanner_database": {
+		{ApiField: "extraStatements", Field: "ddl"},
+	},
+	"google_spanner_instance": {
+		{ApiField: "nodeCount", Field: "num_nodes"},
+	},
+	"google_sql_database_instance": {
+		{ApiField: "diskEncryptionConfiguration.kmsKeyName", Field: "encryption_key_name"},
+		{ApiField: "ipAddresses.ipAddress", Field: "first_ip_address"},
+		{ApiField: "ipAddresses.ipAddress", Field: "ip_address.ip_address"},
+		{ApiField: "ipAddresses.timeToRetire", Field: "ip_address.time_to_retire"},
+		{ApiField: "ipAddresses.type", Field: "ip_address.type"},
+		{ApiField: "ipAddresses.ipAddress", Field: "private_ip_address"},
+		{ApiField: "ipAddresses.ipAddress", Field: "public_ip_address"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.caCertificate", Field: "replica_configuration.ca_certificate"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.clientCertificate", Field: "replica_configuration.client_certificate"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.clientKey", Field: "replica_configuration.client_key"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.connectRetryInterval", Field: "replica_configuration.connect_retry_interval"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.dumpFilePath", Field: "replica_configuration.dump_file_path"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.masterHeartbeatPeriod", Field: "replica_configuration.master_heartbeat_period"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.password", Field: "replica_configuration.password"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.sslCipher", Field: "replica_configuration.ssl_cipher"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.username", Field: "replica_configuration.username"},
+		{ApiField: "replicaConfiguration.mysqlReplicaConfiguration.verifyServerCertificate", Field: "replica_configuration.verify_server_certificate"},
+		{ApiField: "rootPassword", Field: "root_password_wo"},
+		{ApiField: "settings.availabilityType", Field: "settings.effective_availability_type"},
+		{ApiField: "settings.denyMaintenancePeriods.endDate", Field: "settings.deny_maintenance_period.end_date"},
+		{ApiField: "settings.denyMaintenancePeriods.startDate", Field: "settings.deny_maintenance_period.start_date"},
+		{ApiField: "settings.denyMaintenancePeriods.time", Field: "settings.deny_maintenance_period.time"},
+		{ApiField: "settings.storageAutoResize", Field: "settings.disk_autoresize"},
+		{ApiField: "settings.storageAutoResizeLimit", Field: "settings.disk_autoresize_limit"},
+		{ApiField: "settings.dataDiskSizeGb", Field: "settings.disk_size"},
+		{ApiField: "settings.dataDiskType", Field: "settings.disk_type"},
+		{ApiField: "settings.ipConfiguration.pscConfig.pscAutoConnections.consumerProject", Field: "settings.ip_configuration.psc_config.psc_auto_connections.consumer_service_project_id"},
+		{ApiField: "settings.settingsVersion", Field: "settings.version"},
+	},
+	"google_sql_source_representation_instance": {
+		{ApiField: "onPremisesConfiguration.caCertificate", Field: "ca_certificate"},
+		{ApiField: "onPremisesConfiguration.clientCertificate", Field: "client_certificate"},
+		{ApiField: "onPremisesConfiguration.clientKey", Field: "client_key"},
+		{ApiField: "onPremisesConfiguration.dumpFilePath", Field: "dump_file_path"},
+		{ApiField: "onPremisesConfiguration.host", Field: "host"},
+		{ApiField: "onPremisesConfiguration.password", Field: "password"},
+		{ApiField: "onPremisesConfiguration.port", Field: "port"},
+		{ApiField: "onPremisesConfiguration.username", Field: "username"},
+	},
+	"google_sql_user": {
+		{ApiField: "password", Field: "password_wo"},
+	},
+	"google_storage_bucket": {
+		{ApiField: "objectRetention.enabled", Field: "enable_object_retention"},
+		{ApiField: "encryption.defaultKmsKey", Field: "encryption.default_kms_key_name"},
+		{ApiField: "lifecycle.rule.action.storageClass", Field: "lifecycle_rule.action.storage_class"},
+		{ApiField: "lifecycle.rule.action.type", Field: "lifecycle_rule.action.type"},
+		{ApiField: "lifecycle.rule.condition.ageDays", Field: "lifecycle_rule.condition.age"},
+		{ApiField: "lifecycle.rule.condition.createdBefore", Field: "lifecycle_rule.condition.created_before"},
+		{ApiField: "lifecycle.rule.condition.customTimeBefore", Field: "lifecycle_rule.condition.custom_time_before"},
+		{ApiField: "lifecycle.rule.condition.daysSinceCustomTime", Field: "lifecycle_rule.condition.days_since_custom_time"},
+		{ApiField: "lifecycle.rule.condition.daysSinceNoncurrentTime", Field: "lifecycle_rule.condition.days_since_noncurrent_time"},
+		{ApiField: "lifecycle.rule.condition.matchesPrefix", Field: "lifecycle_rule.condition.matches_prefix"},
+		{ApiField: "lifecycle.rule.condition.matchesStorageClass", Field: "lifecycle_rule.condition.matches_storage_class"},
+		{ApiField: "lifecycle.rule.condition.matchesSuffix", Field: "lifecycle_rule.condition.matches_suffix"},
+		{ApiField: "lifecycle.rule.condition.noncurrentTimeBefore", Field: "lifecycle_rule.condition.noncurrent_time_before"},
+		{ApiField: "lifecycle.rule.condition.numNewerVersions", Field: "lifecycle_rule.condition.num_newer_versions"},
+		{ApiField: "lifecycle.rule.condition.isLive", Field: "lifecycle_rule.condition.with_state"},
+		{ApiField: "iamConfig.publicAccessPrevention", Field: "public_access_prevention"},
+		{ApiField: "billing.requesterPays", Field: "requester_pays"},
+		{ApiField: "retentionPolicy.retentionDuration", Field: "retention_policy.retention_period"},
+		{ApiField: "softDeletePolicy.retentionDuration", Field: "soft_delete_policy.retention_duration_seconds"},
+		{ApiField: "iamConfig.uniformBucketLevelAccess.enabled", Field: "uniform_bucket_level_access"},
+		{ApiField: "name", Field: "url"},
+	},
+	"google_storage_bucket_object": {
+		{ApiField: "contexts.custom.value.createTime", Field: "contexts.custom.create_time"},
+		{ApiField: "contexts.custom.value.updateTime", Field: "contexts.custom.update_time"},
+		{ApiField: "contexts.custom.value.value", Field: "contexts.custom.value"},
+		{ApiField: "checksums.crc32c", Field: "crc32c"},
+		{ApiField: "kmsKey", Field: "kms_key_name"},
+		{ApiField: "checksums.md5Hash", Field: "md5hash"},
+		{ApiField: "checksums.md5Hash", Field: "md5hexhash"},
+		{ApiField: "name", Field: "output_name"},
+	},
+	"google_storage_transfer_job": {
+		{ApiField: "loggingConfig.enableOnpremGcsTransferLogs", Field: "logging_config.enable_on_prem_gcs_transfer_logs"},
+	},
+	"google_vector_search_collection": {
+		{ApiField: "vectorSchema.key", Field: "vector_schema.field_name"},
+		{ApiField: "vectorSchema.value.denseVector.dimensions", Field: "vector_schema.dense_vector.dimensions"},
+		{ApiField: "vectorSchema.value.denseVector.vertexEmbeddingConfig.modelId", Field: "vector_schema.dense_vector.vertex_embedding_config.model_id"},
+		{ApiField: "vectorSchema.value.denseVector.vertexEmbeddingConfig.taskType", Field: "vector_schema.dense_vector.vertex_embedding_config.task_type"},
+		{ApiField: "vectorSchema.value.denseVector.vertexEmbeddingConfig.textTemplate", Field: "vector_schema.dense_vector.vertex_embedding_config.text_template"},
+		{ApiField: "vectorSchema.value.sparseVector", Field: "vector_schema.sparse_vector"},
+	},
+	"google_vmwareengine_cluster": {
+		{ApiField: "autoscalingSettings.autoscalingPolicies.key", Field: "autoscaling_settings.autoscaling_policies.autoscale_policy_id"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.consumedMemoryThresholds.scaleIn", Field: "autoscaling_settings.autoscaling_policies.consumed_memory_thresholds.scale_in"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.consumedMemoryThresholds.scaleOut", Field: "autoscaling_settings.autoscaling_policies.consumed_memory_thresholds.scale_out"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.cpuThresholds.scaleIn", Field: "autoscaling_settings.autoscaling_policies.cpu_thresholds.scale_in"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.cpuThresholds.scaleOut", Field: "autoscaling_settings.autoscaling_policies.cpu_thresholds.scale_out"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.nodeTypeId", Field: "autoscaling_settings.autoscaling_policies.node_type_id"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.scaleOutSize", Field: "autoscaling_settings.autoscaling_policies.scale_out_size"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.storageThresholds.scaleIn", Field: "autoscaling_settings.autoscaling_policies.storage_thresholds.scale_in"},
+		{ApiField: "autoscalingSettings.autoscalingPolicies.value.storageThresholds.scaleOut", Field: "autoscaling_settings.autoscaling_policies.storage_thresholds.scale_out"},
+		{ApiField: "nodeTypeConfigs.key", Field: "node_type_configs.node_type_id"},
+		{ApiField: "nodeTypeConfigs.value.customCoreCount", Field: "node_type_configs.custom_core_count"},
+		{ApiField: "nodeTypeConfigs.value.nodeCount", Field: "node_type_configs.node_count"},
+	},
+	"google_vmwareengine_private_cloud": {
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.key", Field: "management_cluster.autoscaling_settings.autoscaling_policies.autoscale_policy_id"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.consumedMemoryThresholds.scaleIn", Field: "management_cluster.autoscaling_settings.autoscaling_policies.consumed_memory_thresholds.scale_in"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.consumedMemoryThresholds.scaleOut", Field: "management_cluster.autoscaling_settings.autoscaling_policies.consumed_memory_thresholds.scale_out"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.cpuThresholds.scaleIn", Field: "management_cluster.autoscaling_settings.autoscaling_policies.cpu_thresholds.scale_in"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.cpuThresholds.scaleOut", Field: "management_cluster.autoscaling_settings.autoscaling_policies.cpu_thresholds.scale_out"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.nodeTypeId", Field: "management_cluster.autoscaling_settings.autoscaling_policies.node_type_id"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.scaleOutSize", Field: "management_cluster.autoscaling_settings.autoscaling_policies.scale_out_size"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.storageThresholds.scaleIn", Field: "management_cluster.autoscaling_settings.autoscaling_policies.storage_thresholds.scale_in"},
+		{ApiField: "managementCluster.autoscalingSettings.autoscalingPolicies.value.storageThresholds.scaleOut", Field: "management_cluster.autoscaling_settings.autoscaling_policies.storage_thresholds.scale_out"},
+		{ApiField: "managementCluster.nodeTypeConfigs.key", Field: "management_cluster.node_type_configs.node_type_id"},
+		{ApiField: "managementCluster.nodeTypeConfigs.value.customCoreCount", Field: "management_cluster.node_type_configs.custom_core_count"},
+		{ApiField: "managementCluster.nodeTypeConfigs.value.nodeCount", Field: "management_cluster.node_type_configs.node_count"},
+	},
+}
@@ -87,6 +87,7 @@ func dataSourceOrganizationRead(d *schema.ResourceData, meta interface{}) error
 				return err
 			},
 			Timeout: d.Timeout(schema.TimeoutRead),
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return fmt.Errorf("Error reading organization: %s", err)
@@ -119,6 +120,7 @@ func dataSourceOrganizationRead(d *schema.ResourceData, meta interface{}) error
 				return err
 			},
 			Timeout: d.Timeout(schema.TimeoutRead),
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Organization Not Found : %s", v), canonicalOrganizationName(v.(string)))
@@ -140,6 +140,7 @@ func resourceGoogleFolderCreate(d *schema.ResourceData, meta interface{}) error
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return fmt.Errorf("Error creating folder '%s' in '%s': %s", displayName, parent, err)
@@ -250,6 +251,7 @@ func resourceGoogleFolderUpdate(d *schema.ResourceData, meta interface{}) error
 				}).Do()
 				return reqErr
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return fmt.Errorf("Error updating display_name to '%s': %s", displayName, err)
@@ -268,6 +270,7 @@ func resourceGoogleFolderUpdate(d *schema.ResourceData, meta interface{}) error
 				}).Do()
 				return reqErr
 			},
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return fmt.Errorf("Error moving folder '%s' to '%s': %s", displayName, newParent, err)
@@ -310,6 +313,7 @@ func resourceGoogleFolderDelete(d *schema.ResourceData, meta interface{}) error
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutDelete),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return fmt.Errorf("Error deleting folder '%s': %s", displayName, err)
@@ -351,6 +355,7 @@ func getGoogleFolder(folderName, userAgent string, d *schema.ResourceData, confi
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return nil, err
@@ -114,6 +114,7 @@ func resourceGoogleFolderOrganizationPolicyRead(d *schema.ResourceData, meta int
 			return getErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Organization policy for %s", folder))
@@ -172,6 +173,7 @@ func resourceGoogleFolderOrganizationPolicyDelete(d *schema.ResourceData, meta i
 			return delErr
 		},
 		Timeout: d.Timeout(schema.TimeoutDelete),
+		RetryOn: config.RetryOn,
 	})
 }
 
@@ -209,6 +211,7 @@ func setFolderOrganizationPolicy(d *schema.ResourceData, meta interface{}) error
 			return setErr
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
+		RetryOn: config.RetryOn,
 	})
 }
 
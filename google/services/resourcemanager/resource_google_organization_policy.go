@@ -224,6 +224,7 @@ func resourceGoogleOrganizationPolicyRead(d *schema.ResourceData, meta interface
 			return readErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Organization policy for %s", org))
@@ -282,6 +283,7 @@ func resourceGoogleOrganizationPolicyDelete(d *schema.ResourceData, meta interfa
 			return dErr
 		},
 		Timeout: d.Timeout(schema.TimeoutDelete),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return err
@@ -353,6 +355,7 @@ func setOrganizationPolicy(d *schema.ResourceData, meta interface{}) error {
 			return setErr
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
+		RetryOn: config.RetryOn,
 	})
 	return err
 }
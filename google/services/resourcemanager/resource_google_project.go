@@ -204,6 +204,7 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating project %s (%s): %s. "+
@@ -353,10 +354,10 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("name", p.Name); err != nil {
 		return fmt.Errorf("Error setting name: %s", err)
 	}
-	if err := tpgresource.SetLabels(p.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(p.Labels, d, "labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
-	if err := tpgresource.SetLabels(p.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(p.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", p.Labels); err != nil {
@@ -389,6 +390,7 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	// Read the billing account
 	if err != nil && !transport_tpg.IsApiNotEnabledError(err) {
@@ -525,6 +527,7 @@ func updateProject(config *transport_tpg.Config, d *schema.ResourceData, project
 			return updateErr
 		},
 		Timeout: d.Timeout(schema.TimeoutUpdate),
+		RetryOn: config.RetryOn,
 	}); err != nil {
 		return nil, fmt.Errorf("Error updating project %q: %s", projectName, err)
 	}
@@ -555,6 +558,7 @@ func resourceGoogleProjectDelete(d *schema.ResourceData, meta interface{}) error
 				return delErr
 			},
 			Timeout: d.Timeout(schema.TimeoutDelete),
+			RetryOn: config.RetryOn,
 		}); err != nil {
 			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Project %s", pid))
 		}
@@ -645,6 +649,7 @@ func updateProjectBillingAccount(d *schema.ResourceData, config *transport_tpg.C
 	err := transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: updateBillingInfoFunc,
 		Timeout:   d.Timeout(schema.TimeoutUpdate),
+		RetryOn:   config.RetryOn,
 	})
 	if err != nil {
 		if err := d.Set("billing_account", ""); err != nil {
@@ -663,6 +668,7 @@ func updateProjectBillingAccount(d *schema.ResourceData, config *transport_tpg.C
 				return reqErr
 			},
 			Timeout: d.Timeout(schema.TimeoutRead),
+			RetryOn: config.RetryOn,
 		})
 		if err != nil {
 			return fmt.Errorf("Error getting billing info for project %q: %v", PrefixedProject(pid), err)
@@ -702,6 +708,7 @@ func readGoogleProject(d *schema.ResourceData, config *transport_tpg.Config, use
 			return reqErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	return p, err
 }
@@ -781,6 +788,7 @@ func doEnableServicesRequest(services []string, project, billingProject, userAge
 		},
 		Timeout:              timeout,
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.ServiceUsageInternalError160009},
+		RetryOn:              config.RetryOn,
 	})
 
 	if logicalErr != nil {
@@ -842,6 +850,7 @@ func ListCurrentlyEnabledServices(project, billingProject, userAgent string, con
 				})
 		},
 		Timeout: timeout,
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Failed to list enabled services for project %s: {{err}}", project), err)
@@ -885,6 +894,7 @@ func waitForServiceUsageEnabledServices(services []string, project, billingProje
 			return nil
 		},
 		Timeout: timeout,
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return errwrap.Wrap(err, fmt.Errorf("failed to enable some service(s) %q for project %s", missing, project))
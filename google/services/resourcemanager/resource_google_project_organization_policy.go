@@ -111,6 +111,7 @@ func resourceGoogleProjectOrganizationPolicyRead(d *schema.ResourceData, meta in
 			return readErr
 		},
 		Timeout: d.Timeout(schema.TimeoutRead),
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Organization policy for %s", project))
@@ -169,6 +170,7 @@ func resourceGoogleProjectOrganizationPolicyDelete(d *schema.ResourceData, meta
 			return err
 		},
 		Timeout: d.Timeout(schema.TimeoutDelete),
+		RetryOn: config.RetryOn,
 	})
 }
 
@@ -206,6 +208,7 @@ func setProjectOrganizationPolicy(d *schema.ResourceData, meta interface{}) erro
 			return err
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
+		RetryOn: config.RetryOn,
 	})
 }
 
@@ -316,6 +316,7 @@ func disableServiceUsageProjectService(service, project string, d *schema.Resour
 		},
 		Timeout:              d.Timeout(schema.TimeoutDelete),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.ServiceUsageServiceBeingActivated},
+		RetryOn:              config.RetryOn,
 	})
 	if err != nil {
 		return fmt.Errorf("Error disabling service %q for project %q: %v", service, project, err)
@@ -179,6 +179,7 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 				ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{
 					transport_tpg.IsNotFoundRetryableError("service account creation"),
 				},
+				RetryOn: config.RetryOn,
 			})
 
 			return nil
@@ -52,14 +52,7 @@ func BatchRequestEnableService(service string, project string, d *schema.Resourc
 		billingProject = bp
 	}
 
-	req := &transport_tpg.BatchRequest{
-		ResourceName: project,
-		Body:         []string{service},
-		CombineF:     combineServiceUsageServicesBatches,
-		SendF:        sendBatchFuncEnableServices(config, userAgent, billingProject, d.Timeout(schema.TimeoutCreate)),
-		DebugId:      fmt.Sprintf("Enable Project Service %q for project %q", service, project),
-	}
-
+	req := enableServiceBatchRequest(service, project, sendBatchFuncEnableServices(config, userAgent, billingProject, d.Timeout(schema.TimeoutCreate)))
 	_, err = config.RequestBatcherServiceUsage.SendRequestWithTimeout(
 		fmt.Sprintf(batchKeyTmplServiceUsageEnableServices, project),
 		req,
@@ -67,6 +60,42 @@ func BatchRequestEnableService(service string, project string, d *schema.Resourc
 	return err
 }
 
+func init() {
+	transport_tpg.RegisterServiceEnabler(enableDisabledService)
+}
+
+// disabledServiceEnableTimeout is how long to wait for an API that a request
+// failed for to be enabled, matching google_project_service's create timeout.
+const disabledServiceEnableTimeout = 20 * time.Minute
+
+// enableDisabledService enables service in project for a request that failed
+// because it isn't enabled while auto_enable_services is set, batched with
+// the APIs google_project_service resources enable.
+func enableDisabledService(config *transport_tpg.Config, service, project string) error {
+	billingProject := project
+	if config.BillingProject != "" {
+		billingProject = config.BillingProject
+	}
+
+	req := enableServiceBatchRequest(service, project, sendBatchFuncEnableServices(config, config.UserAgent, billingProject, disabledServiceEnableTimeout))
+	_, err := config.RequestBatcherServiceUsage.SendRequestWithTimeout(
+		fmt.Sprintf(batchKeyTmplServiceUsageEnableServices, project),
+		req,
+		disabledServiceEnableTimeout)
+	return err
+}
+
+func enableServiceBatchRequest(service, project string, sendF transport_tpg.BatcherSendFunc) *transport_tpg.BatchRequest {
+	return &transport_tpg.BatchRequest{
+		ResourceName: project,
+		Body:         []string{service},
+		CombineF:     combineServiceUsageServicesBatches,
+		SendF:        sendF,
+		DebugId:      fmt.Sprintf("Enable Project Service %q for project %q", service, project),
+		ItemId:       service,
+	}
+}
+
 func tryEnableRenamedService(service, altName string, project string, d *schema.ResourceData, config *transport_tpg.Config) error {
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
@@ -135,12 +164,49 @@ func combineServiceUsageServicesBatches(srvsRaw interface{}, toAddRaw interface{
 }
 
 func sendBatchFuncEnableServices(config *transport_tpg.Config, userAgent, billingProject string, timeout time.Duration) transport_tpg.BatcherSendFunc {
+	return enableServicesBatchSendFunc(
+		func(project string, services []string) error {
+			return EnableServiceUsageProjectServices(services, project, billingProject, userAgent, config, timeout)
+		},
+		func(project string) (map[string]struct{}, error) {
+			return ListCurrentlyEnabledServices(project, billingProject, userAgent, config, timeout)
+		})
+}
+
+// enableServicesBatchSendFunc returns a BatcherSendFunc enabling a batch of
+// services with enableF. If enabling a batch fails after some of its services
+// were enabled, as listed by listF, it returns BatchItemErrors for the other
+// services only. Otherwise the batcher retries each service separately.
+func enableServicesBatchSendFunc(enableF func(project string, services []string) error, listF func(project string) (map[string]struct{}, error)) transport_tpg.BatcherSendFunc {
 	return func(project string, toEnableRaw interface{}) (interface{}, error) {
 		toEnable, ok := toEnableRaw.([]string)
 		if !ok {
 			return nil, fmt.Errorf("Expected batch body type to be []string, got %v. This is a provider error.", toEnableRaw)
 		}
-		return nil, EnableServiceUsageProjectServices(toEnable, project, billingProject, userAgent, config, timeout)
+		err := enableF(project, toEnable)
+		if err == nil || len(toEnable) < 2 {
+			return nil, err
+		}
+
+		enabled, listErr := listF(project)
+		if listErr != nil {
+			log.Printf("[DEBUG] Failed to list enabled services for project %s after a failed batch: %s", project, listErr)
+			return nil, err
+		}
+		itemErrs := transport_tpg.BatchItemErrors{}
+		for _, service := range toEnable {
+			if _, ok := enabled[service]; !ok {
+				itemErrs[service] = err
+			}
+		}
+		if len(itemErrs) == len(toEnable) {
+			return nil, err
+		}
+		if len(itemErrs) == 0 {
+			return nil, nil
+		}
+		log.Printf("[DEBUG] Enabled some services of the batch for project %s, failed: %s", project, itemErrs)
+		return nil, itemErrs
 	}
 }
 
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/resourcemanager/serviceusage_batching_internal_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package resourcemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestEnableServicesBatchSendFunc_itemErrors(t *testing.T) {
+	cases := map[string]struct {
+		Enabled  []string
+		ListErr  error
+		Expected map[string]bool // service -> whether enabling it fails
+		Attempts int
+	}{
+		"some services enabled": {
+			Enabled:  []string{"compute.googleapis.com"},
+			Expected: map[string]bool{"compute.googleapis.com": false, "container.googleapis.com": true},
+			Attempts: 1,
+		},
+		"no service enabled": {
+			// The batcher retries each service separately, which fail again.
+			Expected: map[string]bool{"compute.googleapis.com": true, "container.googleapis.com": true},
+			Attempts: 3,
+		},
+		"listing fails": {
+			ListErr:  errors.New("list failed"),
+			Expected: map[string]bool{"compute.googleapis.com": true, "container.googleapis.com": true},
+			Attempts: 3,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			var mu sync.Mutex
+			attempts := 0
+			sendF := enableServicesBatchSendFunc(
+				func(project string, services []string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					attempts++
+					return fmt.Errorf("failed to enable services %q", services)
+				},
+				func(project string) (map[string]struct{}, error) {
+					enabled := make(map[string]struct{})
+					for _, s := range tc.Enabled {
+						enabled[s] = struct{}{}
+					}
+					return enabled, tc.ListErr
+				})
+
+			batcher := transport_tpg.NewRequestBatcher("testServiceUsage", context.Background(), &transport_tpg.BatchingConfig{
+				SendAfter:      time.Second,
+				EnableBatching: true,
+			})
+
+			var wg sync.WaitGroup
+			for service, shouldFail := range tc.Expected {
+				wg.Add(1)
+				go func(service string, shouldFail bool) {
+					defer wg.Done()
+					req := enableServiceBatchRequest(service, "my-project", sendF)
+					_, err := batcher.SendRequestWithTimeout(fmt.Sprintf(batchKeyTmplServiceUsageEnableServices, "my-project"), req, 10*time.Second)
+					if shouldFail && err == nil {
+						t.Errorf("expected enabling %s to fail", service)
+					}
+					if !shouldFail && err != nil {
+						t.Errorf("expected enabling %s to succeed, got %v", service, err)
+					}
+				}(service, shouldFail)
+			}
+			wg.Wait()
+
+			if attempts != tc.Attempts {
+				t.Errorf("expected %d attempts to enable services, got %d", tc.Attempts, attempts)
+			}
+		})
+	}
+}
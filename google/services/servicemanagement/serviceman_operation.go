@@ -47,7 +47,7 @@ func ServiceManagementOperationWaitTime(config *transport_tpg.Config, op *servic
 		return nil, err
 	}
 
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return nil, err
 	}
 	return w.Op.Response, nil
@@ -250,6 +250,7 @@ func resourceGoogleServiceNetworkingPeeredDNSDomainDelete(d *schema.ResourceData
 			return delErr
 		},
 		Timeout: d.Timeout(schema.TimeoutDelete),
+		RetryOn: config.RetryOn,
 	}); err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Peered DNS domain %s", name))
 	}
@@ -156,6 +156,7 @@ func resourceServiceNetworkingConnectionCreate(d *schema.ResourceData, meta inte
 		},
 		Timeout:              d.Timeout(schema.TimeoutCreate),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{isInvalidAuthError},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
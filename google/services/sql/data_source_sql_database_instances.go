@@ -124,6 +124,7 @@ func dataSourceSqlDatabaseInstancesRead(d *schema.ResourceData, meta interface{}
 			},
 			Timeout:              d.Timeout(schema.TimeoutRead),
 			ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+			RetryOn:              config.RetryOn,
 		})
 		if err != nil {
 			return err
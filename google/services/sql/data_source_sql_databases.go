@@ -74,6 +74,7 @@ func dataSourceSqlDatabasesRead(d *schema.ResourceData, meta interface{}) error
 		},
 		Timeout:              d.Timeout(schema.TimeoutRead),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
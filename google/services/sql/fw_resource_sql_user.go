@@ -247,6 +247,7 @@ func (r *SQLUserFWResource) Create(ctx context.Context, req resource.CreateReque
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: insertFunc,
 		Timeout:   createTimeout,
+		RetryOn:   r.providerConfig.RetryOn,
 	})
 
 	if err != nil {
@@ -357,6 +358,7 @@ func (r *SQLUserFWResource) Update(ctx context.Context, req resource.UpdateReque
 		err = transport_tpg.Retry(transport_tpg.RetryOptions{
 			RetryFunc: updateFunc,
 			Timeout:   updateTimeout,
+			RetryOn:   r.providerConfig.RetryOn,
 		})
 
 		if err != nil {
@@ -415,6 +417,7 @@ func (r *SQLUserFWResource) Delete(ctx context.Context, req resource.DeleteReque
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: deleteFunc,
 		Timeout:   deleteTimeout,
+		RetryOn:   r.providerConfig.RetryOn,
 	})
 
 	if err != nil {
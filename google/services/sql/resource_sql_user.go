@@ -331,6 +331,7 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 				},
 				Timeout:              d.Timeout(schema.TimeoutRead),
 				ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+				RetryOn:              config.RetryOn,
 			})
 			if err != nil {
 				return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("SQL Database Instance %q", d.Get("instance").(string)))
@@ -350,6 +351,7 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: insertFunc,
 		Timeout:   d.Timeout(schema.TimeoutCreate),
+		RetryOn:   config.RetryOn,
 	})
 
 	if err != nil {
@@ -402,6 +404,7 @@ func resourceSqlUserRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		},
 		Timeout: 5 * time.Minute,
+		RetryOn: config.RetryOn,
 	})
 	if err != nil {
 		// move away from transport_tpg.HandleNotFoundError() as we need to handle both 404 and 403
@@ -567,6 +570,7 @@ func resourceSqlUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		err = transport_tpg.Retry(transport_tpg.RetryOptions{
 			RetryFunc: updateFunc,
 			Timeout:   d.Timeout(schema.TimeoutUpdate),
+			RetryOn:   config.RetryOn,
 		})
 
 		if err != nil {
@@ -628,6 +632,7 @@ func resourceSqlUserDelete(d *schema.ResourceData, meta interface{}) error {
 		},
 		Timeout:              d.Timeout(schema.TimeoutDelete),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError, IsSqlInternalError},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
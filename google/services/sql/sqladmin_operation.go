@@ -19,7 +19,6 @@ package sql
 import (
 	"bytes"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
@@ -28,112 +27,63 @@ import (
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
-type SqlAdminOperationWaiter struct {
-	Service *sqladmin.Service
-	Op      *sqladmin.Operation
-	Project string
-}
-
-func (w *SqlAdminOperationWaiter) State() string {
-	if w == nil {
-		return "Operation Waiter is nil!"
-	}
-
-	if w.Op == nil {
-		return "Operation is nil!"
-	}
-
-	return w.Op.Status
-}
-
-func (w *SqlAdminOperationWaiter) Error() error {
-	if w != nil && w.Op != nil && w.Op.Error != nil && w.Op.Status == "DONE" {
-		return SqlAdminOperationError(*w.Op.Error)
-	}
-	return nil
-}
-
-func (w *SqlAdminOperationWaiter) IsRetryable(error) bool {
-	return false
-}
-
-func (w *SqlAdminOperationWaiter) SetOp(op interface{}) error {
-	if op == nil {
-		// Starting as a log statement, this may be a useful error in the future
-		log.Printf("[DEBUG] attempted to set nil op")
-	}
-
-	sqlOp, ok := op.(*sqladmin.Operation)
-	w.Op = sqlOp
-	if !ok {
-		return fmt.Errorf("Unable to set operation. Bad type!")
-	}
-
-	return nil
-}
-
-func (w *SqlAdminOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, waiter is unset or nil.")
-	}
-
-	if w.Op == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-
-	if w.Service == nil {
-		return nil, fmt.Errorf("Cannot query operation, service is nil.")
-	}
-
-	var op interface{}
-	var err error
-	err = transport_tpg.Retry(transport_tpg.RetryOptions{
-		RetryFunc: func() error {
-			op, err = w.Service.Operations.Get(w.Project, w.Op.Name).Do()
-			return err
-		},
-		Timeout: transport_tpg.DefaultRequestTimeout,
-	})
-
-	return op, err
-}
-
-func (w *SqlAdminOperationWaiter) OpName() string {
-	if w == nil {
-		return "<nil waiter>"
-	}
-
-	if w.Op == nil {
-		return "<nil op>"
-	}
-
-	return w.Op.Name
-}
-
-func (w *SqlAdminOperationWaiter) PendingStates() []string {
-	return []string{"PENDING", "RUNNING"}
+// sqlOperation is the kind of Cloud SQL operations, whose errors are only
+// final once they're done.
+var sqlOperation = &tpgresource.OperationKind{
+	Name:          "sql",
+	PendingStates: []string{"PENDING", "RUNNING"},
+	TargetStates:  []string{"DONE"},
+	State:         tpgresource.OperationStatus,
+	Error: func(op map[string]interface{}) error {
+		if op["error"] == nil || tpgresource.OperationStatus(op) != "DONE" {
+			return nil
+		}
+		opErr := sqladmin.OperationErrors{}
+		if err := tpgresource.Convert(op["error"], &opErr); err != nil {
+			return fmt.Errorf("operation error: %v", op["error"])
+		}
+		return SqlAdminOperationError(opErr)
+	},
+	Url: func(w *tpgresource.OperationWaiter) string {
+		return fmt.Sprintf("%sprojects/%s/operations/%s", w.BaseUrl, w.Project, w.OpName())
+	},
+	Cancel: func(w *tpgresource.OperationWaiter) error {
+		_, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    w.Config,
+			Method:    "POST",
+			Project:   w.Project,
+			RawURL:    fmt.Sprintf("%sprojects/%s/operations/%s/cancel", w.BaseUrl, w.Project, w.OpName()),
+			UserAgent: w.UserAgent,
+			Timeout:   time.Minute,
+		})
+		return err
+	},
+	// Cloud SQL operations don't report how complete they are, so their
+	// progress is their kind and status.
+	Progress: func(op map[string]interface{}) tpgresource.OperationProgress {
+		p := tpgresource.OperationProgress{Percent: -1}
+		status := tpgresource.OperationStatus(op)
+		if status == "" {
+			return p
+		}
+		p.Stage = status
+		if opType, _ := op["operationType"].(string); opType != "" {
+			p.Stage = fmt.Sprintf("%s %s", opType, status)
+		}
+		return p
+	},
 }
 
-func (w *SqlAdminOperationWaiter) TargetStates() []string {
-	return []string{"DONE"}
+func init() {
+	tpgresource.RegisterOperationKind(sqlOperation)
 }
 
 func SqlAdminOperationWaitTime(config *transport_tpg.Config, res interface{}, project, activity, userAgent string, timeout time.Duration) error {
-	op := &sqladmin.Operation{}
-	err := tpgresource.Convert(res, op)
+	w, err := tpgresource.NewOperationWaiter(config, sqlOperation, res, transport_tpg.BaseUrl(Product, config), project, userAgent)
 	if err != nil {
 		return err
 	}
-
-	w := &SqlAdminOperationWaiter{
-		Service: NewClient(config, userAgent),
-		Op:      op,
-		Project: project,
-	}
-	if err := w.SetOp(op); err != nil {
-		return err
-	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
 // SqlAdminOperationError wraps sqladmin.OperationError and implements the
@@ -975,6 +975,7 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		},
 		Timeout:              d.Timeout(schema.TimeoutCreate),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429RetryableQuotaError},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
@@ -994,6 +995,7 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		},
 		Timeout:              d.Timeout(schema.TimeoutCreate),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsNotFoundRetryableError("bucket creation")},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
@@ -1171,6 +1173,7 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		},
 		Timeout:              d.Timeout(schema.TimeoutUpdate),
 		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsNotFoundRetryableError("bucket update")},
+		RetryOn:              config.RetryOn,
 	})
 
 	if err != nil {
@@ -2456,10 +2459,10 @@ func setStorageBucket(d *schema.ResourceData, config *transport_tpg.Config, res
 	if err := d.Set("lifecycle_rule", flattenBucketLifecycle(d, res.Lifecycle)); err != nil {
 		return fmt.Errorf("Error setting lifecycle_rule: %s", err)
 	}
-	if err := tpgresource.SetLabels(res.Labels, d, "labels"); err != nil {
+	if err := tpgresource.SetLabels(res.Labels, d, "labels", config); err != nil {
 		return fmt.Errorf("Error setting labels: %s", err)
 	}
-	if err := tpgresource.SetLabels(res.Labels, d, "terraform_labels"); err != nil {
+	if err := tpgresource.SetLabels(res.Labels, d, "terraform_labels", config); err != nil {
 		return fmt.Errorf("Error setting terraform_labels: %s", err)
 	}
 	if err := d.Set("effective_labels", res.Labels); err != nil {
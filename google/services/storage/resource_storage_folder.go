@@ -465,6 +465,7 @@ func resourceStorageFolderDelete(d *schema.ResourceData, meta interface{}) error
 				},
 				Timeout:              d.Timeout(schema.TimeoutDelete),
 				ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.Is429RetryableQuotaError},
+				RetryOn:              config.RetryOn,
 			})
 			if err != nil {
 				return err
@@ -1044,6 +1044,7 @@ func resourceStorageTransferJobCreate(d *schema.ResourceData, meta interface{})
 			res, err = createCall.Do()
 			return err
 		},
+		RetryOn: config.RetryOn,
 	})
 
 	if err != nil {
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/services/tags/tag_bindings.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tags
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+// EffectiveTag is a tag that applies to a resource, with the tag's key and
+// value by their namespaced names, e.g. "123456/env" and "prod". Inherited
+// tags are bound to an ancestor of the resource rather than to it.
+type EffectiveTag struct {
+	Key       string
+	Value     string
+	Inherited bool
+}
+
+// tagBindingsBasePath returns the base path of the Tags API that manages
+// tag bindings in location, or the global one if location is empty. As in
+// google_tags_location_tag_binding, regional and zonal resources have their
+// bindings managed in their location.
+func tagBindingsBasePath(config *transport_tpg.Config, location string) string {
+	if location == "" {
+		return config.TagsBasePath
+	}
+	return strings.Replace(config.TagsLocationBasePath, "{{location}}", location, 1)
+}
+
+// ListEffectiveTags returns the tags that apply to parent, the full resource
+// name of a resource such as //storage.googleapis.com/projects/_/buckets/foo.
+// Effective tags name their keys and values by namespaced name, which tag
+// bindings don't when they're listed.
+func ListEffectiveTags(config *transport_tpg.Config, parent, location, billingProject, userAgent string) ([]EffectiveTag, error) {
+	rawUrl := fmt.Sprintf("%seffectiveTags?parent=%s&pageSize=300", tagBindingsBasePath(config, location), url.QueryEscape(parent))
+
+	var effectiveTags []EffectiveTag
+	pageUrl := rawUrl
+	for {
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing effective tags of %s: %s", parent, err)
+		}
+		raw, _ := res["effectiveTags"].([]interface{})
+		for _, v := range raw {
+			t, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key, _ := t["namespacedTagKey"].(string)
+			value, _ := t["namespacedTagValue"].(string)
+			if key == "" || !strings.HasPrefix(value, key+"/") {
+				continue
+			}
+			inherited, _ := t["inherited"].(bool)
+			effectiveTags = append(effectiveTags, EffectiveTag{Key: key, Value: strings.TrimPrefix(value, key+"/"), Inherited: inherited})
+		}
+
+		pageToken, _ := res["nextPageToken"].(string)
+		if pageToken == "" {
+			return effectiveTags, nil
+		}
+		pageUrl, err = transport_tpg.AddQueryParams(rawUrl, map[string]string{"pageToken": pageToken})
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// CreateTagBinding binds the tag value with the namespaced name
+// "{key}/{value}" to parent.
+func CreateTagBinding(config *transport_tpg.Config, parent, location, key, value, billingProject, userAgent string, timeout time.Duration) error {
+	lockName := fmt.Sprintf("tagBindings/%s", parent)
+	transport_tpg.MutexStore.Lock(lockName)
+	defer transport_tpg.MutexStore.Unlock(lockName)
+
+	log.Printf("[DEBUG] Binding tag %s=%s to %s", key, value, parent)
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    tagBindingsBasePath(config, location) + "tagBindings",
+		UserAgent: userAgent,
+		Body: map[string]interface{}{
+			"parent":                 parent,
+			"tagValueNamespacedName": fmt.Sprintf("%s/%s", key, value),
+		},
+		Timeout: timeout,
+	})
+	if err != nil {
+		return fmt.Errorf("Error binding tag %s=%s to %s: %s", key, value, parent, err)
+	}
+	return TagsLocationOperationWaitTime(config, res, "Creating TagBinding", userAgent, timeout)
+}
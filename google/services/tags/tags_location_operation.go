@@ -17,56 +17,35 @@
 package tags
 
 import (
-	"encoding/json"
-	"fmt"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
-type TagsLocationOperationWaiter struct {
-	Config    *transport_tpg.Config
-	UserAgent string
-	tpgresource.CommonOperationWaiter
-}
-
-func (w *TagsLocationOperationWaiter) QueryOp() (interface{}, error) {
-	if w == nil {
-		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
-	}
-	location := GetLocationFromOpName(w.CommonOperationWaiter.Op.Name)
-	if location != w.CommonOperationWaiter.Op.Name {
-		// Found location in Op.Name, fill it in TagsLocationBasePath and rewrite URL
-		url := fmt.Sprintf("%s%s", strings.Replace(transport_tpg.BaseUrl(Product, w.Config), "{{location}}", location, 1), w.CommonOperationWaiter.Op.Name)
-		return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    w.Config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: w.UserAgent,
-		})
-	} else {
-		url := fmt.Sprintf("%s%s", transport_tpg.BaseUrl(Product, w.Config), w.CommonOperationWaiter.Op.Name)
-		return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    w.Config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: w.UserAgent,
-		})
+// tagsLocationOperation is the kind of the operations of tag bindings in a
+// location, which are polled at the endpoint of the location in their name,
+// e.g. operations/rctb.us-central1.123, or at the global endpoint if they
+// have none.
+var tagsLocationOperation = func() *tpgresource.OperationKind {
+	kind := *tpgresource.AipOperation
+	kind.Name = "tags_location"
+	kind.Url = func(w *tpgresource.OperationWaiter) string {
+		if location := GetLocationFromOpName(w.OpName()); location != w.OpName() {
+			return tpgresource.LocationalBaseUrl(w.BaseUrl, location) + w.OpName()
+		}
+		return transport_tpg.BaseUrl(Product, w.Config) + w.OpName()
 	}
+	return &kind
+}()
+
+func init() {
+	tpgresource.RegisterOperationKind(tagsLocationOperation)
 }
 
-func createTagsLocationWaiter(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string) (*TagsLocationOperationWaiter, error) {
-	w := &TagsLocationOperationWaiter{
-		Config:    config,
-		UserAgent: userAgent,
-	}
-	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
-		return nil, err
-	}
-	return w, nil
+func createTagsLocationWaiter(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string) (*tpgresource.OperationWaiter, error) {
+	return tpgresource.NewOperationWaiter(config, tagsLocationOperation, op, config.TagsLocationBasePath, "", userAgent)
 }
 
 func TagsLocationOperationWaitTimeWithResponse(config *transport_tpg.Config, op map[string]interface{}, response *map[string]interface{}, activity, userAgent string, timeout time.Duration) error {
@@ -74,10 +53,10 @@ func TagsLocationOperationWaitTimeWithResponse(config *transport_tpg.Config, op
 	if err != nil {
 		return err
 	}
-	if err := tpgresource.OperationWait(w, activity, timeout, config.PollInterval); err != nil {
+	if err := tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name)); err != nil {
 		return err
 	}
-	return json.Unmarshal([]byte(w.CommonOperationWaiter.Op.Response), response)
+	return w.Response(response)
 }
 
 func TagsLocationOperationWaitTime(config *transport_tpg.Config, op map[string]interface{}, activity, userAgent string, timeout time.Duration) error {
@@ -90,7 +69,7 @@ func TagsLocationOperationWaitTime(config *transport_tpg.Config, op map[string]i
 		// If w is nil, the op was synchronous.
 		return err
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWait(w, activity, timeout, config.ProductPollInterval(Product.Name))
 }
 
 func GetLocationFromOpName(opName string) string {
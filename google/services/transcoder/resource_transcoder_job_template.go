@@ -1101,18 +1101,7 @@ func flattenTranscoderJobTemplateName(v interface{}, d *schema.ResourceData, con
 }
 
 func flattenTranscoderJobTemplateLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenTranscoderJobTemplateConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1907,18 +1896,7 @@ func flattenTranscoderJobTemplateConfigEncryptionsSecretManagerKeySourceSecretVe
 }
 
 func flattenTranscoderJobTemplateTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenTranscoderJobTemplateEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -780,18 +780,7 @@ func flattenVectorSearchCollectionEncryptionSpecCryptoKeyName(v interface{}, d *
 }
 
 func flattenVectorSearchCollectionLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenVectorSearchCollectionName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -888,18 +877,7 @@ func flattenVectorSearchCollectionVectorSchemaSparseVector(v interface{}, d *sch
 }
 
 func flattenVectorSearchCollectionTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenVectorSearchCollectionEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
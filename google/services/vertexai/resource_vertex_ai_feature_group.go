@@ -647,18 +647,7 @@ func flattenVertexAIFeatureGroupUpdateTime(v interface{}, d *schema.ResourceData
 }
 
 func flattenVertexAIFeatureGroupLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenVertexAIFeatureGroupDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -702,18 +691,7 @@ func flattenVertexAIFeatureGroupBigQueryEntityIdColumns(v interface{}, d *schema
 }
 
 func flattenVertexAIFeatureGroupTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenVertexAIFeatureGroupEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
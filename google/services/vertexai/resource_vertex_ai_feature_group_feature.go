@@ -617,18 +617,7 @@ func flattenVertexAIFeatureGroupFeatureUpdateTime(v interface{}, d *schema.Resou
 }
 
 func flattenVertexAIFeatureGroupFeatureLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenVertexAIFeatureGroupFeatureDescription(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -640,18 +629,7 @@ func flattenVertexAIFeatureGroupFeatureVersionColumnName(v interface{}, d *schem
 }
 
 func flattenVertexAIFeatureGroupFeatureTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenVertexAIFeatureGroupFeatureEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -704,18 +704,7 @@ func flattenVertexAIFeatureOnlineStoreFeatureviewUpdateTime(v interface{}, d *sc
 }
 
 func flattenVertexAIFeatureOnlineStoreFeatureviewLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenVertexAIFeatureOnlineStoreFeatureviewSyncConfig(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -811,18 +800,7 @@ func flattenVertexAIFeatureOnlineStoreFeatureviewFeatureRegistrySourceProjectNum
 }
 
 func flattenVertexAIFeatureOnlineStoreFeatureviewTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenVertexAIFeatureOnlineStoreFeatureviewEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
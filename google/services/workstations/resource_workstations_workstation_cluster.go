@@ -113,6 +113,15 @@ func ResourceWorkstationsWorkstationCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWorkstationsWorkstationClusterResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceWorkstationsWorkstationClusterUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.SetAnnotationsDiff,
@@ -308,6 +317,12 @@ Details can be found in the conditions field.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -796,18 +811,7 @@ func flattenWorkstationsWorkstationClusterUid(v interface{}, d *schema.ResourceD
 }
 
 func flattenWorkstationsWorkstationClusterLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenWorkstationsWorkstationClusterNetwork(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -951,18 +955,7 @@ func flattenWorkstationsWorkstationClusterConditionsDetails(v interface{}, d *sc
 }
 
 func flattenWorkstationsWorkstationClusterTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenWorkstationsWorkstationClusterEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -1164,3 +1157,215 @@ func ResourceWorkstationsWorkstationClusterFlatten(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func resourceWorkstationsWorkstationClusterResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `The relative resource name of the VPC network on which the instance can be accessed.
+It is specified in the following form: "projects/{projectNumber}/global/networks/{network_id}".`,
+			},
+			"subnetwork": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: `Name of the Compute Engine subnetwork in which instances associated with this cluster will be created.
+Must be part of the subnetwork specified for this cluster.`,
+			},
+			"workstation_cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `ID to use for the workstation cluster.`,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Client-specified annotations. This is distinct from labels.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Human-readable name for this resource.`,
+			},
+			"domain_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration options for a custom domain.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Domain used by Workstations for HTTP ingress.`,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Client-specified labels that are applied to the resource and that are also propagated to the underlying Compute Engine resources.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `The location where the workstation cluster should reside.`,
+			},
+			"private_cluster_config": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Configuration for private cluster.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_private_endpoint": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Whether Workstations endpoint is private.`,
+						},
+						"allowed_projects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							Description: `Additional project IDs that are allowed to attach to the workstation cluster's service attachment.
+By default, the workstation cluster's project and the VPC host project (if different) are allowed.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cluster_hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Hostname for the workstation cluster.
+This field will be populated only when private endpoint is enabled.
+To access workstations in the cluster, create a new DNS zone mapping this domain name to an internal IP address and a forwarding rule mapping that address to the service attachment.`,
+						},
+						"service_attachment_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Service attachment URI for the workstation cluster.
+The service attachment is created when private endpoint is enabled.
+To access workstations in the cluster, configure access to the managed service using (Private Service Connect)[https://cloud.google.com/vpc/docs/configure-private-service-connect-services].`,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: `Resource manager tags bound to this resource.
+For example:
+"123/environment": "production",
+"123/costCenter": "marketing"`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Status conditions describing the current resource state.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The status code, which should be an enum value of google.rpc.Code.`,
+						},
+						"details": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `A list of messages that carry the error details.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeMap,
+							},
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Human readable message indicating details about the current status.`,
+						},
+					},
+				},
+			},
+			"control_plane_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `The private IP address of the control plane for this workstation cluster.
+Workstation VMs need access to this IP address to work with the service, so make sure that your firewall rules allow egress from the workstation VMs to this address.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time when this resource was created.`,
+			},
+			"degraded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: `Whether this resource is in degraded mode, in which case it may require user action to restore full functionality.
+Details can be found in the conditions field.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Checksum computed by the server.
+May be sent on update and delete requests to ensure that the client has an up-to-date value before proceeding.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the cluster resource.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The system-generated UID of the resource.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceWorkstationsWorkstationClusterUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
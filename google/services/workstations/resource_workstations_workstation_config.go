@@ -113,6 +113,15 @@ func ResourceWorkstationsWorkstationConfig() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWorkstationsWorkstationConfigResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: ResourceWorkstationsWorkstationConfigUpgradeV0,
+				Version: 0,
+			},
+		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetLabelsDiff,
 			tpgresource.SetAnnotationsDiff,
@@ -748,6 +757,12 @@ A duration in seconds with up to nine fractional digits, ending with 's'. Exampl
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of annotations configured directly on the resource and default annotations configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"effective_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -1412,18 +1427,7 @@ func flattenWorkstationsWorkstationConfigDisplayName(v interface{}, d *schema.Re
 }
 
 func flattenWorkstationsWorkstationConfigLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "labels", config)
 }
 
 func flattenWorkstationsWorkstationConfigAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2203,18 +2207,7 @@ func flattenWorkstationsWorkstationConfigConditionsDetails(v interface{}, d *sch
 }
 
 func flattenWorkstationsWorkstationConfigTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
-	if v == nil {
-		return v
-	}
-
-	transformed := make(map[string]interface{})
-	if l, ok := d.GetOkExists("terraform_labels"); ok {
-		for k := range l.(map[string]interface{}) {
-			transformed[k] = v.(map[string]interface{})[k]
-		}
-	}
-
-	return transformed
+	return tpgresource.FlattenLabels(v, d, "terraform_labels", config)
 }
 
 func flattenWorkstationsWorkstationConfigEffectiveLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -3245,3 +3238,651 @@ func ResourceWorkstationsWorkstationConfigFlatten(d *schema.ResourceData, meta i
 
 	return nil
 }
+
+func resourceWorkstationsWorkstationConfigResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The location where the workstation cluster config should reside.`,
+			},
+			"workstation_cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the parent workstation cluster.`,
+			},
+			"workstation_config_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID to be assigned to the workstation cluster config.`,
+			},
+			"allowed_ports": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `A list of port ranges specifying single ports or ranges of ports that are externally accessible in the workstation. Allowed ports must be one of 22, 80, or within range 1024-65535. If not specified defaults to ports 22, 80, and ports 1024-65535.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Starting port number for the current range of ports. Valid ports are 22, 80, and ports within the range 1024-65535.`,
+						},
+						"last": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `Ending port number for the current range of ports. Valid ports are 22, 80, and ports within the range 1024-65535.`,
+						},
+					},
+				},
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Client-specified annotations. This is distinct from labels.
+
+**Note**: This field is non-authoritative, and will only manage the annotations present in your configuration.
+Please refer to the field 'effective_annotations' for all of the annotations present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"container": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Container that will be run for each workstation using this configuration when that workstation is started.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `Arguments passed to the entrypoint.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"command": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `If set, overrides the default ENTRYPOINT specified by the image.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Description: `Environment variables passed to the container.
+The elements are of the form "KEY=VALUE" for the environment variable "KEY" being given the value "VALUE".`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"image": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Docker image defining the container. This image must be accessible by the config's service account.`,
+						},
+						"run_as_user": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: `If set, overrides the USER specified in the image with the given uid.`,
+						},
+						"working_dir": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `If set, overrides the default DIR specified by the image.`,
+						},
+					},
+				},
+			},
+			"disable_tcp_connections": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Disables support for plain TCP connections in the workstation. By default the service supports TCP connections via a websocket relay. Setting this option to true disables that relay, which prevents the usage of services that require plain tcp connections, such as ssh. When enabled, all communication must occur over https or wss.`,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Human-readable name for this resource.`,
+			},
+			"enable_audit_agent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Whether to enable Linux 'auditd' logging on the workstation. When enabled, a service account must also be specified that has 'logging.buckets.write' permission on the project. Operating system audit logging is distinct from Cloud Audit Logs.`,
+			},
+			"encryption_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Description: `Encrypts resources of this workstation configuration using a customer-managed encryption key.
+
+If specified, the boot disk of the Compute Engine instance and the persistent disk are encrypted using this encryption key. If this field is not set, the disks are encrypted using a generated key. Customer-managed encryption keys do not protect disk metadata.
+If the customer-managed encryption key is rotated, when the workstation instance is stopped, the system attempts to recreate the persistent disk with the new version of the key. Be sure to keep older versions of the key until the persistent disk is recreated. Otherwise, data on the persistent disk will be lost.
+If the encryption key is revoked, the workstation session will automatically be stopped within 7 hours.`,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The name of the Google Cloud KMS encryption key.`,
+						},
+						"kms_key_service_account": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The service account to use with the specified KMS key.`,
+						},
+					},
+				},
+			},
+			"ephemeral_directories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Ephemeral directories which won't persist across workstation sessions.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gce_pd": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `An EphemeralDirectory backed by a Compute Engine persistent disk.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										Description: `Type of the disk to use. Defaults to '"pd-standard"'.`,
+									},
+									"read_only": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Whether the disk is read only. If true, the disk may be shared by multiple VMs and 'sourceSnapshot' must be set.`,
+									},
+									"source_image": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Name of the disk image to use as the source for the disk.
+
+Must be empty 'sourceSnapshot' is set.
+Updating 'sourceImage' will update content in the ephemeral directory after the workstation is restarted.`,
+									},
+									"source_snapshot": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Description: `Name of the snapshot to use as the source for the disk.
+
+Must be empty if 'sourceImage' is set.
+Must be empty if 'read_only' is false.
+Updating 'source_snapshot' will update content in the ephemeral directory after the workstation is restarted.`,
+									},
+								},
+							},
+						},
+						"mount_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							Description: `Location of this directory in the running workstation.`,
+						},
+					},
+				},
+			},
+			"host": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Runtime host for a workstation.`,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gce_instance": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `A runtime using a Compute Engine instance.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accelerators": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `An accelerator card attached to the instance.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"count": {
+													Type:        schema.TypeInt,
+													Required:    true,
+													Description: `Number of accelerator cards exposed to the instance.`,
+												},
+												"type": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `Type of accelerator resource to attach to the instance, for example, "nvidia-tesla-p100".`,
+												},
+											},
+										},
+									},
+									"boost_configs": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `A list of the boost configurations that workstations created using this workstation configuration are allowed to use.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: `The id to be used for the boost config.`,
+												},
+												"accelerators": {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: `An accelerator card attached to the boost instance.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"count": {
+																Type:        schema.TypeInt,
+																Required:    true,
+																Description: `Number of accelerator cards exposed to the instance.`,
+															},
+															"type": {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: `Type of accelerator resource to attach to the instance, for example, "nvidia-tesla-p100".`,
+															},
+														},
+													},
+												},
+												"boot_disk_size_gb": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Optional:    true,
+													ForceNew:    true,
+													Description: `Size of the boot disk in GB. The minimum boot disk size is '30' GB. Defaults to '50' GB.`,
+												},
+												"enable_nested_virtualization": {
+													Type:     schema.TypeBool,
+													Computed: true,
+													Optional: true,
+													Description: `Whether to enable nested virtualization on the Compute Engine VMs backing boosted Workstations.
+
+See https://cloud.google.com/workstations/docs/reference/rest/v1/projects.locations.workstationClusters.workstationConfigs#GceInstance.FIELDS.enable_nested_virtualization`,
+												},
+												"machine_type": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: `The type of machine that boosted VM instances will use—for example, e2-standard-4. For more information about machine types that Cloud Workstations supports, see the list of available machine types https://cloud.google.com/workstations/docs/available-machine-types. Defaults to e2-standard-4.`,
+												},
+												"pool_size": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Optional:    true,
+													Description: `Number of instances to pool for faster workstation boosting.`,
+												},
+											},
+										},
+									},
+									"boot_disk_size_gb": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Size of the boot disk in GB.`,
+									},
+									"confidential_instance_config": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Optional:    true,
+										Description: `A set of Compute Engine Confidential VM instance options.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable_confidential_compute": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `Whether the instance has confidential compute enabled.`,
+												},
+											},
+										},
+									},
+									"disable_public_ip_addresses": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Whether instances have no public IP address.`,
+									},
+									"disable_ssh": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: `Whether to disable SSH access to the VM.`,
+										Default:     true,
+									},
+									"enable_nested_virtualization": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Description: `Whether to enable nested virtualization on the Compute Engine VMs backing the Workstations.
+
+See https://cloud.google.com/workstations/docs/reference/rest/v1/projects.locations.workstationClusters.workstationConfigs#GceInstance.FIELDS.enable_nested_virtualization`,
+									},
+									"machine_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										Description: `The name of a Compute Engine machine type.`,
+									},
+									"pool_size": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Optional:    true,
+										Description: `Number of instances to pool for faster workstation startup.`,
+									},
+									"service_account": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Email address of the service account that will be used on VM instances used to support this config. This service account must have permission to pull the specified container image. If not set, VMs will run without a service account, in which case the image must be publicly accessible.`,
+									},
+									"service_account_scopes": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Optional:    true,
+										Description: `Scopes to grant to the service_account. Various scopes are automatically added based on feature usage. When specified, users of workstations under this configuration must have 'iam.serviceAccounts.actAs' on the service account.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"shielded_instance_config": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Optional:    true,
+										Description: `A set of Compute Engine Shielded instance options.`,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enable_integrity_monitoring": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `Whether the instance has integrity monitoring enabled.`,
+												},
+												"enable_secure_boot": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `Whether the instance has Secure Boot enabled.`,
+												},
+												"enable_vtpm": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Description: `Whether the instance has the vTPM enabled.`,
+												},
+											},
+										},
+									},
+									"tags": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: `Network tags to add to the Compute Engine machines backing the Workstations.`,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"vm_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Description: `Resource manager tags to be bound to the VM instances backing the Workstations.
+Tag keys and values have the same definition as
+https://docs.cloud.google.com/resource-manager/docs/tags/tags-overview
+Keys must be in the format 'tagKeys/{tag_key_id}', and
+values are in the format 'tagValues/456'.`,
+										Elem: &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"idle_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `How long to wait before automatically stopping an instance that hasn't recently received any user traffic. A value of 0 indicates that this instance should never time out from idleness. Defaults to 20 minutes.
+A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+				Default: "1200s",
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Description: `Client-specified labels that are applied to the resource and that are also propagated to the underlying Compute Engine resources.
+
+**Note**: This field is non-authoritative, and will only manage the labels present in your configuration.
+Please refer to the field 'effective_labels' for all of the labels present on the resource.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"max_usable_workstations": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: `Maximum number of workstations under this configuration a user can have workstations.workstation.use permission on. Only enforced on CreateWorkstation API calls on the user issuing the API request.`,
+			},
+			"persistent_directories": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Description: `Directories to persist across workstation sessions.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gce_hd": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: `A directory to persist across workstation sessions, backed by a Compute Engine Hyperdisk Balanced High Availability disk.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"archive_timeout": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										Description: `How long to wait before converting the disk into a snapshot.`,
+									},
+									"reclaim_policy": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"DELETE", "RETAIN", ""}),
+										Description:  `Whether the persistent disk should be deleted when the workstation is deleted. Possible values: ["DELETE", "RETAIN"]`,
+									},
+									"size_gb": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Optional:    true,
+										Description: `The GB capacity of a persistent home directory. Defaults to '200'.`,
+									},
+									"source_snapshot": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Name of the snapshot to use as the source for the disk.`,
+									},
+								},
+							},
+						},
+						"gce_pd": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Optional:    true,
+							Description: `A directory to persist across workstation sessions, backed by a Compute Engine regional persistent disk. Can only be updated if not empty during creation.`,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disk_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										Description: `The type of the persistent disk for the home directory. Defaults to 'pd-standard'.`,
+									},
+									"fs_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Optional:    true,
+										ForceNew:    true,
+										Description: `Type of file system that the disk should be formatted with. The workstation image must support this file system type. Must be empty if 'sourceSnapshot' is set. Defaults to 'ext4'.`,
+									},
+									"reclaim_policy": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ValidateFunc: verify.ValidateEnum([]string{"DELETE", "RETAIN", ""}),
+										Description:  `Whether the persistent disk should be deleted when the workstation is deleted. Valid values are 'DELETE' and 'RETAIN'. Defaults to 'DELETE'. Possible values: ["DELETE", "RETAIN"]`,
+									},
+									"size_gb": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										Description: `The GB capacity of a persistent home directory for each workstation created with this configuration. Must be empty if 'sourceSnapshot' is set.
+Valid values are '10', '50', '100', '200', '500', or '1000'. Defaults to '200'. If less than '200' GB, the 'diskType' must be 'pd-balanced' or 'pd-ssd'.`,
+									},
+									"source_snapshot": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: `Name of the snapshot to use as the source for the disk. This can be the snapshot's 'self_link', 'id', or a string in the format of 'projects/{project}/global/snapshots/{snapshot}'. If set, 'sizeGb' and 'fsType' must be empty. Can only be updated if it has an existing value.`,
+									},
+								},
+							},
+						},
+						"mount_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Location of this directory in the running workstation.`,
+						},
+					},
+				},
+			},
+			"readiness_checks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Readiness checks to be performed on a workstation.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Path to which the request should be sent.`,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Port to which the request should be sent.`,
+						},
+					},
+				},
+			},
+			"replica_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+				Description: `Specifies the zones used to replicate the VM and disk resources within the region. If set, exactly two zones within the workstation cluster's region must be specified—for example, '['us-central1-a', 'us-central1-f']'.
+If this field is empty, two default zones within the region are used. Immutable after the workstation configuration is created.`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"running_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `How long to wait before automatically stopping a workstation after it was started. A value of 0 indicates that workstations using this configuration should never time out from running duration. Must be greater than 0 and less than 24 hours if 'encryption_key' is set. Defaults to 12 hours.
+A duration in seconds with up to nine fractional digits, ending with 's'. Example: "3.5s".`,
+				Default: "43200s",
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Status conditions describing the current resource state.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The status code, which should be an enum value of google.rpc.Code.`,
+						},
+						"details": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `A list of messages that carry the error details.`,
+							Elem: &schema.Schema{
+								Type: schema.TypeMap,
+							},
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Human readable message indicating details about the current status.`,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Time when this resource was created.`,
+			},
+			"degraded": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether this resource is in degraded mode, in which case it may require user action to restore full functionality. Details can be found in the conditions field.`,
+			},
+			"effective_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `All of labels (key/value pairs) present on the resource in GCP, including the labels configured through Terraform, other clients and services.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: `Checksum computed by the server.
+May be sent on update and delete requests to ensure that the client has an up-to-date value before proceeding.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Full name of this resource.`,
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of labels configured directly on the resource
+ and default labels configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The system-generated UID of the resource.`,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func ResourceWorkstationsWorkstationConfigUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return tpgresource.TerraformAnnotationsStateUpgrade(rawState)
+}
@@ -17,6 +17,8 @@
 package tpgiamresource
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"fmt"
@@ -43,7 +45,7 @@ func DataSourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpda
 	}
 
 	return &schema.Resource{
-		Read: DatasourceIamPolicyRead(newUpdaterFunc),
+		ReadContext: DatasourceIamPolicyRead(newUpdaterFunc),
 		// if non-empty, this will be used to send a deprecation message when the
 		// datasource is used.
 		DeprecationMessage: settings.DeprecationMessage,
@@ -52,25 +54,25 @@ func DataSourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpda
 	}
 }
 
-func DatasourceIamPolicyRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func DatasourceIamPolicyRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		policy, err := iamPolicyReadWithRetry(updater)
+		policy, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM policy data source", d))
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Policy", updater.DescribeResource()))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Policy", updater.DescribeResource())))
 		}
 
 		if err := d.Set("etag", policy.Etag); err != nil {
-			return fmt.Errorf("Error setting etag: %s", err)
+			return diag.Errorf("Error setting etag: %s", err)
 		}
 		if err := d.Set("policy_data", marshalIamPolicy(policy)); err != nil {
-			return fmt.Errorf("Error setting policy_data: %s", err)
+			return diag.Errorf("Error setting policy_data: %s", err)
 		}
 		d.SetId(updater.GetResourceId())
 
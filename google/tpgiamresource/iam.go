@@ -18,6 +18,7 @@
 package tpgiamresource
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -73,10 +74,13 @@ type (
 	ResourceIdParserFunc func(d *schema.ResourceData, config *transport_tpg.Config) error
 )
 
-// Locking wrapper around read-only operation with retries.
-func iamPolicyReadWithRetry(updater ResourceIamUpdater) (*cloudresourcemanager.Policy, error) {
+// Locking wrapper around read-only operation with retries. holder describes
+// the caller while it waits for the lock, see transport_tpg.MutexKV.LockWithContext.
+func iamPolicyReadWithRetry(ctx context.Context, updater ResourceIamUpdater, holder string) (*cloudresourcemanager.Policy, error) {
 	mutexKey := updater.GetMutexKey()
-	transport_tpg.MutexStore.Lock(mutexKey)
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, mutexKey, holder); err != nil {
+		return nil, err
+	}
 	defer transport_tpg.MutexStore.Unlock(mutexKey)
 
 	log.Printf("[DEBUG] Retrieving policy for %s\n", updater.DescribeResource())
@@ -95,10 +99,14 @@ func iamPolicyReadWithRetry(updater ResourceIamUpdater) (*cloudresourcemanager.P
 	return policy, nil
 }
 
-// Locking wrapper around read-modify-write cycle for IAM policy.
-func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModifyFunc) error {
+// Locking wrapper around read-modify-write cycle for IAM policy. holder
+// describes the caller while it waits for the lock, see
+// transport_tpg.MutexKV.LockWithContext.
+func iamPolicyReadModifyWrite(ctx context.Context, updater ResourceIamUpdater, holder string, modify iamPolicyModifyFunc) error {
 	mutexKey := updater.GetMutexKey()
-	transport_tpg.MutexStore.Lock(mutexKey)
+	if err := transport_tpg.MutexStore.LockWithContext(ctx, mutexKey, holder); err != nil {
+		return err
+	}
 	defer transport_tpg.MutexStore.Unlock(mutexKey)
 
 	backoff := time.Second
@@ -177,7 +185,7 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 			// calling a retryable function within a retry loop is not
 			// strictly the _best_ idea, but this error only happens in
 			// high-traffic projects anyways
-			currentPolicy, rerr := iamPolicyReadWithRetry(updater)
+			currentPolicy, rerr := iamPolicyReadWithRetry(ctx, updater, holder)
 			if rerr != nil {
 				if p.Etag != currentPolicy.Etag {
 					// not matching indicates that there is a new state to attempt to apply
@@ -17,6 +17,7 @@
 package tpgiamresource
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -35,7 +36,7 @@ func BatchRequestModifyIamPolicy(updater ResourceIamUpdater, modify iamPolicyMod
 		ResourceName: updater.GetResourceId(),
 		Body:         []iamPolicyModifyFunc{modify},
 		CombineF:     combineBatchIamPolicyModifiers,
-		SendF:        sendBatchModifyIamPolicy(updater),
+		SendF:        sendBatchModifyIamPolicy(config, updater),
 		DebugId:      reqDesc,
 	}
 
@@ -57,13 +58,21 @@ func combineBatchIamPolicyModifiers(currV interface{}, toAddV interface{}) (inte
 	return append(currModifiers, newModifiers...), nil
 }
 
-func sendBatchModifyIamPolicy(updater ResourceIamUpdater) transport_tpg.BatcherSendFunc {
+// sendBatchModifyIamPolicy applies a batch of modifications to the policy.
+// The batch may combine changes of several resources, so waiting for the
+// policy's lock only stops when Terraform is interrupted.
+func sendBatchModifyIamPolicy(config *transport_tpg.Config, updater ResourceIamUpdater) transport_tpg.BatcherSendFunc {
 	return func(resourceName string, body interface{}) (interface{}, error) {
 		modifiers, ok := body.([]iamPolicyModifyFunc)
 		if !ok {
 			return nil, fmt.Errorf("provider error: expected data to be type []iamPolicyModifyFunc, got %v with type %T", body, body)
 		}
-		return nil, iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
+		ctx := config.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		holder := fmt.Sprintf("batch of %d IAM policy changes", len(modifiers))
+		return nil, iamPolicyReadModifyWrite(ctx, updater, holder, func(policy *cloudresourcemanager.Policy) error {
 			for _, modifyF := range modifiers {
 				if err := modifyF(policy); err != nil {
 					return err
@@ -17,6 +17,7 @@
 package tpgiamresource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -66,11 +68,11 @@ func ResourceIamAuditConfig(parentSpecificSchema map[string]*schema.Schema, newU
 	settings := NewIamSettings(options...)
 
 	return &schema.Resource{
-		Create: resourceIamAuditConfigCreateUpdate(newUpdaterFunc, settings.EnableBatching),
-		Read:   resourceIamAuditConfigRead(newUpdaterFunc),
-		Update: resourceIamAuditConfigCreateUpdate(newUpdaterFunc, settings.EnableBatching),
-		Delete: resourceIamAuditConfigDelete(newUpdaterFunc, settings.EnableBatching),
-		Schema: tpgresource.MergeSchemas(iamAuditConfigSchema, parentSpecificSchema),
+		CreateContext: resourceIamAuditConfigCreateUpdate(newUpdaterFunc, settings.EnableBatching),
+		ReadContext:   resourceIamAuditConfigRead(newUpdaterFunc),
+		UpdateContext: resourceIamAuditConfigCreateUpdate(newUpdaterFunc, settings.EnableBatching),
+		DeleteContext: resourceIamAuditConfigDelete(newUpdaterFunc, settings.EnableBatching),
+		Schema:        tpgresource.MergeSchemas(iamAuditConfigSchema, parentSpecificSchema),
 		Importer: &schema.ResourceImporter{
 			State: iamAuditConfigImport(resourceIdParser),
 		},
@@ -78,18 +80,18 @@ func ResourceIamAuditConfig(parentSpecificSchema map[string]*schema.Schema, newU
 	}
 }
 
-func resourceIamAuditConfigRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamAuditConfigRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		eAuditConfig := getResourceIamAuditConfig(d)
-		p, err := iamPolicyReadWithRetry(updater)
+		p, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM audit config", d))
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("AuditConfig for %s on %q", eAuditConfig.Service, updater.DescribeResource()))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("AuditConfig for %s on %q", eAuditConfig.Service, updater.DescribeResource())))
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v", updater.DescribeResource(), p)
 
@@ -108,14 +110,14 @@ func resourceIamAuditConfigRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema
 		}
 
 		if err := d.Set("etag", p.Etag); err != nil {
-			return fmt.Errorf("Error setting etag: %s", err)
+			return diag.Errorf("Error setting etag: %s", err)
 		}
 		err = d.Set("audit_log_config", flattenAuditLogConfigs(ac.AuditLogConfigs))
 		if err != nil {
-			return fmt.Errorf("Error flattening audit log config: %s", err)
+			return diag.Errorf("Error flattening audit log config: %s", err)
 		}
 		if err := d.Set("service", ac.Service); err != nil {
-			return fmt.Errorf("Error setting service: %s", err)
+			return diag.Errorf("Error setting service: %s", err)
 		}
 		return nil
 	}
@@ -151,13 +153,13 @@ func iamAuditConfigImport(resourceIdParser ResourceIdParserFunc) schema.StateFun
 	}
 }
 
-func resourceIamAuditConfigCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamAuditConfigCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ac := getResourceIamAuditConfig(d)
@@ -170,23 +172,23 @@ func resourceIamAuditConfigCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Overwrite audit config for service %s on resource %q", ac.Service, updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM audit config", d), modifyF)
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(updater.GetResourceId() + "/audit_config/" + ac.Service)
-		return resourceIamAuditConfigRead(newUpdaterFunc)(d, meta)
+		return resourceIamAuditConfigRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamAuditConfigDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamAuditConfigDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		ac := getResourceIamAuditConfig(d)
@@ -198,13 +200,13 @@ func resourceIamAuditConfigDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enab
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Delete audit config for service %s on resource %q", ac.Service, updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM audit config", d), modifyF)
 		}
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %s with IAM audit config %q", updater.DescribeResource(), d.Id()))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %s with IAM audit config %q", updater.DescribeResource(), d.Id())))
 		}
 
-		return resourceIamAuditConfigRead(newUpdaterFunc)(d, meta)
+		return resourceIamAuditConfigRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
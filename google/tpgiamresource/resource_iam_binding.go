@@ -17,6 +17,7 @@
 package tpgiamresource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -27,6 +28,7 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -85,10 +87,10 @@ func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdat
 	createTimeOut := time.Duration(settings.CreateTimeOut) * time.Minute
 
 	resource := &schema.Resource{
-		Create: resourceIamBindingCreateUpdate(newUpdaterFunc, settings.EnableBatching),
-		Read:   resourceIamBindingRead(newUpdaterFunc),
-		Update: resourceIamBindingCreateUpdate(newUpdaterFunc, settings.EnableBatching),
-		Delete: resourceIamBindingDelete(newUpdaterFunc, settings.EnableBatching),
+		CreateContext: resourceIamBindingCreateUpdate(newUpdaterFunc, settings.EnableBatching),
+		ReadContext:   resourceIamBindingRead(newUpdaterFunc),
+		UpdateContext: resourceIamBindingCreateUpdate(newUpdaterFunc, settings.EnableBatching),
+		DeleteContext: resourceIamBindingDelete(newUpdaterFunc, settings.EnableBatching),
 
 		// if non-empty, this will be used to send a deprecation message when the
 		// resource is used.
@@ -97,7 +99,7 @@ func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdat
 		SchemaVersion:      settings.SchemaVersion,
 		StateUpgraders:     settings.StateUpgraders,
 		Importer: &schema.ResourceImporter{
-			State: iamBindingImport(newUpdaterFunc, resourceIdParser),
+			StateContext: iamBindingImport(newUpdaterFunc, resourceIdParser),
 		},
 		UseJSONNumber: true,
 	}
@@ -109,12 +111,12 @@ func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdat
 	return resource
 }
 
-func resourceIamBindingCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) func(*schema.ResourceData, interface{}) error {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamBindingCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		binding := getResourceIamBinding(d)
@@ -129,34 +131,34 @@ func resourceIamBindingCreateUpdate(newUpdaterFunc NewResourceIamUpdaterFunc, en
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Set IAM Binding for role %q on %q", binding.Role, updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM binding", d), modifyF)
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(updater.GetResourceId() + "/" + binding.Role)
 		if k := conditionKeyFromCondition(binding.Condition); !k.Empty() {
 			d.SetId(d.Id() + "/" + k.String())
 		}
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		return resourceIamBindingRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamBindingRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamBindingRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		eBinding := getResourceIamBinding(d)
 		eCondition := conditionKeyFromCondition(eBinding.Condition)
-		p, err := iamPolicyReadWithRetry(updater)
+		p, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM binding", d))
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Binding (Role %q)", updater.DescribeResource(), eBinding.Role))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Binding (Role %q)", updater.DescribeResource(), eBinding.Role)))
 		}
 		log.Print(spew.Sprintf("[DEBUG] Retrieved policy for %s: %#v", updater.DescribeResource(), p))
 		log.Printf("[DEBUG] Looking for binding with role %q and condition %#v", eBinding.Role, eCondition)
@@ -173,32 +175,32 @@ func resourceIamBindingRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.Rea
 			log.Printf("[WARNING] Binding for role %q not found, assuming it has no members. If you expected existing members bound for this role, make sure your role is correctly formatted.", eBinding.Role)
 			log.Printf("[DEBUG] Binding for role %q and condition %#v not found in policy for %s, assuming it has no members.", eBinding.Role, eCondition, updater.DescribeResource())
 			if err := d.Set("role", eBinding.Role); err != nil {
-				return fmt.Errorf("Error setting role: %s", err)
+				return diag.Errorf("Error setting role: %s", err)
 			}
 			if err := d.Set("members", nil); err != nil {
-				return fmt.Errorf("Error setting members: %s", err)
+				return diag.Errorf("Error setting members: %s", err)
 			}
 			return nil
 		} else {
 			if err := d.Set("role", binding.Role); err != nil {
-				return fmt.Errorf("Error setting role: %s", err)
+				return diag.Errorf("Error setting role: %s", err)
 			}
 			if err := d.Set("members", binding.Members); err != nil {
-				return fmt.Errorf("Error setting members: %s", err)
+				return diag.Errorf("Error setting members: %s", err)
 			}
 			if err := d.Set("condition", FlattenIamCondition(binding.Condition)); err != nil {
-				return fmt.Errorf("Error setting condition: %s", err)
+				return diag.Errorf("Error setting condition: %s", err)
 			}
 		}
 		if err := d.Set("etag", p.Etag); err != nil {
-			return fmt.Errorf("Error setting etag: %s", err)
+			return diag.Errorf("Error setting etag: %s", err)
 		}
 		return nil
 	}
 }
 
-func iamBindingImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser ResourceIdParserFunc) schema.StateFunc {
-	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func iamBindingImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser ResourceIdParserFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIdParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
@@ -241,7 +243,7 @@ func iamBindingImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser
 		if err != nil {
 			return nil, err
 		}
-		p, err := iamPolicyReadWithRetry(updater)
+		p, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM binding", d))
 		if err != nil {
 			return nil, err
 		}
@@ -277,13 +279,13 @@ func iamBindingImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser
 	}
 }
 
-func resourceIamBindingDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamBindingDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		binding := getResourceIamBinding(d)
@@ -296,13 +298,13 @@ func resourceIamBindingDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBa
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config, fmt.Sprintf(
 				"Delete IAM Binding for role %q on %q", binding.Role, updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM binding", d), modifyF)
 		}
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q for IAM binding with role %q", updater.DescribeResource(), binding.Role))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q for IAM binding with role %q", updater.DescribeResource(), binding.Role)))
 		}
 
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		return resourceIamBindingRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
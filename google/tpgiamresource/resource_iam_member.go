@@ -17,6 +17,7 @@
 package tpgiamresource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -28,6 +29,7 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -104,8 +106,8 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 	},
 }
 
-func iamMemberImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser ResourceIdParserFunc) schema.StateFunc {
-	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func iamMemberImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser ResourceIdParserFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIdParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
@@ -148,7 +150,7 @@ func iamMemberImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser
 		if err != nil {
 			return nil, err
 		}
-		p, err := iamPolicyReadWithRetry(updater)
+		p, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM member", d))
 		if err != nil {
 			return nil, err
 		}
@@ -192,9 +194,9 @@ func ResourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdate
 	createTimeOut := time.Duration(settings.CreateTimeOut) * time.Minute
 
 	resourceSchema := &schema.Resource{
-		Create: resourceIamMemberCreate(newUpdaterFunc, settings.EnableBatching),
-		Read:   resourceIamMemberRead(newUpdaterFunc),
-		Delete: resourceIamMemberDelete(newUpdaterFunc, settings.EnableBatching),
+		CreateContext: resourceIamMemberCreate(newUpdaterFunc, settings.EnableBatching),
+		ReadContext:   resourceIamMemberRead(newUpdaterFunc),
+		DeleteContext: resourceIamMemberDelete(newUpdaterFunc, settings.EnableBatching),
 
 		// if non-empty, this will be used to send a deprecation message when the
 		// resource is used.
@@ -204,7 +206,7 @@ func ResourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdate
 		SchemaVersion:  settings.SchemaVersion,
 		StateUpgraders: settings.StateUpgraders,
 		Importer: &schema.ResourceImporter{
-			State: iamMemberImport(newUpdaterFunc, resourceIdParser),
+			StateContext: iamMemberImport(newUpdaterFunc, resourceIdParser),
 		},
 		UseJSONNumber: true,
 	}
@@ -228,13 +230,13 @@ func getResourceIamMember(d *schema.ResourceData) *cloudresourcemanager.Binding
 	return b
 }
 
-func resourceIamMemberCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.CreateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		memberBind := getResourceIamMember(d)
@@ -248,33 +250,33 @@ func resourceIamMemberCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBat
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config,
 				fmt.Sprintf("Create IAM Members %s %+v for %s", memberBind.Role, memberBind.Members[0], updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM member", d), modifyF)
 		}
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.SetId(updater.GetResourceId() + "/" + memberBind.Role + "/" + tpgresource.NormalizeIamPrincipalCasing(memberBind.Members[0]))
 		if k := conditionKeyFromCondition(memberBind.Condition); !k.Empty() {
 			d.SetId(d.Id() + "/" + k.String())
 		}
-		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
+		return resourceIamMemberRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func resourceIamMemberRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		eMember := getResourceIamMember(d)
 		eCondition := conditionKeyFromCondition(eMember.Condition)
-		p, err := iamPolicyReadWithRetry(updater)
+		p, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM member", d))
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Member: Role %q Member %q", updater.DescribeResource(), eMember.Role, eMember.Members[0]))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Member: Role %q Member %q", updater.DescribeResource(), eMember.Role, eMember.Members[0])))
 		}
 		log.Print(spew.Sprintf("[DEBUG]: Retrieved policy for %s: %#v\n", updater.DescribeResource(), p))
 		log.Printf("[DEBUG]: Looking for binding with role %q and condition %#v", eMember.Role, eCondition)
@@ -308,28 +310,28 @@ func resourceIamMemberRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.Read
 		}
 
 		if err := d.Set("etag", p.Etag); err != nil {
-			return fmt.Errorf("Error setting etag: %s", err)
+			return diag.Errorf("Error setting etag: %s", err)
 		}
 		if err := d.Set("member", member); err != nil {
-			return fmt.Errorf("Error setting member: %s", err)
+			return diag.Errorf("Error setting member: %s", err)
 		}
 		if err := d.Set("role", binding.Role); err != nil {
-			return fmt.Errorf("Error setting role: %s", err)
+			return diag.Errorf("Error setting role: %s", err)
 		}
 		if err := d.Set("condition", FlattenIamCondition(binding.Condition)); err != nil {
-			return fmt.Errorf("Error setting condition: %s", err)
+			return diag.Errorf("Error setting condition: %s", err)
 		}
 		return nil
 	}
 }
 
-func resourceIamMemberDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func resourceIamMemberDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		memberBind := getResourceIamMember(d)
@@ -342,11 +344,11 @@ func resourceIamMemberDelete(newUpdaterFunc NewResourceIamUpdaterFunc, enableBat
 			err = BatchRequestModifyIamPolicy(updater, modifyF, config,
 				fmt.Sprintf("Delete IAM Members %s %s for %q", memberBind.Role, memberBind.Members[0], updater.DescribeResource()))
 		} else {
-			err = iamPolicyReadModifyWrite(updater, modifyF)
+			err = iamPolicyReadModifyWrite(ctx, updater, tpgresource.LockHolder("IAM member", d), modifyF)
 		}
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %s for IAM Member (role %q, %q)", updater.GetResourceId(), memberBind.Members[0], memberBind.Role))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %s for IAM Member (role %q, %q)", updater.GetResourceId(), memberBind.Members[0], memberBind.Role)))
 		}
-		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
+		return resourceIamMemberRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
@@ -17,11 +17,13 @@
 package tpgiamresource
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -61,10 +63,10 @@ func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdate
 	createTimeOut := time.Duration(settings.CreateTimeOut) * time.Minute
 
 	resourceSchema := &schema.Resource{
-		Create: ResourceIamPolicyCreate(newUpdaterFunc),
-		Read:   ResourceIamPolicyRead(newUpdaterFunc),
-		Update: ResourceIamPolicyUpdate(newUpdaterFunc),
-		Delete: ResourceIamPolicyDelete(newUpdaterFunc),
+		CreateContext: ResourceIamPolicyCreate(newUpdaterFunc),
+		ReadContext:   ResourceIamPolicyRead(newUpdaterFunc),
+		UpdateContext: ResourceIamPolicyUpdate(newUpdaterFunc),
+		DeleteContext: ResourceIamPolicyDelete(newUpdaterFunc),
 
 		// if non-empty, this will be used to send a deprecation message when the
 		// resource is used.
@@ -86,75 +88,75 @@ func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdate
 	return resourceSchema
 }
 
-func ResourceIamPolicyCreate(newUpdaterFunc NewResourceIamUpdaterFunc) schema.CreateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func ResourceIamPolicyCreate(newUpdaterFunc NewResourceIamUpdaterFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err = setIamPolicyData(d, updater); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.SetId(updater.GetResourceId())
-		return ResourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return ResourceIamPolicyRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func ResourceIamPolicyRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func ResourceIamPolicyRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		policy, err := iamPolicyReadWithRetry(updater)
+		policy, err := iamPolicyReadWithRetry(ctx, updater, tpgresource.LockHolder("IAM policy", d))
 		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Policy", updater.DescribeResource()))
+			return diag.FromErr(transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Policy", updater.DescribeResource())))
 		}
 
 		if err := d.Set("etag", policy.Etag); err != nil {
-			return fmt.Errorf("Error setting etag: %s", err)
+			return diag.Errorf("Error setting etag: %s", err)
 		}
 		if err := d.Set("policy_data", marshalIamPolicy(policy)); err != nil {
-			return fmt.Errorf("Error setting policy_data: %s", err)
+			return diag.Errorf("Error setting policy_data: %s", err)
 		}
 
 		return nil
 	}
 }
 
-func ResourceIamPolicyUpdate(newUpdaterFunc NewResourceIamUpdaterFunc) schema.UpdateFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func ResourceIamPolicyUpdate(newUpdaterFunc NewResourceIamUpdaterFunc) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if d.HasChange("policy_data") {
 			if err := setIamPolicyData(d, updater); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
-		return ResourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return ResourceIamPolicyRead(newUpdaterFunc)(ctx, d, meta)
 	}
 }
 
-func ResourceIamPolicyDelete(newUpdaterFunc NewResourceIamUpdaterFunc) schema.DeleteFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
+func ResourceIamPolicyDelete(newUpdaterFunc NewResourceIamUpdaterFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*transport_tpg.Config)
 
 		updater, err := newUpdaterFunc(d, config)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// Set an empty policy to delete the attached policy.
@@ -165,7 +167,7 @@ func ResourceIamPolicyDelete(newUpdaterFunc NewResourceIamUpdaterFunc) schema.De
 		pol.Version = IamPolicyVersion
 		err = updater.SetResourceIamPolicy(pol)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		return nil
@@ -19,30 +19,50 @@ package tpgresource
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
+// The CustomizeDiff func to set the values of terraform_annotations and effective_annotations fields
+// when annotations field is at the root level and named "annotations".
 func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	raw := d.Get("annotations")
 	if raw == nil {
 		return nil
 	}
 
+	if d.Get("terraform_annotations") == nil {
+		return fmt.Errorf("`terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("effective_annotations") == nil {
 		return fmt.Errorf("`effective_annotations` field is not present in the resource schema.")
 	}
 
-	// If "annotations" field is computed, set "effective_annotations" to computed.
+	// If "annotations" field is computed, set "terraform_annotations" and "effective_annotations" to computed.
 	// https://github.com/hashicorp/terraform-provider-google/issues/16217
 	if !d.GetRawPlan().GetAttr("annotations").IsWhollyKnown() {
+		if err := d.SetNewComputed("terraform_annotations"); err != nil {
+			return fmt.Errorf("error setting terraform_annotations to computed: %w", err)
+		}
+
 		if err := d.SetNewComputed("effective_annotations"); err != nil {
 			return fmt.Errorf("error setting effective_annotations to computed: %w", err)
 		}
 		return nil
 	}
 
-	o, n := d.GetChange("annotations")
+	config := meta.(*transport_tpg.Config)
+
+	terraformAnnotations := terraformManagedAnnotations(raw.(map[string]interface{}), config)
+	if err := d.SetNew("terraform_annotations", terraformAnnotations); err != nil {
+		return fmt.Errorf("error setting new terraform_annotations diff: %w", err)
+	}
+
+	o, n := d.GetChange("terraform_annotations")
 	effectiveAnnotations := d.Get("effective_annotations").(map[string]interface{})
 
 	for k, v := range n.(map[string]interface{}) {
@@ -50,7 +70,7 @@ func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interfac
 	}
 
 	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
+		if _, ok := n.(map[string]interface{})[k]; !ok && !config.IgnoreLabels.Ignored(k) {
 			delete(effectiveAnnotations, k)
 		}
 	}
@@ -71,8 +91,8 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 	// Fix the bug that the computed and nested "annotations" field disappears from the terraform plan.
 	// https://github.com/hashicorp/terraform-provider-google/issues/17756
 	// The bug is introduced by SetNew on "metadata" field with the object including "effective_annotations".
-	// "effective_annotations" cannot be set directly due to a bug that SetNew doesn't work on nested fields
-	// in terraform sdk.
+	// "terraform_annotations" and "effective_annotations" cannot be set directly due to a bug that SetNew
+	// doesn't work on nested fields in terraform sdk.
 	// https://github.com/hashicorp/terraform-plugin-sdk/issues/459
 	values := d.GetRawPlan().GetAttr("metadata").AsValueSlice()
 	if len(values) > 0 && !values[0].GetAttr("annotations").IsWhollyKnown() {
@@ -84,11 +104,24 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 		return nil
 	}
 
+	if d.Get("metadata.0.terraform_annotations") == nil {
+		return fmt.Errorf("`metadata.0.terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("metadata.0.effective_annotations") == nil {
 		return fmt.Errorf("`metadata.0.effective_annotations` field is not present in the resource schema.")
 	}
 
-	o, n := d.GetChange("metadata.0.annotations")
+	config := meta.(*transport_tpg.Config)
+
+	original := l[0].(map[string]interface{})
+
+	original["terraform_annotations"] = terraformManagedAnnotations(raw.(map[string]interface{}), config)
+	if err := d.SetNew("metadata", []interface{}{original}); err != nil {
+		return fmt.Errorf("error setting new metadata diff: %w", err)
+	}
+
+	o, n := d.GetChange("metadata.0.terraform_annotations")
 	effectiveAnnotations := d.Get("metadata.0.effective_annotations").(map[string]interface{})
 
 	for k, v := range n.(map[string]interface{}) {
@@ -96,14 +129,12 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 	}
 
 	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
+		if _, ok := n.(map[string]interface{})[k]; !ok && !config.IgnoreLabels.Ignored(k) {
 			delete(effectiveAnnotations, k)
 		}
 	}
 
-	original := l[0].(map[string]interface{})
 	original["effective_annotations"] = effectiveAnnotations
-
 	if err := d.SetNew("metadata", []interface{}{original}); err != nil {
 		return fmt.Errorf("error setting new metadata diff: %w", err)
 	}
@@ -111,6 +142,26 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// terraformManagedAnnotations merges provider default annotations with the user defined annotations in
+// the resource to get terraform managed annotations.
+func terraformManagedAnnotations(annotations map[string]interface{}, config *transport_tpg.Config) map[string]string {
+	terraformAnnotations := make(map[string]string)
+	for k, v := range config.DefaultAnnotations {
+		terraformAnnotations[k] = v
+	}
+	for k, v := range annotations {
+		terraformAnnotations[k] = v.(string)
+	}
+
+	// Annotations the user configured are managed even if ignore_labels matches them.
+	for k := range terraformAnnotations {
+		if _, ok := annotations[k]; !ok && config.IgnoreLabels.Ignored(k) {
+			delete(terraformAnnotations, k)
+		}
+	}
+	return terraformAnnotations
+}
+
 // Sets the "annotations" field with the value of the field "effective_annotations" for data sources.
 // When reading data source, as the annotations field is unavailable in the configuration of the data source,
 // the "annotations" field will be empty. With this function, the labels "annotations" will have all of annotations in the resource.
@@ -129,3 +180,23 @@ func SetDataSourceAnnotations(d *schema.ResourceData) error {
 
 	return nil
 }
+
+// Upgrade the field "terraform_annotations" in the state, at the root level or in the "metadata" block,
+// to have the value of field "annotations" when it is not set but "annotations" field is set in the state
+func TerraformAnnotationsStateUpgrade(rawState map[string]interface{}) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", rawState)
+
+	if rawState["terraform_annotations"] == nil && rawState["annotations"] != nil {
+		rawState["terraform_annotations"] = rawState["annotations"]
+	}
+
+	if l, ok := rawState["metadata"].([]interface{}); ok && len(l) > 0 {
+		if metadata, ok := l[0].(map[string]interface{}); ok && metadata["terraform_annotations"] == nil && metadata["annotations"] != nil {
+			metadata["terraform_annotations"] = metadata["annotations"]
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", rawState)
+
+	return rawState, nil
+}
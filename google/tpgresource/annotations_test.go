@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/annotations_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func testAnnotationsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetAnnotationsDiff,
+	}
+}
+
+func TestSetAnnotationsDiff_defaultAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		defaults    map[string]string
+		state       map[string]string
+		annotations map[string]interface{}
+		expected    map[string]string
+		removed     []string
+	}{
+		"adds default annotations": {
+			defaults:    map[string]string{"team": "data"},
+			annotations: map[string]interface{}{"env": "prod"},
+			expected: map[string]string{
+				"terraform_annotations.team": "data",
+				"terraform_annotations.env":  "prod",
+				"effective_annotations.team": "data",
+				"effective_annotations.env":  "prod",
+			},
+		},
+		"resource annotations override default annotations": {
+			defaults:    map[string]string{"env": "dev"},
+			annotations: map[string]interface{}{"env": "prod"},
+			expected: map[string]string{
+				"terraform_annotations.env": "prod",
+				"effective_annotations.env": "prod",
+			},
+		},
+		"removes annotations dropped from default annotations": {
+			defaults: map[string]string{},
+			state: map[string]string{
+				"annotations.%":                  "1",
+				"annotations.env":                "prod",
+				"terraform_annotations.%":        "2",
+				"terraform_annotations.env":      "prod",
+				"terraform_annotations.team":     "data",
+				"effective_annotations.%":        "3",
+				"effective_annotations.env":      "prod",
+				"effective_annotations.team":     "data",
+				"effective_annotations.external": "x",
+			},
+			annotations: map[string]interface{}{"env": "prod"},
+			removed:     []string{"terraform_annotations.team", "effective_annotations.team"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := &transport_tpg.Config{DefaultAnnotations: tc.defaults}
+			diff := testPlanDiff(t, testAnnotationsResource(), tc.state, map[string]interface{}{"annotations": tc.annotations}, config)
+
+			for k, v := range tc.expected {
+				if a, ok := diff.Attributes[k]; !ok || a.New != v {
+					t.Errorf("expected %s to be planned as %q, got %#v", k, v, a)
+				}
+			}
+			for _, k := range tc.removed {
+				if a, ok := diff.Attributes[k]; !ok || !a.NewRemoved {
+					t.Errorf("expected %s to be removed, got %#v", k, a)
+				}
+			}
+			if a, ok := diff.Attributes["effective_annotations.external"]; ok && a.NewRemoved {
+				t.Errorf("expected annotations not managed by Terraform to be kept")
+			}
+		})
+	}
+}
+
+func TestTerraformAnnotationsStateUpgrade(t *testing.T) {
+	rawState := map[string]interface{}{
+		"annotations": map[string]interface{}{"env": "prod"},
+		"metadata": []interface{}{
+			map[string]interface{}{"annotations": map[string]interface{}{"team": "data"}},
+		},
+	}
+
+	upgraded, err := TerraformAnnotationsStateUpgrade(rawState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := upgraded["terraform_annotations"]; !reflect.DeepEqual(got, map[string]interface{}{"env": "prod"}) {
+		t.Errorf("unexpected terraform_annotations: %v", got)
+	}
+	metadata := upgraded["metadata"].([]interface{})[0].(map[string]interface{})
+	if got := metadata["terraform_annotations"]; !reflect.DeepEqual(got, map[string]interface{}{"team": "data"}) {
+		t.Errorf("unexpected metadata terraform_annotations: %v", got)
+	}
+}
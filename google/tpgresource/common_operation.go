@@ -17,12 +17,18 @@
 package tpgresource
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 )
 
@@ -114,6 +120,226 @@ func (w *CommonOperationWaiter) TargetStates() []string {
 	return []string{"done: true"}
 }
 
+// EstimatingWaiter is implemented by waiters that can estimate when their
+// operation will be done, so that OperationWait doesn't poll it more often
+// than needed.
+type EstimatingWaiter interface {
+	Waiter
+
+	// EstimatedEndTime returns when the operation is estimated to be done,
+	// or the zero time if unknown.
+	EstimatedEndTime() time.Time
+}
+
+// EstimatedEndTime estimates when the operation will be done from its
+// metadata: an estimated end time if there is one, or else the time since
+// it started and its progressPercent.
+func (w *CommonOperationWaiter) EstimatedEndTime() time.Time {
+	if w == nil || len(w.Op.Metadata) == 0 {
+		return time.Time{}
+	}
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(w.Op.Metadata, &metadata); err != nil {
+		return time.Time{}
+	}
+	return OperationEstimatedEndTime(metadata, time.Now())
+}
+
+// OperationEstimatedEndTime estimates when an operation will be done from
+// its metadata at time now, or returns the zero time if it can't. Progress
+// may also be reported in a "progress" message, as by Spanner.
+func OperationEstimatedEndTime(metadata map[string]interface{}, now time.Time) time.Time {
+	if progress, ok := metadata["progress"].(map[string]interface{}); ok {
+		if eta := OperationEstimatedEndTime(progress, now); !eta.IsZero() {
+			return eta
+		}
+	}
+
+	for _, field := range []string{"estimatedEndTime", "estimatedCompletionTime"} {
+		if v, ok := metadata[field].(string); ok {
+			if eta, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				return eta
+			}
+		}
+	}
+
+	percent, ok := metadata["progressPercent"].(float64)
+	if !ok || percent <= 0 || percent >= 100 {
+		return time.Time{}
+	}
+	for _, field := range []string{"startTime", "createTime"} {
+		v, ok := metadata[field].(string)
+		if !ok {
+			continue
+		}
+		start, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil || start.After(now) {
+			continue
+		}
+		elapsed := now.Sub(start)
+		return now.Add(time.Duration(float64(elapsed) * (100 - percent) / percent))
+	}
+	return time.Time{}
+}
+
+// CancelableWaiter is implemented by waiters that stop waiting when a
+// context is done, such as the provider's Config.Context, which is done
+// when Terraform is interrupted. OperationWait then cancels the operation so
+// that it doesn't keep running.
+type CancelableWaiter interface {
+	Waiter
+
+	// WaitContext returns the context that stops the wait, or nil.
+	WaitContext() context.Context
+
+	// CancelOp asks the API to cancel the operation, returning
+	// transport_tpg.ErrCancelNotSupported if the API doesn't support it.
+	CancelOp() error
+}
+
+// LimitedWaiter is implemented by waiters whose operations count towards the
+// provider's max_concurrent_operations. OperationWait holds a slot for the
+// operation's project while waiting on it, and releases it once it's done.
+type LimitedWaiter interface {
+	Waiter
+
+	// HoldOperationSlot is transport_tpg.Config.HoldOperationSlot for the
+	// operation's project.
+	HoldOperationSlot() (func(), error)
+}
+
+// ResumableWaiter is implemented by waiters whose operations can be waited
+// on again by a later refresh or apply if waiting stops before they're done,
+// e.g. because it timed out. OperationWait records the operation while
+// waiting on it, see transport_tpg.Config.WithOperationRecorder.
+type ResumableWaiter interface {
+	Waiter
+
+	// PendingOperation describes the operation for ResumeOperationWait, or
+	// returns nil if waiting on it can't be resumed.
+	PendingOperation() *transport_tpg.PendingOperation
+
+	// OperationRecorder returns the recorder the operation is recorded to,
+	// or nil.
+	OperationRecorder() *transport_tpg.OperationRecorder
+}
+
+// TracedWaiter is implemented by waiters that poll their operation through a
+// Config, so that OperationWait's span is a child of the span of the resource
+// function waiting on the operation, and the polls are children of it.
+type TracedWaiter interface {
+	Waiter
+
+	// TraceContext returns the context carrying the span of the resource
+	// function, or nil.
+	TraceContext() context.Context
+
+	// SetTraceContext makes the waiter poll with requests traced as children
+	// of the span in ctx.
+	SetTraceContext(ctx context.Context)
+}
+
+// OperationProgress is the progress reported by a running operation.
+type OperationProgress struct {
+	// Stage is the name of the stage the operation is in, if known.
+	Stage string
+
+	// Percent is how complete the operation is, or -1 if unknown.
+	Percent int
+}
+
+// noProgress is the progress of an operation that doesn't report any.
+var noProgress = OperationProgress{Percent: -1}
+
+func (p OperationProgress) String() string {
+	switch {
+	case p.Stage != "" && p.Percent >= 0:
+		return fmt.Sprintf("%s (%d%%)", p.Stage, p.Percent)
+	case p.Percent >= 0:
+		return fmt.Sprintf("%d%%", p.Percent)
+	}
+	return p.Stage
+}
+
+// ProgressWaiter is implemented by waiters whose operations report their
+// progress, which OperationWait logs as it changes.
+type ProgressWaiter interface {
+	Waiter
+
+	// Progress returns the progress of the operation as of the last poll.
+	Progress() OperationProgress
+}
+
+// Progress returns the progress reported in the operation's metadata.
+func (w *CommonOperationWaiter) Progress() OperationProgress {
+	if w == nil || len(w.Op.Metadata) == 0 {
+		return noProgress
+	}
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(w.Op.Metadata, &metadata); err != nil {
+		return noProgress
+	}
+	return OperationMetadataProgress(metadata)
+}
+
+// OperationMetadataProgress returns the progress reported in an operation's
+// metadata, either directly or in a "progress" message.
+func OperationMetadataProgress(metadata map[string]interface{}) OperationProgress {
+	p := noProgress
+	if progress, ok := metadata["progress"].(map[string]interface{}); ok {
+		p = OperationMetadataProgress(progress)
+	}
+
+	if v, ok := metadata["progressPercent"].(float64); ok && p.Percent < 0 {
+		p.Percent = int(v)
+	}
+	for _, field := range []string{"stage", "currentStage", "statusDetail", "statusMessage"} {
+		if v, ok := metadata[field].(string); ok && v != "" && p.Stage == "" {
+			p.Stage = v
+		}
+	}
+	return p
+}
+
+// progressReporterKey is the key in a context of the func operation progress
+// is reported to, see ContextWithProgressReporter.
+type progressReporterKey struct{}
+
+// ContextWithProgressReporter returns a context in which the progress of
+// operations waited on is also reported to report, as a message naming the
+// activity and its progress, each time it changes. It reaches the waiter
+// through the context of its config, see
+// transport_tpg.Config.WithResourceContext.
+func ContextWithProgressReporter(ctx context.Context, report func(message string)) context.Context {
+	return context.WithValue(ctx, progressReporterKey{}, report)
+}
+
+// logOperationProgress logs an operation's progress at INFO level, with
+// tflog if the waiter's context carries the logger of a resource function,
+// see transport_tpg.Config.WithResourceContext, and reports it as a progress
+// event if the context carries a reporter, see ContextWithProgressReporter.
+func logOperationProgress(ctx context.Context, name, activity string, p OperationProgress) {
+	if ctx == nil {
+		log.Printf("[INFO] Operation %s for %s is at %s", name, activity, p)
+		return
+	}
+	fields := map[string]interface{}{
+		"operation": name,
+		"activity":  activity,
+	}
+	if p.Stage != "" {
+		fields["stage"] = p.Stage
+	}
+	if p.Percent >= 0 {
+		fields["percent_complete"] = p.Percent
+	}
+	tflog.Info(ctx, "Operation progress", fields)
+
+	if report, ok := ctx.Value(progressReporterKey{}).(func(string)); ok {
+		report(fmt.Sprintf("%s: %s", activity, p))
+	}
+}
+
 func OperationDone(w Waiter) bool {
 	for _, s := range w.TargetStates() {
 		if s == w.State() {
@@ -152,24 +378,95 @@ func CommonRefreshFunc(w Waiter) retry.StateRefreshFunc {
 	}
 }
 
-func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
+func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) (err error) {
+	tw, traced := w.(TracedWaiter)
+	var traceCtx context.Context
+	if traced {
+		traceCtx = tw.TraceContext()
+	}
+	traceCtx, span := transport_tpg.StartSpan(traceCtx, "OperationWait",
+		attribute.String("operation.name", w.OpName()),
+		attribute.String("operation.activity", activity),
+	)
+	defer func() { transport_tpg.EndSpan(span, err) }()
+	if traced {
+		tw.SetTraceContext(traceCtx)
+	}
+
 	if OperationDone(w) {
 		return w.Error()
 	}
 
-	c := &retry.StateChangeConf{
-		Pending:      w.PendingStates(),
-		Target:       w.TargetStates(),
-		Refresh:      CommonRefreshFunc(w),
-		Timeout:      timeout,
-		MinTimeout:   2 * time.Second,
-		PollInterval: pollInterval,
+	if lw, ok := w.(LimitedWaiter); ok {
+		release, err := lw.HoldOperationSlot()
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	var recorder *transport_tpg.OperationRecorder
+	if rw, ok := w.(ResumableWaiter); ok {
+		if pending := rw.PendingOperation(); pending != nil {
+			recorder = rw.OperationRecorder()
+			recorder.Record(pending)
+		}
+	}
+
+	cw, cancelable := w.(CancelableWaiter)
+	var waitCtx, logCtx context.Context
+	if cancelable {
+		waitCtx = cw.WaitContext()
+		logCtx = transport_tpg.LogContext(waitCtx)
+	}
+
+	refresh := CommonRefreshFunc(w)
+	pw, reportsProgress := w.(ProgressWaiter)
+	progress := noProgress
+	c := &transport_tpg.StateWaitConf{
+		Pending: w.PendingStates(),
+		Target:  w.TargetStates(),
+		Refresh: func() (interface{}, string, error) {
+			op, state, err := refresh()
+			span.AddEvent("poll", trace.WithAttributes(attribute.String("operation.state", state)))
+			if reportsProgress && err == nil && op != nil {
+				if p := pw.Progress(); p != noProgress && p != progress {
+					progress = p
+					logOperationProgress(logCtx, w.OpName(), activity, p)
+				}
+			}
+			return op, state, err
+		},
+		Timeout:         timeout,
+		MaxPollInterval: pollInterval,
+		Context:         waitCtx,
+	}
+	if e, ok := w.(EstimatingWaiter); ok {
+		c.EstimatedEndTime = e.EstimatedEndTime
 	}
 	opRaw, err := c.WaitForState()
 	if err != nil {
+		if cancelable && c.Context != nil && c.Context.Err() != nil {
+			// Terraform was interrupted, so don't leave the operation
+			// running. Operations that time out are left running, so that
+			// the next apply can resume waiting on them.
+			cancelErr := cw.CancelOp()
+			transport_tpg.LogOperationCancel(w.OpName(), cancelErr)
+			if cancelErr == nil {
+				recorder.Done(w.OpName())
+			}
+		}
+		if OperationDone(w) {
+			recorder.Done(w.OpName())
+		}
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) && progress != noProgress {
+			return fmt.Errorf("Error waiting for %s (last stage: %s): %w", activity, progress, err)
+		}
 		return fmt.Errorf("Error waiting for %s: %w", activity, err)
 	}
 
+	recorder.Done(w.OpName())
 	err = w.SetOp(opRaw)
 	if err != nil {
 		return err
@@ -178,6 +475,27 @@ func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterva
 	return w.Error()
 }
 
+// ResumeOperationWait waits on an operation that a previous apply was still
+// waiting on when it failed, polling it as its kind does. As for
+// OperationWait, an error embedded in the operation is returned.
+func ResumeOperationWait(config *transport_tpg.Config, pending *transport_tpg.PendingOperation, activity string, timeout time.Duration) error {
+	registered, ok := operationKinds[pending.Kind]
+	if !ok {
+		return fmt.Errorf("unknown kind %q of operation %s", pending.Kind, pending.Name)
+	}
+	// The operation is polled where it was, as the URL of some kinds
+	// depends on fields of the operation that aren't kept.
+	kind := *registered
+	kind.Url = func(*OperationWaiter) string {
+		return pending.Url
+	}
+	w, err := NewOperationWaiter(config, &kind, map[string]interface{}{"name": pending.Name}, pending.BaseUrl, pending.Project, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	return OperationWait(w, activity, timeout, config.PollInterval)
+}
+
 // The cloud resource manager API operation is an example of one of many
 // interchangeable API operations. Choose it somewhat arbitrarily to represent
 // the "common" operation.
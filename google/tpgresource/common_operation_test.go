@@ -17,10 +17,18 @@
 package tpgresource
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
 )
 
@@ -83,3 +91,305 @@ func TestOperationWait_TimeoutsShouldRetry(t *testing.T) {
 			expectedRunCount, testWaiter.runCount)
 	}
 }
+
+func TestResumeOperationWait(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			fmt.Fprint(w, `{"name": "operations/op"}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "operations/op", "done": true, "error": {"code": 9, "message": "failed"}}`)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{Client: ts.Client()}
+	pending := &transport_tpg.PendingOperation{Kind: AipOperation.Name, Name: "operations/op", Url: ts.URL + "/v1/operations/op"}
+	err := ResumeOperationWait(config, pending, "my-activity", time.Minute)
+
+	var opErr *CommonOpError
+	if !errors.As(err, &opErr) || opErr.Message != "failed" {
+		t.Errorf("expected the operation's error, got %v", err)
+	}
+	if polls != 2 {
+		t.Errorf("expected the operation to be polled 2 times, was polled %d times", polls)
+	}
+
+	if err := ResumeOperationWait(config, &transport_tpg.PendingOperation{Kind: "unknown", Name: "operations/op"}, "my-activity", time.Minute); err == nil {
+		t.Errorf("expected an error for an unknown kind of operation")
+	}
+}
+
+type spanRecordingTransport struct {
+	spans []trace.SpanContext
+}
+
+func (rt *spanRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.spans = append(rt.spans, trace.SpanFromContext(req.Context()).SpanContext())
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestOperationWait_tracesUnderCallerSpan(t *testing.T) {
+	t.Setenv(transport_tpg.TracesFileEnvVar, t.TempDir()+"/traces.json")
+	shutdown, err := transport_tpg.InitTracing(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("initializing tracing: %v", err)
+	}
+	defer shutdown(context.Background())
+
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			fmt.Fprint(w, `{"name": "operations/op"}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "operations/op", "done": true}`)
+	}))
+	defer ts.Close()
+
+	resourceCtx, resourceSpan := transport_tpg.StartSpan(context.Background(), "resource")
+	defer resourceSpan.End()
+
+	rt := &spanRecordingTransport{}
+	config := (&transport_tpg.Config{Client: &http.Client{Transport: rt}}).WithTraceContext(resourceCtx)
+	w := &OperationWaiter{Config: config, Kind: AipOperation, BaseUrl: ts.URL + "/v1/"}
+	if err := w.SetOp(map[string]interface{}{"name": "operations/op"}); err != nil {
+		t.Fatalf("setting the operation: %v", err)
+	}
+	if err := OperationWait(w, "my-activity", time.Minute, 0); err != nil {
+		t.Fatalf("waiting for the operation: %v", err)
+	}
+
+	if len(rt.spans) == 0 {
+		t.Fatalf("expected the operation to be polled")
+	}
+	resource := resourceSpan.SpanContext()
+	for _, span := range rt.spans {
+		if span.TraceID() != resource.TraceID() {
+			t.Errorf("expected the poll to be traced in the resource's trace %s, got %s", resource.TraceID(), span.TraceID())
+		}
+		if span.SpanID() == resource.SpanID() {
+			t.Errorf("expected the poll to be traced under the wait's span, not the resource's")
+		}
+	}
+}
+
+func TestOperationWait_recordsPendingOperation(t *testing.T) {
+	done := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"name": "operations/op", "done": %t}`, done)
+	}))
+	defer ts.Close()
+
+	recorder := transport_tpg.NewOperationRecorder(func() string { return "channels/c" })
+	config := (&transport_tpg.Config{Client: ts.Client()}).WithOperationRecorder(recorder)
+	op := map[string]interface{}{"name": "operations/op"}
+
+	w, err := NewOperationWaiter(config, AipOperation, op, ts.URL+"/v1/", "my-project", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := OperationWait(w, "my-activity", time.Second, time.Millisecond); err == nil {
+		t.Fatalf("expected waiting to time out")
+	}
+	expected := transport_tpg.PendingOperation{
+		Kind:       "aip",
+		Name:       "operations/op",
+		Url:        ts.URL + "/v1/operations/op",
+		BaseUrl:    ts.URL + "/v1/",
+		Project:    "my-project",
+		ResourceId: "channels/c",
+	}
+	if p := recorder.Pending(); p == nil || *p != expected {
+		t.Fatalf("expected pending operation %+v, got %+v", expected, p)
+	}
+
+	done = true
+	if err := ResumeOperationWait(config, recorder.Pending(), "my-activity", time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := recorder.Pending(); p != nil {
+		t.Errorf("expected no pending operation once done, got %+v", p)
+	}
+}
+
+func TestOperationEstimatedEndTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		metadata map[string]interface{}
+		expected time.Time
+	}{
+		"no progress": {
+			metadata: map[string]interface{}{"createTime": "2024-01-01T11:00:00Z"},
+		},
+		"estimated end time": {
+			metadata: map[string]interface{}{"estimatedEndTime": "2024-01-01T12:05:00Z", "progressPercent": 10.0},
+			expected: now.Add(5 * time.Minute),
+		},
+		"progress percent": {
+			metadata: map[string]interface{}{"createTime": "2024-01-01T11:50:00Z", "progressPercent": 50.0},
+			expected: now.Add(10 * time.Minute),
+		},
+		"progress message": {
+			metadata: map[string]interface{}{"progress": map[string]interface{}{"startTime": "2024-01-01T11:45:00Z", "progressPercent": 75.0}},
+			expected: now.Add(5 * time.Minute),
+		},
+		"no progress yet": {
+			metadata: map[string]interface{}{"createTime": "2024-01-01T11:50:00Z", "progressPercent": 0.0},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if eta := OperationEstimatedEndTime(tc.metadata, now); !eta.Equal(tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, eta)
+			}
+		})
+	}
+}
+
+type cancelableTestWaiter struct {
+	TestWaiter
+	ctx       context.Context
+	cancelled bool
+}
+
+func (w *cancelableTestWaiter) State() string {
+	return "RUNNING"
+}
+
+func (w *cancelableTestWaiter) PendingStates() []string {
+	return []string{"RUNNING"}
+}
+
+func (w *cancelableTestWaiter) WaitContext() context.Context {
+	return w.ctx
+}
+
+func (w *cancelableTestWaiter) CancelOp() error {
+	w.cancelled = true
+	return nil
+}
+
+func TestOperationWait_cancelsOnInterrupt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := &cancelableTestWaiter{ctx: ctx}
+	if err := OperationWait(w, "my-activity", time.Minute, time.Second); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the wait to be interrupted, got %v", err)
+	}
+	if !w.cancelled {
+		t.Errorf("expected the operation to be cancelled")
+	}
+
+	w = &cancelableTestWaiter{ctx: context.Background()}
+	if err := OperationWait(w, "my-activity", time.Millisecond, time.Millisecond); err == nil {
+		t.Errorf("expected the wait to time out")
+	}
+	if w.cancelled {
+		t.Errorf("expected an operation that timed out not to be cancelled")
+	}
+}
+
+func TestOperationMetadataProgress(t *testing.T) {
+	cases := map[string]struct {
+		metadata map[string]interface{}
+		expected OperationProgress
+	}{
+		"no progress": {
+			metadata: map[string]interface{}{"createTime": "2024-01-01T11:00:00Z"},
+			expected: OperationProgress{Percent: -1},
+		},
+		"progress percent": {
+			metadata: map[string]interface{}{"progressPercent": 40.0},
+			expected: OperationProgress{Percent: 40},
+		},
+		"status message": {
+			metadata: map[string]interface{}{"statusMessage": "Creating instance", "verb": "create"},
+			expected: OperationProgress{Stage: "Creating instance", Percent: -1},
+		},
+		"progress message": {
+			metadata: map[string]interface{}{"stage": "UPGRADE", "progress": map[string]interface{}{"progressPercent": 75.0}},
+			expected: OperationProgress{Stage: "UPGRADE", Percent: 75},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if p := OperationMetadataProgress(tc.metadata); p != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, p)
+			}
+		})
+	}
+}
+
+type progressTestWaiter struct {
+	cancelableTestWaiter
+}
+
+func (w *progressTestWaiter) QueryOp() (interface{}, error) {
+	return "my return value", nil
+}
+
+func (w *progressTestWaiter) Progress() OperationProgress {
+	return OperationProgress{Stage: "Creating nodes", Percent: 40}
+}
+
+func TestOperationWait_timeoutIncludesLastStage(t *testing.T) {
+	w := &progressTestWaiter{cancelableTestWaiter{ctx: context.Background()}}
+	err := OperationWait(w, "my-activity", 10*time.Millisecond, time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "last stage: Creating nodes (40%)") {
+		t.Errorf("expected the timeout error to include the last stage, got %v", err)
+	}
+}
+
+func TestOperationWait_reportsProgress(t *testing.T) {
+	var messages []string
+	ctx := ContextWithProgressReporter(context.Background(), func(message string) {
+		messages = append(messages, message)
+	})
+	config := (&transport_tpg.Config{Context: context.Background()}).WithResourceContext(ctx)
+
+	w := &progressTestWaiter{cancelableTestWaiter{ctx: config.Context}}
+	if err := OperationWait(w, "my-activity", 10*time.Millisecond, time.Millisecond); err == nil {
+		t.Fatalf("expected waiting to time out")
+	}
+	// Progress is only reported when it changes.
+	if len(messages) != 1 || messages[0] != "my-activity: Creating nodes (40%)" {
+		t.Errorf("expected a single progress event, got %q", messages)
+	}
+}
+
+func TestOperationWait_reportsOperationMetadataProgress(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		switch polls {
+		case 1, 2:
+			fmt.Fprint(w, `{"name": "operations/op", "metadata": {"stage": "Provisioning", "progressPercent": 10}}`)
+		case 3:
+			fmt.Fprint(w, `{"name": "operations/op", "metadata": {"stage": "Starting", "progressPercent": 80}}`)
+		default:
+			fmt.Fprint(w, `{"name": "operations/op", "done": true}`)
+		}
+	}))
+	defer ts.Close()
+
+	var messages []string
+	ctx := ContextWithProgressReporter(context.Background(), func(message string) {
+		messages = append(messages, message)
+	})
+	config := (&transport_tpg.Config{Client: ts.Client(), Context: context.Background()}).WithResourceContext(ctx)
+	w := &OperationWaiter{Config: config, Kind: AipOperation, BaseUrl: ts.URL + "/v1/"}
+	if err := w.SetOp(map[string]interface{}{"name": "operations/op"}); err != nil {
+		t.Fatalf("setting the operation: %v", err)
+	}
+	if err := OperationWait(w, "my-activity", time.Minute, time.Millisecond); err != nil {
+		t.Fatalf("waiting for the operation: %v", err)
+	}
+
+	expected := []string{"my-activity: Provisioning (10%)", "my-activity: Starting (80%)"}
+	if strings.Join(messages, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected progress events %q, got %q", expected, messages)
+	}
+}
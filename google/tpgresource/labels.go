@@ -18,9 +18,14 @@ package tpgresource
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
@@ -31,13 +36,22 @@ import (
 // So the field "labels" and "terraform_labels" in the state will only have the user defined labels.
 // param "labels" is all of labels returned from API read reqeust.
 // param "lineage" is the terraform lineage of the field and could be "labels" or "terraform_labels".
-func SetLabels(labels map[string]string, d *schema.ResourceData, lineage string) error {
+// Labels rewritten by label_normalization and labels ignored by ignore_labels that aren't in the labels
+// field keep their configured values.
+func SetLabels(labels map[string]string, d *schema.ResourceData, lineage string, config *transport_tpg.Config) error {
 	transformed := make(map[string]interface{})
 
 	if v, ok := d.GetOk(lineage); ok {
 		if labels != nil {
-			for k := range v.(map[string]interface{}) {
-				transformed[k] = labels[k]
+			read := make(map[string]interface{}, len(labels))
+			for k, l := range labels {
+				read[k] = l
+			}
+			transformed = flattenConfiguredLabels(v.(map[string]interface{}), userConfiguredLabels(d, lineage), read, config)
+			for k, value := range transformed {
+				if value == nil {
+					transformed[k] = ""
+				}
 			}
 		}
 	}
@@ -45,6 +59,96 @@ func SetLabels(labels map[string]string, d *schema.ResourceData, lineage string)
 	return d.Set(lineage, transformed)
 }
 
+// FlattenLabels is called by the flatteners of generated resources to flatten
+// the field "labels" or "terraform_labels" the same way SetLabels sets it.
+// param "v" is all of labels returned from API read request.
+func FlattenLabels(v interface{}, d *schema.ResourceData, lineage string, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOkExists(lineage); ok {
+		transformed = flattenConfiguredLabels(l.(map[string]interface{}), userConfiguredLabels(d, lineage), v.(map[string]interface{}), config)
+	}
+
+	return transformed
+}
+
+// FlattenLabelBlocks is FlattenLabels for labels fields that are sets of
+// key/value blocks instead of maps, like the labels of Deployment Manager
+// deployments. It reads the API's list of {key, value} objects and returns the
+// configured labels in the same form.
+func FlattenLabelBlocks(v interface{}, d *schema.ResourceData, lineage string, config *transport_tpg.Config) []interface{} {
+	labels := labelBlocksToMap(v.([]interface{}))
+	configured := make(map[string]interface{})
+	if l, ok := d.GetOkExists(lineage); ok {
+		configured = labelBlocksToMap(l.(*schema.Set).List())
+	}
+
+	flattened := flattenConfiguredLabels(configured, configured, labels, config)
+	transformed := make([]interface{}, 0, len(flattened))
+	for k, v := range flattened {
+		if _, ok := labels[k]; !ok && v == nil {
+			// The label was removed outside of Terraform.
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{"key": k, "value": v})
+	}
+	return transformed
+}
+
+func labelBlocksToMap(blocks []interface{}) map[string]interface{} {
+	labels := make(map[string]interface{}, len(blocks))
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]interface{})
+		if !ok || block["key"] == nil {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		labels[block["key"].(string)] = block["value"]
+	}
+	return labels
+}
+
+// flattenConfiguredLabels returns the values read from the API of the
+// configured labels. Labels rewritten by label_normalization are read by their
+// normalized keys, and keep their configured values if they're unchanged.
+// Labels ignored by ignore_labels keep their configured values unless the user
+// configured them in the labels field.
+func flattenConfiguredLabels(configured, user, labels map[string]interface{}, config *transport_tpg.Config) map[string]interface{} {
+	transformed := make(map[string]interface{}, len(configured))
+	for k, c := range configured {
+		if _, ok := user[k]; !ok && config.IgnoreLabels.Ignored(k) {
+			transformed[k] = c
+			continue
+		}
+
+		value, ok := labels[k]
+		if config.LabelNormalization {
+			normalizedKey, normalizedValue := NormalizeLabel(k, c.(string))
+			if !ok {
+				value = labels[normalizedKey]
+			}
+			if value == normalizedValue {
+				value = c
+			}
+		}
+		transformed[k] = value
+	}
+	return transformed
+}
+
+// userConfiguredLabels returns the labels the user configured for the field at
+// lineage, which are in the sibling "labels" field of a "terraform_labels" field.
+func userConfiguredLabels(d *schema.ResourceData, lineage string) map[string]interface{} {
+	if strings.HasSuffix(lineage, "terraform_labels") {
+		lineage = strings.TrimSuffix(lineage, "terraform_labels") + "labels"
+	}
+	labels, _ := d.Get(lineage).(map[string]interface{})
+	return labels
+}
+
 // Sets the "labels" field and "terraform_labels" with the value of the field "effective_labels" for data sources.
 // When reading data source, as the labels field is unavailable in the configuration of the data source,
 // the "labels" field will be empty. With this function, the labels "field" will have all of labels in the resource.
@@ -72,7 +176,7 @@ func SetDataSourceLabels(d *schema.ResourceData) error {
 }
 
 // Sets the values of terraform_labels and effective_labels fields when labels field is in root level
-func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{}, skipAttribution bool) error {
+func setLabelsFields(ctx context.Context, labelsField string, d *schema.ResourceDiff, meta interface{}, skipAttribution bool) error {
 	raw := d.Get(labelsField)
 	if raw == nil {
 		return nil
@@ -124,6 +228,18 @@ func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{
 		terraformLabels[k] = v.(string)
 	}
 
+	// Labels the user configured are managed even if ignore_labels matches them.
+	for k := range terraformLabels {
+		if _, ok := labels[k]; !ok && config.IgnoreLabels.Ignored(k) {
+			delete(terraformLabels, k)
+		}
+	}
+
+	terraformLabels, err := normalizeLabels(ctx, config, terraformLabels)
+	if err != nil {
+		return err
+	}
+
 	if err := d.SetNew("terraform_labels", terraformLabels); err != nil {
 		return fmt.Errorf("error setting new terraform_labels diff: %w", err)
 	}
@@ -136,7 +252,7 @@ func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{
 	}
 
 	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
+		if _, ok := n.(map[string]interface{})[k]; !ok && !config.IgnoreLabels.Ignored(k) {
 			delete(effectiveLabels, k)
 		}
 	}
@@ -145,28 +261,28 @@ func setLabelsFields(labelsField string, d *schema.ResourceDiff, meta interface{
 		return fmt.Errorf("error setting new effective_labels diff: %w", err)
 	}
 
-	return nil
+	return checkLabelPolicy(ctx, config, effectiveLabels)
 }
 
-func SetLabelsDiffWithoutAttributionLabel(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return setLabelsFields("labels", d, meta, true)
+func SetLabelsDiffWithoutAttributionLabel(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsFields(ctx, "labels", d, meta, true)
 }
 
 // The CustomizeDiff func to set the values of terraform_labels and effective_labels fields
 // when labels field is at the root level and named "labels".
-func SetLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return setLabelsFields("labels", d, meta, false)
+func SetLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsFields(ctx, "labels", d, meta, false)
 }
 
 // The CustomizeDiff func to set the values of terraform_labels and effective_labels fields
 // when labels field is at the root level and has a diffent name (e.g. resource_labels) than "labels"
 func SetDiffForLabelsWithCustomizedName(labelsField string) func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-		return setLabelsFields(labelsField, d, meta, false)
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return setLabelsFields(ctx, labelsField, d, meta, false)
 	}
 }
 
-func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+func SetMetadataLabelsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	l := d.Get("metadata").([]interface{})
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -221,6 +337,18 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 		terraformLabels[k] = v.(string)
 	}
 
+	// Labels the user configured are managed even if ignore_labels matches them.
+	for k := range terraformLabels {
+		if _, ok := labels[k]; !ok && config.IgnoreLabels.Ignored(k) {
+			delete(terraformLabels, k)
+		}
+	}
+
+	terraformLabels, err := normalizeLabels(ctx, config, terraformLabels)
+	if err != nil {
+		return err
+	}
+
 	original := l[0].(map[string]interface{})
 
 	original["terraform_labels"] = terraformLabels
@@ -236,7 +364,7 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	}
 
 	for k := range o.(map[string]interface{}) {
-		if _, ok := n.(map[string]interface{})[k]; !ok {
+		if _, ok := n.(map[string]interface{})[k]; !ok && !config.IgnoreLabels.Ignored(k) {
 			delete(effectiveLabels, k)
 		}
 	}
@@ -246,7 +374,29 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 		return fmt.Errorf("error setting new metadata diff: %w", err)
 	}
 
-	return nil
+	return checkLabelPolicy(ctx, config, effectiveLabels)
+}
+
+// checkLabelPolicy checks the labels a resource is planned to have against
+// the provider's label_policy, failing the plan or warning about them
+// depending on its mode. Terraform shows which resource the error or
+// warning is about.
+func checkLabelPolicy(ctx context.Context, config *transport_tpg.Config, effectiveLabels map[string]interface{}) error {
+	labels := make(map[string]string, len(effectiveLabels))
+	for k, v := range effectiveLabels {
+		labels[k], _ = v.(string)
+	}
+	violations := config.LabelPolicy.Violations(labels)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	detail := strings.Join(violations, "\n")
+	if config.LabelPolicy.Mode == transport_tpg.LabelPolicyModeWarn {
+		AddPlanWarning(ctx, "Labels violate the provider's label_policy", detail)
+		return nil
+	}
+	return fmt.Errorf("labels violate the provider's label_policy:\n%s", detail)
 }
 
 // Upgrade the field "labels" in the state to exclude the labels with the labels prefix
@@ -295,3 +445,72 @@ func TerraformLabelsStateUpgrade(rawState map[string]interface{}) (map[string]in
 
 	return rawState, nil
 }
+
+// maxLabelLength is the most characters label keys and values can have.
+const maxLabelLength = 63
+
+// invalidLabelChars matches the characters GCP rejects in labels: anything
+// but lowercase letters, letters without case (international characters),
+// digits, underscores and dashes.
+var invalidLabelChars = regexp.MustCompile(`[^\p{Ll}\p{Lo}\p{N}_-]`)
+
+// NormalizeLabel rewrites a label into a valid one: lowercase, with
+// characters other than letters, digits, underscores and dashes replaced by
+// underscores, and at most 63 characters long. Keys are also made to start
+// with a letter. Truncated keys and values end with a hash of the original,
+// so that labels that only differ after the 63rd character stay distinct.
+// Valid labels, including ones with international characters, are returned
+// unchanged.
+func NormalizeLabel(key, value string) (string, string) {
+	normalizedKey := normalizeLabelPart(key)
+	if first, _ := utf8.DecodeRuneInString(normalizedKey); !unicode.IsLetter(first) {
+		normalizedKey = normalizeLabelPart("k" + normalizedKey)
+	}
+	return normalizedKey, normalizeLabelPart(value)
+}
+
+func normalizeLabelPart(s string) string {
+	normalized := []rune(invalidLabelChars.ReplaceAllString(strings.ToLower(s), "_"))
+	if len(normalized) <= maxLabelLength {
+		return string(normalized)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(s)))[:8]
+	return string(normalized[:maxLabelLength-len(hash)-1]) + "-" + hash
+}
+
+// normalizeLabels rewrites labels with NormalizeLabel if label_normalization
+// is set, warning about each label it rewrote.
+func normalizeLabels(ctx context.Context, config *transport_tpg.Config, labels map[string]string) (map[string]string, error) {
+	if !config.LabelNormalization {
+		return labels, nil
+	}
+
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(labels))
+	originalKeys := make(map[string]string, len(labels))
+	var rewritten []string
+	for _, k := range keys {
+		nk, nv := NormalizeLabel(k, labels[k])
+		if other, ok := originalKeys[nk]; ok {
+			return nil, fmt.Errorf("labels %q and %q are both normalized to %q by label_normalization", other, k, nk)
+		}
+		originalKeys[nk] = k
+		normalized[nk] = nv
+		if nk != k {
+			rewritten = append(rewritten, fmt.Sprintf("key %q to %q", k, nk))
+		}
+		if nv != labels[k] {
+			rewritten = append(rewritten, fmt.Sprintf("value %q of %q to %q", labels[k], k, nv))
+		}
+	}
+
+	if len(rewritten) > 0 {
+		AddPlanWarning(ctx, "Labels rewritten by label_normalization", "Rewrote label "+strings.Join(rewritten, "\nRewrote label "))
+	}
+	return normalized, nil
+}
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/labels_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestCheckLabelPolicy(t *testing.T) {
+	policy := &transport_tpg.LabelPolicy{
+		RequiredKeys:  []string{"cost-center"},
+		AllowedValues: map[string]*regexp.Regexp{},
+		Mode:          transport_tpg.LabelPolicyModeError,
+	}
+	config := &transport_tpg.Config{LabelPolicy: policy}
+	labels := map[string]interface{}{"team": "data"}
+
+	if err := checkLabelPolicy(context.Background(), config, labels); err == nil {
+		t.Errorf("expected a violation to fail the plan")
+	}
+	if err := checkLabelPolicy(context.Background(), config, map[string]interface{}{"cost-center": "123"}); err != nil {
+		t.Errorf("unexpected error for compliant labels: %s", err)
+	}
+
+	policy.Mode = transport_tpg.LabelPolicyModeWarn
+	ctx, warnings := ContextWithPlanWarnings(context.Background())
+	if err := checkLabelPolicy(ctx, config, labels); err != nil {
+		t.Errorf("expected a violation only to warn, got %s", err)
+	}
+	if w := warnings.Warnings(); len(w) != 1 || w[0].Detail != `required label "cost-center" is missing` {
+		t.Errorf("expected a warning about the missing label, got %v", w)
+	}
+
+	if err := checkLabelPolicy(context.Background(), &transport_tpg.Config{}, labels); err != nil {
+		t.Errorf("unexpected error without a label_policy: %s", err)
+	}
+}
+
+func TestNormalizeLabel(t *testing.T) {
+	cases := map[string]struct {
+		key, value       string
+		wantKey, wantVal string
+	}{
+		"valid": {
+			key: "env", value: "prod",
+			wantKey: "env", wantVal: "prod",
+		},
+		"uppercase and invalid characters": {
+			key: "Cost Center", value: "Team.A",
+			wantKey: "cost_center", wantVal: "team_a",
+		},
+		"key starting with a digit": {
+			key: "1st", value: "",
+			wantKey: "k1st", wantVal: "",
+		},
+		"international characters": {
+			key: "équipe", value: "東京",
+			wantKey: "équipe", wantVal: "東京",
+		},
+		"international uppercase letters": {
+			key: "Équipe", value: "Ünit",
+			wantKey: "équipe", wantVal: "ünit",
+		},
+	}
+	for name, tc := range cases {
+		k, v := NormalizeLabel(tc.key, tc.value)
+		if k != tc.wantKey || v != tc.wantVal {
+			t.Errorf("%s: expected %q=%q, got %q=%q", name, tc.wantKey, tc.wantVal, k, v)
+		}
+	}
+
+	long := strings.Repeat("a", 70)
+	_, first := NormalizeLabel("k", long+"b")
+	_, second := NormalizeLabel("k", long+"c")
+	if len(first) != maxLabelLength || len(second) != maxLabelLength {
+		t.Errorf("expected truncated values to be %d characters, got %q and %q", maxLabelLength, first, second)
+	}
+	if first == second {
+		t.Errorf("expected different values to stay distinct after truncation, both got %q", first)
+	}
+	if _, again := NormalizeLabel("k", long+"b"); again != first {
+		t.Errorf("expected truncation to be deterministic, got %q and %q", first, again)
+	}
+
+	if _, v := NormalizeLabel("k", strings.Repeat("é", 70)); utf8.RuneCountInString(v) != maxLabelLength || !utf8.ValidString(v) {
+		t.Errorf("expected truncated values to be %d characters, got %q", maxLabelLength, v)
+	}
+}
+
+func TestNormalizeLabels(t *testing.T) {
+	config := &transport_tpg.Config{LabelNormalization: true}
+	ctx, warnings := ContextWithPlanWarnings(context.Background())
+
+	labels, err := normalizeLabels(ctx, config, map[string]string{"Env": "Prod", "team": "data"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(labels) != 2 || labels["env"] != "prod" || labels["team"] != "data" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+	want := "Rewrote label key \"Env\" to \"env\"\nRewrote label value \"Prod\" of \"Env\" to \"prod\""
+	if w := warnings.Warnings(); len(w) != 1 || w[0].Detail != want {
+		t.Errorf("expected a warning listing the rewrites, got %v", w)
+	}
+
+	if _, err := normalizeLabels(ctx, config, map[string]string{"env": "a", "Env": "b"}); err == nil {
+		t.Errorf("expected an error when two labels normalize to the same key")
+	}
+
+	unchanged := map[string]string{"Env": "Prod"}
+	if labels, _ := normalizeLabels(ctx, &transport_tpg.Config{}, unchanged); labels["Env"] != "Prod" {
+		t.Errorf("expected labels to be unchanged without label_normalization, got %v", labels)
+	}
+}
+
+func TestSetLabels_ignoreLabels(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"terraform_labels": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"labels":           map[string]interface{}{"env": "prod", "goog-owner": "me"},
+		"terraform_labels": map[string]interface{}{"env": "prod", "goog-owner": "me", "goog-default": "a"},
+	})
+	config := &transport_tpg.Config{
+		IgnoreLabels: &transport_tpg.IgnoreLabels{KeyPrefixes: []string{"goog-"}},
+	}
+
+	labels := map[string]string{"env": "dev", "goog-owner": "scanner", "goog-default": "b", "goog-extra": "x"}
+	if err := SetLabels(labels, d, "labels", config); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetLabels(labels, d, "terraform_labels", config); err != nil {
+		t.Fatal(err)
+	}
+
+	// Ignored labels the user configured are read like any other label.
+	expected := map[string]interface{}{"env": "dev", "goog-owner": "scanner"}
+	if got := d.Get("labels"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected labels %v, got %v", expected, got)
+	}
+	expected = map[string]interface{}{"env": "dev", "goog-owner": "scanner", "goog-default": "a"}
+	if got := d.Get("terraform_labels"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected terraform_labels %v, got %v", expected, got)
+	}
+}
+
+func TestFlattenLabelBlocks(t *testing.T) {
+	block := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key":   {Type: schema.TypeString, Optional: true},
+			"value": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	s := map[string]*schema.Schema{
+		"labels": {Type: schema.TypeSet, Optional: true, Elem: block},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"labels": []interface{}{
+			map[string]interface{}{"key": "env", "value": "prod"},
+			map[string]interface{}{"key": "removed", "value": "a"},
+		},
+	})
+
+	api := []interface{}{
+		map[string]interface{}{"key": "env", "value": "dev"},
+		map[string]interface{}{"key": "goog-extra", "value": "x"},
+		map[string]interface{}{},
+	}
+	got := FlattenLabelBlocks(api, d, "labels", &transport_tpg.Config{})
+	expected := []interface{}{map[string]interface{}{"key": "env", "value": "dev"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected labels %v, got %v", expected, got)
+	}
+}
+
+func TestSetLabelsDiff_ignoreLabels(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetLabelsDiff,
+	}
+	config := &transport_tpg.Config{
+		DefaultLabels: map[string]string{"goog-default": "a"},
+		IgnoreLabels:  &transport_tpg.IgnoreLabels{KeyPrefixes: []string{"goog-"}},
+	}
+
+	diff := testPlanDiff(t, r, nil, map[string]interface{}{
+		"labels": map[string]interface{}{"env": "prod", "goog-owner": "me"},
+	}, config)
+
+	// Ignored labels the user configured are managed, ignored default labels aren't.
+	for _, k := range []string{"terraform_labels.env", "terraform_labels.goog-owner", "effective_labels.goog-owner"} {
+		if _, ok := diff.Attributes[k]; !ok {
+			t.Errorf("expected %s to be planned", k)
+		}
+	}
+	for _, k := range []string{"terraform_labels.goog-default", "effective_labels.goog-default"} {
+		if _, ok := diff.Attributes[k]; ok {
+			t.Errorf("expected %s not to be planned", k)
+		}
+	}
+}
+
+// testPlanDiff plans the resource with the given state attributes and
+// configuration the way the SDK does, with the configuration as the raw plan.
+func testPlanDiff(t *testing.T, r *schema.Resource, attributes map[string]string, raw map[string]interface{}, meta interface{}) *terraform.InstanceDiff {
+	t.Helper()
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := &terraform.InstanceState{Attributes: attributes, RawPlan: plan}
+	if attributes != nil {
+		state.ID = "id"
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return diff
+}
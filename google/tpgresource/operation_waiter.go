@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/operation_waiter.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// OperationKind describes a kind of long-running operation, to parameterize
+// an OperationWaiter: where operations are polled and how their state,
+// errors and progress are read. AipOperation is the kind returned by most
+// APIs; services with their own kind, such as Compute Engine and Cloud SQL,
+// declare it alongside their operation error type.
+type OperationKind struct {
+	// Name identifies the kind in pending operations, see
+	// RegisterOperationKind.
+	Name string
+
+	// PendingStates and TargetStates are the values of State while the
+	// operation is running and once it's done.
+	PendingStates []string
+	TargetStates  []string
+
+	// State returns the state of op.
+	State func(op map[string]interface{}) string
+
+	// Error returns the error embedded in op, or nil if there is none.
+	Error func(op map[string]interface{}) error
+
+	// IsRetryable reports whether the operation is polled again despite an
+	// embedded error. Nil if it never is.
+	IsRetryable func(err error) bool
+
+	// Url returns the URL the operation is polled at.
+	Url func(w *OperationWaiter) string
+
+	// Cancel asks the API to cancel the operation. Nil if the API doesn't
+	// support it.
+	Cancel func(w *OperationWaiter) error
+
+	// Progress returns the progress reported by op. Nil if operations don't
+	// report any.
+	Progress func(op map[string]interface{}) OperationProgress
+
+	// EstimatedEndTime estimates when op will be done at time now, or
+	// returns the zero time if it can't. Nil if operations have no estimate.
+	EstimatedEndTime func(op map[string]interface{}, now time.Time) time.Time
+}
+
+// AipOperation is the kind of the google.longrunning.Operation returned by
+// most APIs, see https://google.aip.dev/151. Operations are polled at their
+// name relative to the API's base URL.
+var AipOperation = &OperationKind{
+	Name:          "aip",
+	PendingStates: []string{"done: false"},
+	TargetStates:  []string{"done: true"},
+	State: func(op map[string]interface{}) string {
+		done, _ := op["done"].(bool)
+		return fmt.Sprintf("done: %v", done)
+	},
+	Error: func(op map[string]interface{}) error {
+		if op["error"] == nil {
+			return nil
+		}
+		status := &cloudresourcemanager.Status{}
+		if err := Convert(op["error"], status); err != nil {
+			return fmt.Errorf("operation error: %v", op["error"])
+		}
+		return &CommonOpError{status}
+	},
+	Url: func(w *OperationWaiter) string {
+		return w.BaseUrl + w.OpName()
+	},
+	Cancel: func(w *OperationWaiter) error {
+		return transport_tpg.CancelOperation(w.Config, &transport_tpg.PendingOperation{
+			Name:    w.OpName(),
+			Url:     w.Kind.Url(w),
+			Project: w.Project,
+		}, w.UserAgent)
+	},
+	Progress: func(op map[string]interface{}) OperationProgress {
+		metadata, _ := op["metadata"].(map[string]interface{})
+		return OperationMetadataProgress(metadata)
+	},
+	EstimatedEndTime: func(op map[string]interface{}, now time.Time) time.Time {
+		metadata, _ := op["metadata"].(map[string]interface{})
+		return OperationEstimatedEndTime(metadata, now)
+	},
+}
+
+// LocationalAipOperation is the kind of the AIP-151 operations of APIs with an
+// endpoint per location, whose base URL has a {{location}} or {{region}}
+// placeholder, such as https://{{location}}-aiplatform.googleapis.com/v1/.
+// Operations are polled at the endpoint of the location in their name,
+// projects/{project}/locations/{location}/operations/{operation}, or at the
+// base URL as it is if they have none.
+var LocationalAipOperation = func() *OperationKind {
+	kind := *AipOperation
+	kind.Name = "aip_location"
+	kind.Url = func(w *OperationWaiter) string {
+		if location := GetRegionFromRegionalSelfLink(w.OpName()); location != w.OpName() {
+			return LocationalBaseUrl(w.BaseUrl, location) + w.OpName()
+		}
+		return w.BaseUrl + w.OpName()
+	}
+	return &kind
+}()
+
+// LocationalBaseUrl returns the base URL of the endpoint of an API in
+// location, replacing the {{location}} or {{region}} placeholder in baseUrl.
+func LocationalBaseUrl(baseUrl, location string) string {
+	return strings.NewReplacer("{{location}}", location, "{{region}}", location).Replace(baseUrl)
+}
+
+// operationKinds are the kinds of operations that waiting on can be resumed
+// for, by name.
+var operationKinds = map[string]*OperationKind{}
+
+func init() {
+	RegisterOperationKind(AipOperation)
+	RegisterOperationKind(LocationalAipOperation)
+}
+
+// RegisterOperationKind registers kind so that waiting on its operations
+// can be resumed by a later apply, see ResumeOperationWait. Services register
+// their own kinds in an init func.
+func RegisterOperationKind(kind *OperationKind) {
+	operationKinds[kind.Name] = kind
+}
+
+// OperationWaiter waits on an operation of any kind, polling it through
+// SendRequest, so that all of them share the same timeouts, retries,
+// cancellation and progress reporting.
+type OperationWaiter struct {
+	Config    *transport_tpg.Config
+	UserAgent string
+	Project   string
+	Kind      *OperationKind
+	Op        map[string]interface{}
+
+	// BaseUrl is the base URL of the API the operation is from.
+	BaseUrl string
+
+	// Parent is the resource the operation is polled under if it isn't a
+	// project, such as the organization of a Compute Engine organization
+	// operation.
+	Parent string
+
+	// ErrorAbortPredicates are passed on to SendRequest when polling.
+	ErrorAbortPredicates []transport_tpg.RetryErrorPredicateFunc
+}
+
+// NewOperationWaiter returns a waiter for op, an operation of the given kind
+// returned by the API at baseUrl.
+func NewOperationWaiter(config *transport_tpg.Config, kind *OperationKind, op interface{}, baseUrl, project, userAgent string) (*OperationWaiter, error) {
+	w := &OperationWaiter{
+		Config:    config,
+		UserAgent: userAgent,
+		Project:   project,
+		Kind:      kind,
+		BaseUrl:   baseUrl,
+	}
+	if err := w.SetOp(op); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *OperationWaiter) State() string {
+	if w == nil || w.Op == nil {
+		return "<nil>"
+	}
+	return w.Kind.State(w.Op)
+}
+
+func (w *OperationWaiter) Error() error {
+	if w == nil || w.Op == nil {
+		return nil
+	}
+	return w.Kind.Error(w.Op)
+}
+
+func (w *OperationWaiter) IsRetryable(err error) bool {
+	return w.Kind.IsRetryable != nil && w.Kind.IsRetryable(err)
+}
+
+func (w *OperationWaiter) SetOp(op interface{}) error {
+	m, err := ConvertToMap(op)
+	if err != nil {
+		return fmt.Errorf("Unable to set operation: %s", err)
+	}
+	w.Op = m
+	return nil
+}
+
+func (w *OperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil || w.Op == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	return transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:               w.Config,
+		Method:               "GET",
+		Project:              w.Project,
+		RawURL:               w.Kind.Url(w),
+		UserAgent:            w.UserAgent,
+		ErrorAbortPredicates: w.ErrorAbortPredicates,
+	})
+}
+
+func (w *OperationWaiter) OpName() string {
+	if w == nil || w.Op == nil {
+		return "<nil>"
+	}
+	name, _ := w.Op["name"].(string)
+	return name
+}
+
+func (w *OperationWaiter) PendingStates() []string {
+	return w.Kind.PendingStates
+}
+
+func (w *OperationWaiter) TargetStates() []string {
+	return w.Kind.TargetStates
+}
+
+func (w *OperationWaiter) WaitContext() context.Context {
+	return w.Config.Context
+}
+
+func (w *OperationWaiter) TraceContext() context.Context {
+	return w.Config.TraceContext()
+}
+
+func (w *OperationWaiter) SetTraceContext(ctx context.Context) {
+	w.Config = w.Config.WithTraceContext(ctx)
+}
+
+func (w *OperationWaiter) CancelOp() error {
+	if w.Kind.Cancel == nil {
+		return transport_tpg.ErrCancelNotSupported
+	}
+	return w.Kind.Cancel(w)
+}
+
+func (w *OperationWaiter) HoldOperationSlot() (func(), error) {
+	return w.Config.HoldOperationSlot(w.Project)
+}
+
+func (w *OperationWaiter) PendingOperation() *transport_tpg.PendingOperation {
+	if _, ok := operationKinds[w.Kind.Name]; !ok {
+		return nil
+	}
+	return &transport_tpg.PendingOperation{
+		Kind:    w.Kind.Name,
+		Name:    w.OpName(),
+		Url:     w.Kind.Url(w),
+		BaseUrl: w.BaseUrl,
+		Project: w.Project,
+	}
+}
+
+func (w *OperationWaiter) OperationRecorder() *transport_tpg.OperationRecorder {
+	return w.Config.OperationRecorder()
+}
+
+func (w *OperationWaiter) Progress() OperationProgress {
+	if w == nil || w.Op == nil || w.Kind.Progress == nil {
+		return noProgress
+	}
+	return w.Kind.Progress(w.Op)
+}
+
+func (w *OperationWaiter) EstimatedEndTime() time.Time {
+	if w == nil || w.Op == nil || w.Kind.EstimatedEndTime == nil {
+		return time.Time{}
+	}
+	return w.Kind.EstimatedEndTime(w.Op, time.Now())
+}
+
+// Response unmarshals the response of a done AIP-151 operation into
+// response.
+func (w *OperationWaiter) Response(response *map[string]interface{}) error {
+	if w.Op["response"] == nil {
+		return errors.New("`resource` not set in operation response")
+	}
+	b, err := json.Marshal(w.Op["response"])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, response)
+}
+
+// OperationStatus returns the state of an operation that reports it in a
+// status field, such as PENDING, RUNNING or DONE, as those of Compute Engine
+// and Cloud SQL do.
+func OperationStatus(op map[string]interface{}) string {
+	status, _ := op["status"].(string)
+	return status
+}
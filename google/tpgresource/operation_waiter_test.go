@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/operation_waiter_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestOperationWaiter_aip(t *testing.T) {
+	cases := map[string]struct {
+		done     string
+		response map[string]interface{}
+		err      string
+	}{
+		"response": {
+			done:     `{"name": "operations/op", "done": true, "response": {"name": "instances/foo"}}`,
+			response: map[string]interface{}{"name": "instances/foo"},
+		},
+		"error": {
+			done: `{"name": "operations/op", "done": true, "error": {"code": 9, "message": "failed"}}`,
+			err:  "failed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			polls := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/operations/op" {
+					t.Errorf("expected the operation to be polled at its name, got %s", r.URL.Path)
+				}
+				polls++
+				if polls < 2 {
+					fmt.Fprint(w, `{"name": "operations/op", "metadata": {"progressPercent": 50}}`)
+					return
+				}
+				fmt.Fprint(w, tc.done)
+			}))
+			defer ts.Close()
+
+			config := &transport_tpg.Config{Client: ts.Client()}
+			op := map[string]interface{}{"name": "operations/op"}
+			w, err := NewOperationWaiter(config, AipOperation, op, ts.URL+"/v1/", "my-project", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = OperationWait(w, "my-activity", time.Minute, time.Millisecond)
+			if tc.err != "" {
+				var opErr *CommonOpError
+				if !errors.As(err, &opErr) || opErr.Message != tc.err {
+					t.Errorf("expected the operation's error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error waiting for operation: %s", err)
+			}
+			var response map[string]interface{}
+			if err := w.Response(&response); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(response, tc.response) {
+				t.Errorf("expected response %v, got %v", tc.response, response)
+			}
+		})
+	}
+}
+
+func TestLocationalAipOperation_url(t *testing.T) {
+	cases := map[string]struct {
+		baseUrl, name, expected string
+	}{
+		"location": {
+			baseUrl:  "https://{{location}}-aiplatform.googleapis.com/v1/",
+			name:     "projects/p/locations/us-central1/operations/1",
+			expected: "https://us-central1-aiplatform.googleapis.com/v1/projects/p/locations/us-central1/operations/1",
+		},
+		"region": {
+			baseUrl:  "https://{{region}}-aiplatform.googleapis.com/v1/",
+			name:     "projects/p/locations/europe-west1/operations/1",
+			expected: "https://europe-west1-aiplatform.googleapis.com/v1/projects/p/locations/europe-west1/operations/1",
+		},
+		"no location": {
+			baseUrl:  "https://dialogflow.googleapis.com/v2/",
+			name:     "projects/p/operations/1",
+			expected: "https://dialogflow.googleapis.com/v2/projects/p/operations/1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := &OperationWaiter{Kind: LocationalAipOperation, BaseUrl: tc.baseUrl, Op: map[string]interface{}{"name": tc.name}}
+			if got := LocationalAipOperation.Url(w); got != tc.expected {
+				t.Errorf("expected the operation to be polled at %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestOperationWaiter_status(t *testing.T) {
+	kind := &OperationKind{
+		PendingStates: []string{"PENDING", "RUNNING"},
+		TargetStates:  []string{"DONE"},
+		State:         OperationStatus,
+		Error: func(op map[string]interface{}) error {
+			if op["error"] != nil {
+				return fmt.Errorf("%v", op["error"])
+			}
+			return nil
+		},
+		Url: func(w *OperationWaiter) string {
+			return fmt.Sprintf("%sprojects/%s/operations/%s", w.BaseUrl, w.Project, w.OpName())
+		},
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/projects/my-project/operations/op" {
+			t.Errorf("unexpected operation URL %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"name": "op", "status": "DONE"}`)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{Client: ts.Client()}
+	w, err := NewOperationWaiter(config, kind, map[string]interface{}{"name": "op", "status": "RUNNING"}, ts.URL+"/", "my-project", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := OperationWait(w, "my-activity", time.Minute, time.Millisecond); err != nil {
+		t.Errorf("unexpected error waiting for operation: %s", err)
+	}
+	if !errors.Is(w.CancelOp(), transport_tpg.ErrCancelNotSupported) {
+		t.Errorf("expected operations of a kind without Cancel not to be cancellable")
+	}
+}
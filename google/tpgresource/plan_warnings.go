@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/tpgresource/plan_warnings.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package tpgresource
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// PlanWarning is a warning about a resource's plan, shown by Terraform
+// along with the plan.
+type PlanWarning struct {
+	Summary string
+	Detail  string
+}
+
+// PlanWarnings collects the warnings raised while planning a resource.
+type PlanWarnings struct {
+	mu       sync.Mutex
+	warnings []PlanWarning
+}
+
+// Warnings returns the warnings raised so far.
+func (w *PlanWarnings) Warnings() []PlanWarning {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]PlanWarning(nil), w.warnings...)
+}
+
+type planWarningsContextKey struct{}
+
+// ContextWithPlanWarnings returns a context in which CustomizeDiff funcs
+// can raise warnings with AddPlanWarning, which are collected in the
+// returned PlanWarnings. The SDK doesn't let CustomizeDiff funcs return
+// warnings, so the provider's gRPC server adds them to its response.
+func ContextWithPlanWarnings(ctx context.Context) (context.Context, *PlanWarnings) {
+	w := &PlanWarnings{}
+	return context.WithValue(ctx, planWarningsContextKey{}, w), w
+}
+
+// AddPlanWarning raises a warning about the plan of the resource being
+// planned with ctx, or logs it if warnings aren't collected.
+func AddPlanWarning(ctx context.Context, summary, detail string) {
+	var w *PlanWarnings
+	if ctx != nil {
+		w, _ = ctx.Value(planWarningsContextKey{}).(*PlanWarnings)
+	}
+	if w == nil {
+		log.Printf("[WARN] %s: %s", summary, detail)
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.warnings = append(w.warnings, PlanWarning{Summary: summary, Detail: detail})
+}
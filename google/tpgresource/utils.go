@@ -174,6 +174,20 @@ func GetRouterLockName(region string, router string) string {
 	return fmt.Sprintf("router/%s/%s", region, router)
 }
 
+// LockHolder describes the resource of type resourceType being changed with
+// d as the holder of a lock, see transport_tpg.MutexKV.LockWithContext.
+// Terraform doesn't tell providers the address of a resource, so it's
+// described by its type and ID, or its name while it's being created.
+func LockHolder(resourceType string, d TerraformResourceData) string {
+	if id := d.Id(); id != "" {
+		return fmt.Sprintf("%s %q", resourceType, id)
+	}
+	if name, ok := d.GetOk("name"); ok {
+		return fmt.Sprintf("%s %q", resourceType, name)
+	}
+	return resourceType
+}
+
 func IsFailedPreconditionError(err error) bool {
 	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
 	if !ok {
@@ -1282,3 +1282,33 @@ func TestNormalizeIamPrincipalCasing(t *testing.T) {
 		})
 	}
 }
+
+func TestLockHolder(t *testing.T) {
+	cases := map[string]struct {
+		Id       string
+		Fields   map[string]interface{}
+		Expected string
+	}{
+		"id": {
+			Id:       "us-central1/my-router/my-interface",
+			Fields:   map[string]interface{}{"name": "my-interface"},
+			Expected: `google_compute_router_interface "us-central1/my-router/my-interface"`,
+		},
+		"name while creating": {
+			Fields:   map[string]interface{}{"name": "my-interface"},
+			Expected: `google_compute_router_interface "my-interface"`,
+		},
+		"neither": {
+			Fields:   map[string]interface{}{},
+			Expected: "google_compute_router_interface",
+		},
+	}
+
+	for tn, tc := range cases {
+		d := &tpgresource.ResourceDataMock{FieldsInSchema: tc.Fields}
+		d.SetId(tc.Id)
+		if got := tpgresource.LockHolder("google_compute_router_interface", d); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
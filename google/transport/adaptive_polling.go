@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/adaptive_polling.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// MinPollInterval is the interval between the first polls of an operation or
+// resource, as many operations are done within a few seconds.
+var MinPollInterval = 1 * time.Second
+
+// DefaultPollInterval is the default of the provider's poll_interval, the
+// longest interval between polls.
+var DefaultPollInterval = 10 * time.Second
+
+// PollBackoff chooses the interval between polls. It starts at
+// MinPollInterval and doubles up to a maximum, so that operations that are
+// done quickly are seen to be done quickly, and long ones aren't polled more
+// than needed. If the operation estimates when it will be done, it is next
+// polled then, up to the maximum.
+type PollBackoff struct {
+	min, max time.Duration
+	last     time.Duration
+	next     time.Duration
+}
+
+// NewPollBackoff returns a backoff up to max, or DefaultPollInterval if max
+// is 0.
+func NewPollBackoff(max time.Duration) *PollBackoff {
+	if max <= 0 {
+		max = DefaultPollInterval
+	}
+	min := MinPollInterval
+	if min > max {
+		min = max
+	}
+	return &PollBackoff{min: min, max: max, next: min}
+}
+
+// Next returns how long to wait before the next poll. eta is when the
+// operation is estimated to be done, or the zero time if unknown.
+func (b *PollBackoff) Next(eta time.Time) time.Duration {
+	wait := b.next
+	if b.next *= 2; b.next > b.max {
+		b.next = b.max
+	}
+	if !eta.IsZero() {
+		if remaining := time.Until(eta); remaining > 0 {
+			wait = remaining
+		}
+	}
+
+	if wait < b.min {
+		wait = b.min
+	}
+	if wait > b.max {
+		wait = b.max
+	}
+	b.last = wait
+	return wait
+}
+
+// Repeat returns the interval last returned by Next without backing off, or
+// the minimum if Next hasn't been called.
+func (b *PollBackoff) Repeat() time.Duration {
+	if b.last == 0 {
+		return b.min
+	}
+	return b.last
+}
+
+// StateWaitConf polls for a state like retry.StateChangeConf, and returns the
+// same errors, but with the interval between polls chosen by a PollBackoff.
+type StateWaitConf struct {
+	Pending []string
+	Target  []string
+	Refresh retry.StateRefreshFunc
+	Timeout time.Duration
+	// MaxPollInterval is the longest interval between polls, see
+	// NewPollBackoff.
+	MaxPollInterval time.Duration
+	// EstimatedEndTime optionally returns when what is being waited on is
+	// estimated to be done, or the zero time if unknown. It is called after
+	// each refresh.
+	EstimatedEndTime func() time.Time
+	// NotFoundChecks is the number of consecutive times Refresh may return
+	// a nil result, defaulting to 20 as for retry.StateChangeConf.
+	NotFoundChecks int
+	// ContinuousTargetOccurence is the number of consecutive times the
+	// target state must be seen, defaulting to 1. Polls counting these
+	// don't back off.
+	ContinuousTargetOccurence int
+	// Context optionally stops the wait between polls when it is done, in
+	// which case its error is returned.
+	Context context.Context
+}
+
+// WaitForState polls until the target state is seen, Refresh returns an
+// error, the timeout expires or the context is done. The last poll is made
+// when the timeout expires.
+func (conf *StateWaitConf) WaitForState() (interface{}, error) {
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = 20
+	}
+	targetOccurences := conf.ContinuousTargetOccurence
+	if targetOccurences == 0 {
+		targetOccurences = 1
+	}
+
+	deadline := time.Now().Add(conf.Timeout)
+	backoff := NewPollBackoff(conf.MaxPollInterval)
+
+	var lastResult interface{}
+	var lastState string
+	notFound, targetOccurence := 0, 0
+	for {
+		res, state, err := conf.Refresh()
+		if err != nil {
+			return res, err
+		}
+
+		if res == nil {
+			notFound++
+			targetOccurence = 0
+			if notFound > notFoundChecks {
+				return nil, &retry.NotFoundError{Retries: notFound}
+			}
+		} else {
+			notFound = 0
+			lastResult, lastState = res, state
+			if containsState(conf.Target, state) {
+				targetOccurence++
+				if targetOccurence >= targetOccurences {
+					return res, nil
+				}
+			} else {
+				targetOccurence = 0
+				if len(conf.Pending) > 0 && !containsState(conf.Pending, state) {
+					return res, &retry.UnexpectedStateError{State: state, ExpectedState: conf.Target}
+				}
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return lastResult, &retry.TimeoutError{
+				LastState:     lastState,
+				Timeout:       conf.Timeout,
+				ExpectedState: conf.Target,
+			}
+		}
+
+		var wait time.Duration
+		if targetOccurence > 0 {
+			wait = backoff.Repeat()
+		} else {
+			var eta time.Time
+			if conf.EstimatedEndTime != nil {
+				eta = conf.EstimatedEndTime()
+			}
+			wait = backoff.Next(eta)
+		}
+		if wait > remaining {
+			wait = remaining
+		}
+		if err := conf.sleep(wait); err != nil {
+			return lastResult, err
+		}
+	}
+}
+
+func (conf *StateWaitConf) sleep(d time.Duration) error {
+	if conf.Context == nil {
+		time.Sleep(d)
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-conf.Context.Done():
+		return conf.Context.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/adaptive_polling_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func TestPollBackoff(t *testing.T) {
+	b := NewPollBackoff(10 * time.Second)
+	var waits []time.Duration
+	for i := 0; i < 6; i++ {
+		waits = append(waits, b.Next(time.Time{}))
+	}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i := range expected {
+		if waits[i] != expected[i] {
+			t.Fatalf("expected waits %v, got %v", expected, waits)
+		}
+	}
+	if b.Repeat() != 10*time.Second {
+		t.Errorf("expected Repeat to return the last wait, got %v", b.Repeat())
+	}
+
+	b = NewPollBackoff(time.Minute)
+	if wait := b.Next(time.Now().Add(30 * time.Second)); wait < 29*time.Second || wait > 30*time.Second {
+		t.Errorf("expected to wait until the estimated end time, waited %v", wait)
+	}
+	if wait := b.Next(time.Now().Add(time.Hour)); wait != time.Minute {
+		t.Errorf("expected to wait at most the maximum, waited %v", wait)
+	}
+	if wait := b.Next(time.Now().Add(-time.Minute)); wait != 4*time.Second {
+		t.Errorf("expected to back off once past the estimated end time, waited %v", wait)
+	}
+
+	if wait := NewPollBackoff(100 * time.Millisecond).Next(time.Time{}); wait != 100*time.Millisecond {
+		t.Errorf("expected a maximum below MinPollInterval to be used, waited %v", wait)
+	}
+	if wait := NewPollBackoff(0).Next(time.Now().Add(time.Hour)); wait != DefaultPollInterval {
+		t.Errorf("expected the maximum to default to DefaultPollInterval, waited %v", wait)
+	}
+}
+
+func TestStateWaitConf(t *testing.T) {
+	states := func(s ...string) retry.StateRefreshFunc {
+		return func() (interface{}, string, error) {
+			state := s[0]
+			if len(s) > 1 {
+				s = s[1:]
+			}
+			return state, state, nil
+		}
+	}
+
+	cases := map[string]struct {
+		conf     StateWaitConf
+		expected error
+	}{
+		"target": {
+			conf: StateWaitConf{Pending: []string{"RUNNING"}, Target: []string{"DONE"}, Refresh: states("RUNNING", "RUNNING", "DONE")},
+		},
+		"unexpected state": {
+			conf:     StateWaitConf{Pending: []string{"RUNNING"}, Target: []string{"DONE"}, Refresh: states("RUNNING", "FAILED")},
+			expected: &retry.UnexpectedStateError{},
+		},
+		"timeout": {
+			conf:     StateWaitConf{Pending: []string{"RUNNING"}, Target: []string{"DONE"}, Refresh: states("RUNNING"), Timeout: 50 * time.Millisecond},
+			expected: &retry.TimeoutError{},
+		},
+		"not found": {
+			conf: StateWaitConf{Target: []string{"DONE"}, NotFoundChecks: 2, Refresh: func() (interface{}, string, error) {
+				return nil, "", nil
+			}},
+			expected: &retry.NotFoundError{},
+		},
+		"target occurrences": {
+			conf: StateWaitConf{Target: []string{"DONE"}, ContinuousTargetOccurence: 2, Refresh: states("DONE", "RUNNING", "DONE", "DONE")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.conf.MaxPollInterval = time.Millisecond
+			if tc.conf.Timeout == 0 {
+				tc.conf.Timeout = time.Minute
+			}
+			_, err := tc.conf.WaitForState()
+			if tc.expected == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if fmt.Sprintf("%T", err) != fmt.Sprintf("%T", tc.expected) {
+				t.Errorf("expected %T, got %#v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestStateWaitConf_contextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	polls := 0
+	conf := StateWaitConf{
+		Pending: []string{"RUNNING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			polls++
+			cancel()
+			return "RUNNING", "RUNNING", nil
+		},
+		Timeout: time.Minute,
+		Context: ctx,
+	}
+	if _, err := conf.WaitForState(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+	if polls != 1 {
+		t.Errorf("expected to stop polling once the context is done, polled %d times", polls)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/base_transport.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/oauth2"
+
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+// tlsVersions maps the values accepted by the provider's `min_tls_version`
+// setting to TLS versions.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// NewBaseTransport returns the transport API and token requests are sent
+// through when any of ProxyUrl, CaBundle or MinTlsVersion is set, or nil to
+// use the client libraries' default transport.
+//
+// Setting a proxy here rather than through HTTPS_PROXY keeps it from applying
+// to other providers run by the same Terraform process.
+func (c *Config) NewBaseTransport() (*http.Transport, error) {
+	if c.ProxyUrl == "" && c.CaBundle == "" && c.MinTlsVersion == "" {
+		return nil, nil
+	}
+
+	t := cleanhttp.DefaultPooledTransport()
+	// Matches the default transport of the client libraries.
+	t.MaxIdleConnsPerHost = 100
+	t.TLSClientConfig = &tls.Config{}
+
+	if c.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(c.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %s", c.ProxyUrl, err)
+		}
+		t.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	if c.CaBundle != "" {
+		contents, _, err := verify.PathOrContents(c.CaBundle)
+		if err != nil {
+			return nil, fmt.Errorf("error loading ca_bundle: %s", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(contents)) {
+			return nil, fmt.Errorf("ca_bundle does not contain any PEM encoded certificates")
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.MinTlsVersion != "" {
+		v, ok := tlsVersions[c.MinTlsVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid min_tls_version %q", c.MinTlsVersion)
+		}
+		t.TLSClientConfig.MinVersion = v
+	}
+
+	return t, nil
+}
+
+// authHttpClient returns the HTTP client credentials should use to fetch
+// tokens, or nil to use the default.
+func (c *Config) authHttpClient() *http.Client {
+	if c.Context == nil {
+		return nil
+	}
+	client, _ := c.Context.Value(oauth2.HTTPClient).(*http.Client)
+	return client
+}
+
+// authContext returns the context to create credentials with, carrying the
+// HTTP client tokens are fetched with if it isn't the default.
+func (c *Config) authContext() context.Context {
+	if client := c.authHttpClient(); client != nil {
+		return context.WithValue(context.Background(), oauth2.HTTPClient, client)
+	}
+	return context.Background()
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/base_transport_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfig_NewBaseTransport(t *testing.T) {
+	config := &Config{}
+	if bt, err := config.NewBaseTransport(); err != nil || bt != nil {
+		t.Fatalf("expected no base transport without settings, got %v, %v", bt, err)
+	}
+
+	config = &Config{ProxyUrl: "http://proxy.example.com:3128", MinTlsVersion: "1.3"}
+	bt, err := config.NewBaseTransport()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, _ := http.NewRequest("GET", "https://compute.googleapis.com/compute/v1/projects/p", nil)
+	proxy, err := bt.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("expected requests to use the proxy, got %v, %v", proxy, err)
+	}
+	if bt.TLSClientConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("expected minimum TLS version 1.3, got %x", bt.TLSClientConfig.MinVersion)
+	}
+
+	for _, c := range []*Config{
+		{CaBundle: "not a certificate"},
+		{MinTlsVersion: "1.4"},
+		{ProxyUrl: "://"},
+	} {
+		if _, err := c.NewBaseTransport(); err == nil {
+			t.Errorf("expected an error for %+v", c)
+		}
+	}
+}
+
+func TestConfig_NewBaseTransport_caBundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caPath, caPem, 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, bundle := range map[string]string{"contents": string(caPem), "path": caPath} {
+		config := &Config{CaBundle: bundle}
+		bt, err := config.NewBaseTransport()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		resp, err := (&http.Client{Transport: bt}).Get(ts.URL)
+		if err != nil {
+			t.Fatalf("%s: expected server certificate to be trusted, got %v", name, err)
+		}
+		resp.Body.Close()
+	}
+
+	// Without the bundle, the server's certificate isn't trusted.
+	config := &Config{MinTlsVersion: "1.2"}
+	bt, err := config.NewBaseTransport()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: bt}).Get(ts.URL); err == nil {
+		t.Fatalf("expected server certificate not to be trusted without ca_bundle")
+	}
+}
@@ -18,16 +18,26 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/errwrap"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 const DefaultBatchSendIntervalSec = 3
 
+// ServiceUsageMaxBatchItems is the most services batchEnable accepts in a
+// single call.
+const ServiceUsageMaxBatchItems = 20
+
 // RequestBatcher keeps track of batched requests globally.
 // It should be created at a provider level. In general, one
 // should be created per service that requires batching to:
@@ -40,6 +50,11 @@ type RequestBatcher struct {
 	parentCtx context.Context
 	batches   map[string]*startedBatch
 	debugId   string
+
+	// Counters reported in debug logs after each batch is sent.
+	batchesSent   uint64
+	itemsSent     uint64
+	singleRetries uint64
 }
 
 // These types are meant to be the public interface to batchers. They define
@@ -69,6 +84,10 @@ type (
 		// ID for debugging request. This should be specific to a single request
 		// (i.e. per Terraform resource)
 		DebugId string
+
+		// ItemId identifies the item this request adds to a batch, e.g. the
+		// service to enable, so that SendF can return BatchItemErrors.
+		ItemId string
 	}
 
 	// BatcherCombineFunc is a function type for combine existing batches and additional batch data
@@ -76,8 +95,29 @@ type (
 
 	// BatcherSendFunc is a function type for sending a batch request
 	BatcherSendFunc func(resourceName string, body interface{}) (interface{}, error)
+
+	// BatchItemErrors can be returned as the error of a BatcherSendFunc when
+	// only some items of a batch failed. It maps the ItemId of each failed
+	// request to its error. Other requests in the batch succeed with the
+	// response returned alongside it, and failed requests aren't retried
+	// individually.
+	BatchItemErrors map[string]error
 )
 
+func (e BatchItemErrors) Error() string {
+	ids := make([]string, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	msgs := make([]string, 0, len(e))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", id, e[id]))
+	}
+	return fmt.Sprintf("%d batch items failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
 // batchResponse bundles an API response (data, error) tuple.
 type batchResponse struct {
 	body interface{}
@@ -116,6 +156,23 @@ type batchSubscriber struct {
 type BatchingConfig struct {
 	SendAfter      time.Duration
 	EnableBatching bool
+	// MaxItems is the maximum number of requests combined into one batch. A
+	// batch is sent as soon as it is full, and later requests start a new
+	// batch. 0 means batches aren't limited.
+	MaxItems int
+}
+
+// withMaxItems returns a copy of the config whose batches hold at most
+// maxItems requests, for APIs that limit the size of a batch.
+func (c *BatchingConfig) withMaxItems(maxItems int) *BatchingConfig {
+	if c == nil {
+		return nil
+	}
+	copied := *c
+	if copied.MaxItems == 0 || copied.MaxItems > maxItems {
+		copied.MaxItems = maxItems
+	}
+	return &copied
 }
 
 // Initializes a new batcher.
@@ -179,7 +236,8 @@ func (b *RequestBatcher) SendRequestWithTimeout(batchKey string, request *BatchR
 	}
 	if !b.EnableBatching {
 		log.Printf("[DEBUG] Batching is disabled, sending single request for %q", request.DebugId)
-		return request.SendF(request.ResourceName, request.Body)
+		resp := itemResponse(request.send(), request)
+		return resp.body, resp.err
 	}
 
 	respCh, err := b.registerBatchRequest(batchKey, request)
@@ -231,7 +289,12 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 
 	// If batch already exists, combine this request into existing request.
 	if batch, ok := b.batches[batchKey]; ok {
-		return batch.addRequest(newRequest)
+		respCh, err := batch.addRequest(newRequest)
+		if err != nil {
+			return nil, err
+		}
+		b.sendIfFull(batchKey, batch)
+		return respCh, nil
 	}
 
 	// Batch doesn't exist for given batch key - create a new batch.
@@ -269,21 +332,52 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 			b.sendBatchWithSingleRetry(batchKey, batch)
 		}
 	})
+	b.sendIfFull(batchKey, b.batches[batchKey])
 
 	return respCh, nil
 }
 
+// sendIfFull sends a batch without waiting for its timer once it holds
+// MaxItems requests. The batcher must be locked.
+func (b *RequestBatcher) sendIfFull(batchKey string, batch *startedBatch) {
+	if b.MaxItems <= 0 || len(batch.subscribers) < b.MaxItems {
+		return
+	}
+	// If the timer already fired, the batch is about to be sent anyway.
+	if !batch.timer.Stop() {
+		return
+	}
+	log.Printf("[DEBUG] Batch %q reached the maximum of %d requests, sending now", batchKey, b.MaxItems)
+	delete(b.batches, batchKey)
+	go b.sendBatchWithSingleRetry(batchKey, batch)
+}
+
 func (b *RequestBatcher) sendBatchWithSingleRetry(batchKey string, batch *startedBatch) {
 	log.Printf("[DEBUG] Sending batch %q combining %d requests)", batchKey, len(batch.subscribers))
+	_, span := StartSpan(b.parentCtx, "RequestBatcher "+b.debugId,
+		attribute.String("batch.key", batchKey),
+		attribute.Int("batch.size", len(batch.subscribers)),
+	)
+	defer span.End()
+
 	resp := batch.send()
+	if resp.IsError() {
+		span.RecordError(resp.err)
+		span.SetStatus(codes.Error, resp.err.Error())
+	}
+	atomic.AddUint64(&b.batchesSent, 1)
+	atomic.AddUint64(&b.itemsSent, uint64(len(batch.subscribers)))
+	defer b.logStats()
 
+	var itemErrs BatchItemErrors
 	// If the batch failed and combines more than one request, retry each single request.
-	if resp.IsError() && len(batch.subscribers) > 1 {
+	if resp.IsError() && !errors.As(resp.err, &itemErrs) && len(batch.subscribers) > 1 {
 		log.Printf("[DEBUG] Batch failed with error: %v", resp.err)
 		log.Printf("[DEBUG] Sending each request in batch separately")
 		for _, sub := range batch.subscribers {
 			log.Printf("[DEBUG] Retrying single request %q", sub.singleRequest.DebugId)
-			singleResp := sub.singleRequest.send()
+			atomic.AddUint64(&b.singleRetries, 1)
+			singleResp := itemResponse(sub.singleRequest.send(), sub.singleRequest)
 			log.Printf("[DEBUG] Retried single request %q returned response: %v", sub.singleRequest.DebugId, singleResp)
 
 			if singleResp.IsError() {
@@ -297,12 +391,46 @@ func (b *RequestBatcher) sendBatchWithSingleRetry(batchKey string, batch *starte
 	} else {
 		// Send result to all subscribers
 		for _, sub := range batch.subscribers {
-			sub.respCh <- resp
+			sub.respCh <- itemResponse(resp, sub.singleRequest)
 			close(sub.respCh)
 		}
 	}
 }
 
+// batcherStats is a snapshot of a batcher's counters.
+type batcherStats struct {
+	batchesSent   uint64
+	itemsSent     uint64
+	singleRetries uint64
+}
+
+func (b *RequestBatcher) stats() batcherStats {
+	return batcherStats{
+		batchesSent:   atomic.LoadUint64(&b.batchesSent),
+		itemsSent:     atomic.LoadUint64(&b.itemsSent),
+		singleRetries: atomic.LoadUint64(&b.singleRetries),
+	}
+}
+
+func (b *RequestBatcher) logStats() {
+	s := b.stats()
+	log.Printf("[DEBUG] Batcher %q has sent %d batches with %.1f requests per batch on average, and retried %d requests individually",
+		b.debugId, s.batchesSent, float64(s.itemsSent)/float64(s.batchesSent), s.singleRetries)
+}
+
+// itemResponse returns the response for a single request from the response
+// to the batch it was sent in.
+func itemResponse(resp batchResponse, req *BatchRequest) batchResponse {
+	var itemErrs BatchItemErrors
+	if !errors.As(resp.err, &itemErrs) {
+		return resp
+	}
+	if err, ok := itemErrs[req.ItemId]; ok {
+		return batchResponse{err: err}
+	}
+	return batchResponse{body: resp.body}
+}
+
 // popBatch safely gets and removes a batch with given batchkey from the
 // RequestBatcher's started batches.
 func (b *RequestBatcher) popBatch(batchKey string) *startedBatch {
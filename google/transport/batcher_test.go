@@ -23,6 +23,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -261,6 +262,138 @@ func TestRequestBatcher_errTimeout(t *testing.T) {
 	wg.Wait()
 }
 
+func TestRequestBatcher_maxItems(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(5) * time.Second,
+			EnableBatching: true,
+			MaxItems:       2,
+		})
+
+	testCombine := func(body interface{}, toAdd interface{}) (interface{}, error) {
+		return append(body.([]int), toAdd.([]int)...), nil
+	}
+
+	var mu sync.Mutex
+	var batchSizes []int
+	testSendBatch := func(resourceName string, body interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		batchSizes = append(batchSizes, len(body.([]int)))
+		return nil, nil
+	}
+
+	numRequests := 4
+
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+
+	start := time.Now()
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("maxItems %d", idx),
+				ResourceName: "RESOURCE-MAX-ITEMS",
+				Body:         []int{idx},
+				CombineF:     testCombine,
+				SendF:        testSendBatch,
+			}
+
+			if _, err := testBatcher.SendRequestWithTimeout("batchMaxItems", req, time.Duration(10)*time.Second); err != nil {
+				t.Errorf("expected request %d to succeed, got error: %v", idx, err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	// Full batches are sent without waiting for SendAfter.
+	if elapsed := time.Since(start); elapsed >= 5*time.Second {
+		t.Errorf("expected full batches to be sent immediately, took %s", elapsed)
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != 2 || batchSizes[1] != 2 {
+		t.Errorf("expected 2 batches of 2 requests, got batch sizes %v", batchSizes)
+	}
+	if stats := testBatcher.stats(); stats.batchesSent != 2 || stats.itemsSent != 4 || stats.singleRetries != 0 {
+		t.Errorf("unexpected batcher stats: %+v", stats)
+	}
+}
+
+func TestRequestBatcher_itemErrors(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	testCombine := func(body interface{}, toAdd interface{}) (interface{}, error) {
+		return append(body.([]int), toAdd.([]int)...), nil
+	}
+
+	failIdx := 1
+	var sends int32
+	testSendBatch := func(resourceName string, body interface{}) (interface{}, error) {
+		atomic.AddInt32(&sends, 1)
+		errs := BatchItemErrors{}
+		for _, v := range body.([]int) {
+			if v == failIdx {
+				errs[fmt.Sprint(v)] = fmt.Errorf("item %d is invalid", v)
+			}
+		}
+		if len(errs) > 0 {
+			return "done", errs
+		}
+		return "done", nil
+	}
+
+	numRequests := 3
+
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("itemErrors %d", idx),
+				ItemId:       fmt.Sprint(idx),
+				ResourceName: "RESOURCE-ITEM-ERRORS",
+				Body:         []int{idx},
+				CombineF:     testCombine,
+				SendF:        testSendBatch,
+			}
+
+			resp, err := testBatcher.SendRequestWithTimeout("batchItemErrors", req, time.Duration(10)*time.Second)
+			if idx == failIdx {
+				if err == nil || !strings.Contains(err.Error(), "item 1 is invalid") {
+					t.Errorf("expected request %d to fail with its own error, got %v", idx, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected request %d to succeed, got error: %v", idx, err)
+			}
+			if resp != "done" {
+				t.Errorf("expected request %d to get the batch response, got %v", idx, resp)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	// Requests in a batch returning item errors aren't retried separately.
+	if sends != 1 {
+		t.Errorf("expected a single send, got %d", sends)
+	}
+}
+
 func testBasicCountBatches(t *testing.T, testName string, numBatches int) {
 	testBatcher := NewRequestBatcher(
 		"testBatcher",
@@ -52,19 +52,13 @@ func PollingWaitTime(pollF PollReadFunc, checkResponse PollCheckResponseFunc, ac
 	timeout time.Duration, targetOccurrences int) error {
 	log.Printf("[DEBUG] %s: Polling until expected state is read", activity)
 	log.Printf("[DEBUG] Target occurrences: %d", targetOccurrences)
-	if targetOccurrences == 1 {
-		return retry.Retry(timeout, func() *retry.RetryError {
-			readResp, readErr := pollF()
-			return checkResponse(readResp, readErr)
-		})
-	}
 	return RetryWithTargetOccurrences(timeout, targetOccurrences, func() *retry.RetryError {
 		readResp, readErr := pollF()
 		return checkResponse(readResp, readErr)
 	})
 }
 
-// RetryWithTargetOccurrences is a basic wrapper around StateChangeConf that will retry
+// RetryWithTargetOccurrences is a basic wrapper around StateWaitConf that will retry
 // a function until it returns the specified amount of target occurrences continuously.
 // Adapted from the Retry function in the go SDK.
 func RetryWithTargetOccurrences(timeout time.Duration, targetOccurrences int,
@@ -74,11 +68,10 @@ func RetryWithTargetOccurrences(timeout time.Duration, targetOccurrences int,
 	var resultErr error
 	var resultErrMu sync.Mutex
 
-	c := &retry.StateChangeConf{
+	c := &StateWaitConf{
 		Pending:                   []string{"retryableerror"},
 		Target:                    []string{"success"},
 		Timeout:                   timeout,
-		MinTimeout:                500 * time.Millisecond,
 		ContinuousTargetOccurence: targetOccurrences,
 		Refresh: func() (interface{}, string, error) {
 			rerr := f()
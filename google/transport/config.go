@@ -17,7 +17,6 @@
 package transport
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -36,21 +35,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
-	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
-
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/sirupsen/logrus"
 	"google.golang.org/api/option"
 	"google.golang.org/api/option/internaloption"
 
+	"github.com/hashicorp/terraform-provider-google/google/registry"
 	"github.com/hashicorp/terraform-provider-google/google/verify"
 
 	"golang.org/x/oauth2"
 	googleoauth "golang.org/x/oauth2/google"
 	externalaccount "golang.org/x/oauth2/google/externalaccount"
 	"google.golang.org/api/transport"
+	htransport "google.golang.org/api/transport/http"
 	"google.golang.org/grpc"
 )
 
@@ -58,91 +55,6 @@ type ProviderMeta struct {
 	ModuleName string `cty:"module_name"`
 }
 
-type Formatter struct {
-	TimestampFormat string
-	LogFormat       string
-}
-
-// Borrowed logic from https://github.com/sirupsen/logrus/blob/master/json_formatter.go and https://github.com/t-tomalak/logrus-easy-formatter/blob/master/formatter.go
-func (f *Formatter) Format(entry *logrus.Entry) ([]byte, error) {
-	// Suppress logs if TF_LOG is not DEBUG or TRACE
-	if !logging.IsDebugOrHigher() {
-		return nil, nil
-	}
-
-	// Also suppress based on log content
-	// - frequent transport spam
-	// - ListenSocket logs from gRPC
-	isTransportSpam := strings.Contains(entry.Message, "transport is closing")
-	listenSocketRegex := regexp.MustCompile(`\[Server #\d+( ListenSocket #\d+)*\]`) // Match patterns like `[Server #00]` or `[Server #00 ListenSocket #00]`
-	isListenSocketLog := listenSocketRegex.MatchString(entry.Message)
-	if isTransportSpam || isListenSocketLog {
-		return nil, nil
-	}
-
-	output := f.LogFormat
-	entry.Level = logrus.DebugLevel // Force Entries to be Debug
-
-	timestampFormat := f.TimestampFormat
-
-	output = strings.Replace(output, "%time%", entry.Time.Format(timestampFormat), 1)
-
-	output = strings.Replace(output, "%msg%", entry.Message, 1)
-
-	level := strings.ToUpper(entry.Level.String())
-	output = strings.Replace(output, "%lvl%", level, 1)
-
-	var gRPCMessageFlag bool
-	for k, val := range entry.Data {
-		switch v := val.(type) {
-		case string:
-			output = strings.Replace(output, "%"+k+"%", v, 1)
-		case int:
-			s := strconv.Itoa(v)
-			output = strings.Replace(output, "%"+k+"%", s, 1)
-		case bool:
-			s := strconv.FormatBool(v)
-			output = strings.Replace(output, "%"+k+"%", s, 1)
-		}
-
-		if k != "system" {
-			gRPCMessageFlag = true
-		}
-	}
-
-	if gRPCMessageFlag {
-		data := make(logrus.Fields, len(entry.Data)+4)
-		for k, v := range entry.Data {
-			switch v := v.(type) {
-			case error:
-				// Otherwise errors are ignored by `encoding/json`
-				// https://github.com/sirupsen/logrus/issues/137
-				data[k] = v.Error()
-			default:
-				data[k] = v
-			}
-		}
-
-		var b *bytes.Buffer
-		if entry.Buffer != nil {
-			b = entry.Buffer
-		} else {
-			b = &bytes.Buffer{}
-		}
-
-		encoder := json.NewEncoder(b)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(data); err != nil {
-			return nil, fmt.Errorf("failed to marshal fields to JSON, %w", err)
-		}
-
-		finalOutput := append([]byte(output), b.Bytes()...)
-		return finalOutput, nil
-	}
-
-	return []byte(output), nil
-}
-
 type ExternalCredentials struct {
 	Audience            string
 	ServiceAccountEmail string
@@ -209,18 +121,45 @@ type Config struct {
 	UniverseDomain                            string
 	Scopes                                    []string
 	BatchingConfig                            *BatchingConfig
+	RetryConfig                               *RetryConfig
+	RetryOn                                   []*RetryOnRule
+	RequestRateLimits                         map[string]float64
+	MaxConcurrentOperations                   int
+	RequestLoggingMode                        string
+	ProxyUrl                                  string
+	CaBundle                                  string
+	MinTlsVersion                             string
 	UserProjectOverride                       bool
+	AutoEnableServices                        bool
 	RequestReason                             string
 	RequestTimeout                            time.Duration
 	DefaultLabels                             map[string]string
+	DefaultAnnotations                        map[string]string
+	DefaultTags                               map[string]string
+	LabelNormalization                        bool
+	IgnoreLabels                              *IgnoreLabels
+	DefaultDeletionProtection                 bool
+	ProtectedResourceTypes                    []string
 	AddTerraformAttributionLabel              bool
 	TerraformAttributionLabelAdditionStrategy string
-	// PollInterval is passed to retry.StateChangeConf in common_operation.go
-	// It controls the interval at which we poll for successful operations
+	// PollInterval is the longest interval at which we poll for successful
+	// operations, see PollBackoff
 	PollInterval time.Duration
+	// ProductOverrides replaces RequestTimeout, PollInterval and resource
+	// default timeouts for individual products, keyed by product name.
+	ProductOverrides map[string]*ProductOverride
+	// LabelPolicy is checked against the labels of resources when planning,
+	// or nil.
+	LabelPolicy *LabelPolicy
 
 	Client             *http.Client
 	Context            context.Context
+	traceContext       context.Context
+	operationRecorder  *OperationRecorder
+	operationLimiter   *operationLimiter
+	operationSlots     *operationSlots
+	requestCoalescer   *requestCoalescer
+	noCoalescing       bool
 	UserAgent          string
 	GRPCLoggingOptions []option.ClientOption
 
@@ -826,6 +765,17 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 		c.Scopes = DefaultClientScopes
 	}
 
+	// Requests to fetch tokens use the same proxy and TLS settings as API requests.
+	baseTransport, err := c.NewBaseTransport()
+	if err != nil {
+		return err
+	}
+	tokenClient := cleanhttp.DefaultClient()
+	if baseTransport != nil {
+		tokenClient = &http.Client{Transport: baseTransport}
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, tokenClient)
+	}
+
 	c.Context = ctx
 
 	tokenSource, err := c.getTokenSource(ctx, c.Scopes, false)
@@ -834,7 +784,7 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	}
 
 	c.TokenSource = tokenSource
-	cleanCtx := context.WithValue(ctx, oauth2.HTTPClient, cleanhttp.DefaultClient())
+	cleanCtx := context.WithValue(ctx, oauth2.HTTPClient, tokenClient)
 	clientOptions := []option.ClientOption{option.WithTokenSource(tokenSource)}
 
 	// The client libraries allow setting the GOOGLE_CLOUD_QUOTA_PROJECT environment variable
@@ -847,9 +797,20 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	}
 
 	// 1. MTLS TRANSPORT/CLIENT - sets up proper auth headers
-	client, _, err := transport.NewHTTPClient(cleanCtx, clientOptions...)
-	if err != nil {
-		return err
+	// A custom base transport is needed for proxy and TLS settings, which
+	// NewHTTPClient doesn't support.
+	var client *http.Client
+	if baseTransport != nil {
+		authTransport, err := htransport.NewTransport(cleanCtx, baseTransport, clientOptions...)
+		if err != nil {
+			return err
+		}
+		client = &http.Client{Transport: authTransport}
+	} else {
+		client, _, err = transport.NewHTTPClient(cleanCtx, clientOptions...)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Userinfo is fetched before request logging is enabled to reduce additional noise.
@@ -859,17 +820,45 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	}
 
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
-	loggingTransport := logging.NewTransport("Google", client.Transport)
+	loggingTransport := NewTransportWithLogging("Google", client.Transport, c.RequestLoggingMode)
+
+	// 3. Rate Limit Transport - throttles requests per product if configured
+	// Sits below the retry transport so that each retried request is throttled as well.
+	var rateLimitedTransport http.RoundTripper = loggingTransport
+	if len(c.RequestRateLimits) > 0 {
+		products := make([]string, 0, len(c.RequestRateLimits))
+		for product := range c.RequestRateLimits {
+			products = append(products, product)
+		}
+		rateLimitedTransport = NewTransportWithRateLimits(loggingTransport, c.RequestRateLimits, c.productBasePaths(products))
+	}
 
-	// 3. Retry Transport - retries common temporary errors
+	// 4. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := NewTransportWithDefaultRetries(loggingTransport)
+	retryTransport := NewTransportWithDefaultRetries(rateLimitedTransport, c.RetryConfig)
+	c.compileRetryOnRules()
+	retryTransport.retryOn = c.RetryOn
+
+	// 5. HAR Transport - records requests to a HAR file if GOOGLE_TERRAFORM_HAR_FILE is set
+	// Sits above the retry transport so that each request is recorded once with its final response.
+	var harTransport http.RoundTripper = retryTransport
+	if path := os.Getenv(HarFileEnvVar); path != "" {
+		harTransport, err = NewTransportWithHarRecording(retryTransport, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 6. Request Cache Invalidation Transport - drops GET responses cached by SendRequest
+	// when a mutating request is made to the same resource.
+	c.requestCoalescer = newRequestCoalescer(DefaultRequestCacheTTL)
+	invalidatingTransport := NewTransportWithRequestCacheInvalidation(harTransport, c.requestCoalescer)
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 7. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
-	headerTransport := NewTransportWithHeaders(retryTransport)
+	headerTransport := NewTransportWithHeaders(invalidatingTransport)
 	if c.RequestReason != "" {
 		headerTransport.Set("X-Goog-Request-Reason", c.RequestReason)
 	}
@@ -888,34 +877,33 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	client.Transport = headerTransport
 
 	// This timeout is a timeout per HTTP request, not per logical operation.
-	client.Timeout = c.synchronousTimeout()
+	// The client's timeout applies to every request, so when products have
+	// their own request timeout all requests are timed out by a transport.
+	if timeouts, basePaths := c.productRequestTimeouts(); len(timeouts) > 0 {
+		client.Transport = NewTransportWithProductTimeouts(headerTransport, c.synchronousTimeout(), timeouts, basePaths)
+	} else {
+		client.Timeout = c.synchronousTimeout()
+	}
 
 	c.Client = client
 	c.Context = ctx
+	if c.MaxConcurrentOperations > 0 {
+		c.operationLimiter = newOperationLimiter(c.MaxConcurrentOperations)
+	}
 	c.Region = GetRegionFromRegionSelfLink(c.Region)
-	c.RequestBatcherServiceUsage = NewRequestBatcher("Service Usage", ctx, c.BatchingConfig)
+	c.RequestBatcherServiceUsage = NewRequestBatcher("Service Usage", ctx, c.BatchingConfig.withMaxItems(ServiceUsageMaxBatchItems))
 	c.RequestBatcherIam = NewRequestBatcher("IAM", ctx, c.BatchingConfig)
 	// Set default of 10s if unset by user in provider.go or LoadAndValidate was invoked directly
 	if c.PollInterval == 0 {
-		c.PollInterval = 10 * time.Second
+		c.PollInterval = DefaultPollInterval
 	}
 
 	// gRPC Logging setup
-	logger := logrus.StandardLogger()
-
-	logrus.SetLevel(logrus.DebugLevel)
-	logrus.SetFormatter(&Formatter{
-		TimestampFormat: "2006/01/02 15:04:05",
-		LogFormat:       "%time% [%lvl%] %msg% \n",
-	})
-	logger.SetOutput(log.Writer())
-
-	alwaysLoggingDeciderClient := func(ctx context.Context, fullMethodName string) bool { return true }
 	c.GRPCLoggingOptions = append(
 		c.GRPCLoggingOptions, option.WithGRPCDialOption(grpc.WithUnaryInterceptor(
-			grpc_logrus.PayloadUnaryClientInterceptor(logrus.NewEntry(logger), alwaysLoggingDeciderClient))),
+			grpcLoggingUnaryInterceptor(c.RequestLoggingMode))),
 		option.WithGRPCDialOption(grpc.WithStreamInterceptor(
-			grpc_logrus.PayloadStreamClientInterceptor(logrus.NewEntry(logger), alwaysLoggingDeciderClient))),
+			grpcLoggingStreamInterceptor(c.RequestLoggingMode))),
 	)
 
 	return nil
@@ -965,9 +953,102 @@ func ExpandProviderBatchingConfig(v interface{}) (*BatchingConfig, error) {
 		config.EnableBatching = enable.(bool)
 	}
 
+	if maxItems, ok := cfgV["max_items"]; ok {
+		config.MaxItems = maxItems.(int)
+	}
+
 	return config, nil
 }
 
+func ExpandProviderRetryConfig(v interface{}) (*RetryConfig, error) {
+	config := DefaultRetryConfig()
+
+	if v == nil {
+		return config, nil
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return config, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	if maxAttempts, ok := cfgV["max_attempts"]; ok {
+		config.MaxAttempts = maxAttempts.(int)
+	}
+
+	if initialBackoffV, ok := cfgV["initial_backoff"]; ok && initialBackoffV != "" {
+		initialBackoff, err := time.ParseDuration(initialBackoffV.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse duration from 'initial_backoff' value %q", initialBackoffV)
+		}
+		config.InitialBackoff = initialBackoff
+	}
+
+	if maxBackoffV, ok := cfgV["max_backoff"]; ok && maxBackoffV != "" {
+		maxBackoff, err := time.ParseDuration(maxBackoffV.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse duration from 'max_backoff' value %q", maxBackoffV)
+		}
+		config.MaxBackoff = maxBackoff
+	}
+
+	if jitter, ok := cfgV["jitter_factor"]; ok {
+		config.JitterFactor = jitter.(float64)
+	}
+
+	if config.MaxBackoff < config.InitialBackoff {
+		return nil, fmt.Errorf("'max_backoff' (%s) must not be less than 'initial_backoff' (%s)", config.MaxBackoff, config.InitialBackoff)
+	}
+
+	return config, nil
+}
+
+// ExpandProviderRequestRateLimits converts the provider `request_rate_limits`
+// map into requests per second keyed by registered product name, e.g.
+// "compute". Keys may be product names or base path keys, e.g.
+// ComputeBasePathKey.
+func ExpandProviderRequestRateLimits(v interface{}) (map[string]float64, error) {
+	limits := make(map[string]float64)
+	if v == nil {
+		return limits, nil
+	}
+
+	keys := make(map[string]string)
+	for k, rps := range v.(map[string]interface{}) {
+		product, ok := rateLimitProduct(k)
+		if !ok {
+			return nil, fmt.Errorf("unknown product %q in request_rate_limits, expected a product name such as %q or a base path key such as %q", k, "compute", ComputeBasePathKey)
+		}
+		if other, ok := keys[product]; ok {
+			return nil, fmt.Errorf("request_rate_limits sets the limit for %q twice, as %q and %q", product, other, k)
+		}
+		keys[product] = k
+
+		limit := rps.(float64)
+		if limit <= 0 {
+			return nil, fmt.Errorf("request_rate_limits value for %q must be greater than 0, got %v", k, limit)
+		}
+		limits[product] = limit
+	}
+
+	return limits, nil
+}
+
+// rateLimitProduct returns the name of the product a `request_rate_limits`
+// key is for, which is either the product name or its base path key.
+func rateLimitProduct(key string) (string, bool) {
+	if _, ok := registry.LookupProduct(key); ok {
+		return key, true
+	}
+	if _, ok := DefaultBasePaths[key]; ok {
+		product := strings.ToLower(key)
+		if _, ok := registry.LookupProduct(product); ok {
+			return product, true
+		}
+	}
+	return "", false
+}
+
 func (c *Config) synchronousTimeout() time.Duration {
 	if c.RequestTimeout == 0 {
 		return 120 * time.Second
@@ -1062,7 +1143,7 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 		token := &oauth2.Token{AccessToken: contents}
 		if c.ImpersonateServiceAccount != "" && !initialCredentialsOnly {
 			opts := []option.ClientOption{option.WithTokenSource(oauth2.StaticTokenSource(token)), option.ImpersonateCredentials(c.ImpersonateServiceAccount, c.ImpersonateServiceAccountDelegates...), option.WithScopes(clientScopes...)}
-			creds, err := transport.Creds(context.TODO(), opts...)
+			creds, err := transport.Creds(c.authContext(), opts...)
 			if err != nil {
 				return googleoauth.Credentials{}, err
 			}
@@ -1099,6 +1180,7 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 			jsonCreds, err := credentials.DetectDefault(&credentials.DetectOptions{
 				Scopes:          clientScopes,
 				CredentialsJSON: []byte(contents),
+				Client:          c.authHttpClient(),
 			})
 			if err != nil {
 				return googleoauth.Credentials{}, fmt.Errorf("error loading credentials: %s", err)
@@ -1109,6 +1191,7 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 				Scopes:          clientScopes,
 				Delegates:       c.ImpersonateServiceAccountDelegates,
 				Credentials:     jsonCreds,
+				Client:          c.authHttpClient(),
 			}
 
 			if c.UniverseDomain != "" && c.UniverseDomain != "googleapis.com" {
@@ -1152,6 +1235,7 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 	if c.ImpersonateServiceAccount != "" && !initialCredentialsOnly {
 		defaultCreds, err := credentials.DetectDefault(&credentials.DetectOptions{
 			Scopes: clientScopes,
+			Client: c.authHttpClient(),
 		})
 		if err != nil {
 			return googleoauth.Credentials{}, fmt.Errorf("error loading credentials: %s", err)
@@ -1162,6 +1246,7 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 			Scopes:          clientScopes,
 			Delegates:       c.ImpersonateServiceAccountDelegates,
 			Credentials:     defaultCreds,
+			Client:          c.authHttpClient(),
 		}
 
 		if c.UniverseDomain != "" && c.UniverseDomain != "googleapis.com" {
@@ -1184,20 +1269,20 @@ func (c *Config) GetCredentials(clientScopes []string, initialCredentialsOnly bo
 
 		if c.UniverseDomain != "" && c.UniverseDomain != "googleapis.com" {
 			log.Printf("[INFO]   -- Sending JwtWithScope option")
-			creds, err = transport.Creds(context.Background(), option.WithScopes(clientScopes...), internaloption.EnableJwtWithScope())
+			creds, err = transport.Creds(c.authContext(), option.WithScopes(clientScopes...), internaloption.EnableJwtWithScope())
 			if err != nil {
 				return googleoauth.Credentials{}, fmt.Errorf("Attempted to load application default credentials since neither `credentials` nor `access_token` was set in the provider block.  No credentials loaded. To use your gcloud credentials, run 'gcloud auth application-default login'.  Original error: %w", err)
 			}
 		} else {
 			if AreADCCredentialsX509() {
 				log.Printf("[INFO] Authenticating using EnableNewAuthLibrary")
-				creds, err = transport.Creds(context.Background(), option.WithScopes(clientScopes...), internaloption.EnableNewAuthLibrary())
+				creds, err = transport.Creds(c.authContext(), option.WithScopes(clientScopes...), internaloption.EnableNewAuthLibrary())
 				if err != nil {
 					//this call should be backwards compatible, but this initial implementation ahead of the EnableNewAuthLibrary being made default for all googleapi authentication calls is only intended for it to be called on X.509 requests.
 					return googleoauth.Credentials{}, fmt.Errorf("Attempted to load application default credentials since neither `credentials` nor `access_token` was set in the provider block.  No credentials loaded. To use your gcloud credentials, run 'gcloud auth application-default login'. If you are recieving this error while not attempting to authenticate using X.509 certificates, please file an issue with the provider at https://github.com/hashicorp/terraform-provider-google/issues/new/choose. Original error: %w", err)
 				}
 			} else {
-				creds, err = transport.Creds(context.Background(), option.WithScopes(clientScopes...))
+				creds, err = transport.Creds(c.authContext(), option.WithScopes(clientScopes...))
 				if err != nil {
 					return googleoauth.Credentials{}, fmt.Errorf("Attempted to load application default credentials since neither `credentials` nor `access_token` was set in the provider block.  No credentials loaded. To use your gcloud credentials, run 'gcloud auth application-default login'.  Original error: %w", err)
 				}
@@ -443,6 +443,15 @@ func TestConfigLoadAndValidate_defaultBatchingConfig(t *testing.T) {
 			transport_tpg.DefaultBatchSendIntervalSec,
 			config.RequestBatcherServiceUsage.SendAfter)
 	}
+
+	if config.RequestBatcherServiceUsage.MaxItems != transport_tpg.ServiceUsageMaxBatchItems {
+		t.Fatalf("expected Service Usage batches to hold at most %d requests, got %d",
+			transport_tpg.ServiceUsageMaxBatchItems,
+			config.RequestBatcherServiceUsage.MaxItems)
+	}
+	if config.RequestBatcherIam.MaxItems != 0 {
+		t.Fatalf("expected IAM batches to be unlimited, got %d", config.RequestBatcherIam.MaxItems)
+	}
 }
 
 func TestConfigLoadAndValidate_customBatchingConfig(t *testing.T) {
@@ -486,6 +495,103 @@ func TestConfigLoadAndValidate_customBatchingConfig(t *testing.T) {
 	}
 }
 
+func TestExpandProviderRetryConfig(t *testing.T) {
+	cases := map[string]struct {
+		Value       interface{}
+		Expected    *transport_tpg.RetryConfig
+		ExpectError bool
+	}{
+		"unset uses the defaults": {
+			Value:    nil,
+			Expected: transport_tpg.DefaultRetryConfig(),
+		},
+		"all fields set": {
+			Value: []interface{}{
+				map[string]interface{}{
+					"max_attempts":    5,
+					"initial_backoff": "1s",
+					"max_backoff":     "1m",
+					"jitter_factor":   0.5,
+				},
+			},
+			Expected: &transport_tpg.RetryConfig{
+				MaxAttempts:    5,
+				InitialBackoff: time.Second,
+				MaxBackoff:     time.Minute,
+				JitterFactor:   0.5,
+			},
+		},
+		"max_backoff less than initial_backoff": {
+			Value: []interface{}{
+				map[string]interface{}{
+					"initial_backoff": "1m",
+					"max_backoff":     "1s",
+				},
+			},
+			ExpectError: true,
+		},
+		"invalid duration": {
+			Value: []interface{}{
+				map[string]interface{}{
+					"initial_backoff": "soon",
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			cfg, err := transport_tpg.ExpandProviderRetryConfig(tc.Value)
+			if err != nil {
+				if !tc.ExpectError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.ExpectError {
+				t.Fatal("expected error but got none")
+			}
+			if *cfg != *tc.Expected {
+				t.Fatalf("expected %+v, got %+v", *tc.Expected, *cfg)
+			}
+		})
+	}
+}
+
+func TestExpandProviderRequestRateLimits(t *testing.T) {
+
+	limits, err := transport_tpg.ExpandProviderRequestRateLimits(map[string]interface{}{
+		"producttest": 0.5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limits["producttest"] != 0.5 {
+		t.Fatalf("unexpected limits: %v", limits)
+	}
+
+	limits, err = transport_tpg.ExpandProviderRequestRateLimits(map[string]interface{}{
+		transport_tpg.ComputeBasePathKey: 20.0,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error for a base path key: %v", err)
+	}
+	if limits["compute"] != 20 {
+		t.Fatalf("expected the base path key to be converted to the product name, got %v", limits)
+	}
+
+	if _, err := transport_tpg.ExpandProviderRequestRateLimits(map[string]interface{}{"compute": 20.0, "Compute": 10.0}); err == nil {
+		t.Fatal("expected error for a product set twice but got none")
+	}
+	if _, err := transport_tpg.ExpandProviderRequestRateLimits(map[string]interface{}{"Unknown": 20.0}); err == nil {
+		t.Fatal("expected error for unknown product but got none")
+	}
+	if _, err := transport_tpg.ExpandProviderRequestRateLimits(map[string]interface{}{"producttest": 0.0}); err == nil {
+		t.Fatal("expected error for non-positive limit but got none")
+	}
+}
+
 func TestRemoveBasePathVersion(t *testing.T) {
 	cases := []struct {
 		BaseURL  string
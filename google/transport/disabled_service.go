@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/disabled_service.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"google.golang.org/api/googleapi"
+)
+
+// DisabledService is an API a request failed for because it isn't enabled
+// in the project the request was made for.
+type DisabledService struct {
+	// Service is the name of the API, e.g. "compute.googleapis.com".
+	Service string
+	// Project is the ID or number of the consumer project, i.e. the project
+	// the API needs to be enabled in.
+	Project string
+}
+
+// activationUrlPattern matches the link to enable an API included in the
+// message of accessNotConfigured errors.
+var activationUrlPattern = regexp.MustCompile(`/apis/api/([a-z0-9.-]+)/overview\?project=([a-z0-9-]+)`)
+
+// DisabledServiceFromError returns the API that err was returned for because
+// it isn't enabled, from the SERVICE_DISABLED error info of the Google API
+// error it wraps or the link to enable the API in its message.
+func DisabledServiceFromError(err error) (DisabledService, bool) {
+	if err == nil {
+		return DisabledService{}, false
+	}
+	if gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error); ok && gerr != nil {
+		if gerr.Code != http.StatusForbidden {
+			return DisabledService{}, false
+		}
+		return disabledService(ParseApiErrorDetails(gerr.Details), gerr.Error())
+	}
+	return disabledService(ApiErrorDetails{}, err.Error())
+}
+
+func disabledService(details ApiErrorDetails, msg string) (DisabledService, bool) {
+	for _, info := range details.ErrorInfo {
+		if info.Reason == "SERVICE_DISABLED" && info.Metadata["service"] != "" {
+			return DisabledService{
+				Service: info.Metadata["service"],
+				Project: strings.TrimPrefix(info.Metadata["consumer"], "projects/"),
+			}, true
+		}
+	}
+	if m := activationUrlPattern.FindStringSubmatch(msg); m != nil {
+		return DisabledService{Service: m[1], Project: m[2]}, true
+	}
+	return DisabledService{}, false
+}
+
+// serviceEnabler enables an API in a project. It's registered by the
+// resourcemanager package, which batches enabling APIs with
+// google_project_service resources.
+var serviceEnabler func(config *Config, service, project string) error
+
+// RegisterServiceEnabler registers the function used to enable APIs that
+// requests fail for if auto_enable_services is set.
+func RegisterServiceEnabler(f func(config *Config, service, project string) error) {
+	serviceEnabler = f
+}
+
+// serviceEnablePropagationDelay is how long to wait after enabling an API
+// before retrying, as it takes a while for enabling an API to take effect.
+var serviceEnablePropagationDelay = 30 * time.Second
+
+// enableDisabledService enables the API that the request made with opt
+// failed with err for because it isn't enabled if auto_enable_services is
+// set, and reports whether the request should be retried. APIs are only
+// enabled for requests that change something, so that plans and refreshes
+// never do. The wait for the change to propagate stops when ctx is done.
+func (c *Config) enableDisabledService(ctx context.Context, opt SendRequestOptions, err error) bool {
+	if !c.AutoEnableServices || serviceEnabler == nil {
+		return false
+	}
+	switch opt.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	s, ok := DisabledServiceFromError(err)
+	if !ok || s.Project == "" {
+		return false
+	}
+
+	log.Printf("[INFO] Enabling %s in project %s as auto_enable_services is set", s.Service, s.Project)
+	if err := serviceEnabler(c, s.Service, s.Project); err != nil {
+		log.Printf("[WARN] Unable to enable %s in project %s: %s", s.Service, s.Project, err)
+		return false
+	}
+
+	t := time.NewTimer(serviceEnablePropagationDelay)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+	}
+	log.Printf("[DEBUG] Retrying %s %s after enabling %s in project %s", opt.Method, opt.RawURL, s.Service, s.Project)
+	return true
+}
+
+// diagnostic explains how to enable the service.
+func (s DisabledService) diagnostic() diag.Diagnostic {
+	project := "the project"
+	flag := ""
+	if s.Project != "" {
+		project = "project " + s.Project
+		flag = " --project=" + s.Project
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s is not enabled in %s", s.Service, project),
+		Detail: fmt.Sprintf("Enable it with a google_project_service resource or by running "+
+			"`gcloud services enable %s%s`, then try again once the change has propagated, "+
+			"which may take a few minutes. Alternatively, set auto_enable_services = true in "+
+			"the provider configuration to enable APIs as resources need them.", s.Service, flag),
+	}
+}
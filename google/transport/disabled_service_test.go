@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/disabled_service_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestDisabledServiceFromError(t *testing.T) {
+	serviceDisabled := &googleapi.Error{
+		Code:    403,
+		Message: "Cloud Run Admin API has not been used in project 123456 before or it is disabled.",
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "SERVICE_DISABLED",
+				"domain": "googleapis.com",
+				"metadata": map[string]interface{}{
+					"service":  "run.googleapis.com",
+					"consumer": "projects/123456",
+				},
+			},
+		},
+	}
+	accessNotConfigured := &googleapi.Error{
+		Code:    403,
+		Message: "Compute Engine API has not been used in project my-project before or it is disabled. Enable it by visiting https://console.developers.google.com/apis/api/compute.googleapis.com/overview?project=my-project then retry.",
+		Errors:  []googleapi.ErrorItem{{Reason: "accessNotConfigured"}},
+	}
+
+	cases := map[string]struct {
+		err      error
+		expected DisabledService
+		ok       bool
+	}{
+		"error info": {
+			err:      serviceDisabled,
+			expected: DisabledService{Service: "run.googleapis.com", Project: "123456"},
+			ok:       true,
+		},
+		"wrapped error info": {
+			err:      fmt.Errorf("Error creating Service: %w", serviceDisabled),
+			expected: DisabledService{Service: "run.googleapis.com", Project: "123456"},
+			ok:       true,
+		},
+		"activation link": {
+			err:      accessNotConfigured,
+			expected: DisabledService{Service: "compute.googleapis.com", Project: "my-project"},
+			ok:       true,
+		},
+		"activation link in message": {
+			err:      fmt.Errorf("Error creating Network: %s", accessNotConfigured),
+			expected: DisabledService{Service: "compute.googleapis.com", Project: "my-project"},
+			ok:       true,
+		},
+		"other code": {
+			err: &googleapi.Error{Code: 400, Message: accessNotConfigured.Message},
+		},
+		"other error": {
+			err: &googleapi.Error{Code: 403, Message: "The caller does not have permission"},
+		},
+		"nil": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, ok := DisabledServiceFromError(tc.err)
+			if ok != tc.ok || s != tc.expected {
+				t.Errorf("expected %+v (%t), got %+v (%t)", tc.expected, tc.ok, s, ok)
+			}
+		})
+	}
+
+	if !IsApiNotEnabledError(serviceDisabled) || !IsApiNotEnabledError(accessNotConfigured) {
+		t.Errorf("expected IsApiNotEnabledError to detect disabled APIs")
+	}
+}
+
+func TestApiErrorDiagnostics_disabledService(t *testing.T) {
+	err := fmt.Errorf("Error creating Network: %s", &googleapi.Error{
+		Code:    403,
+		Message: "Compute Engine API has not been used in project my-project before or it is disabled. Enable it by visiting https://console.developers.google.com/apis/api/compute.googleapis.com/overview?project=my-project then retry.",
+	})
+	diags := ApiErrorDiagnostics(err, "google_compute_network", nil)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %#v", diags)
+	}
+	if diags[1].Summary != "compute.googleapis.com is not enabled in project my-project" {
+		t.Errorf("unexpected summary %q", diags[1].Summary)
+	}
+	if !strings.Contains(diags[1].Detail, "gcloud services enable compute.googleapis.com --project=my-project") {
+		t.Errorf("unexpected detail %q", diags[1].Detail)
+	}
+}
+
+func TestSendRequest_autoEnableServices(t *testing.T) {
+	var mu sync.Mutex
+	enabled := false
+	requests := map[string]int{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests[r.Method]++
+		if !enabled {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error": {"code": 403, "message": "Cloud Run Admin API has not been used in project 123456 before or it is disabled.", "details": [{"@type": "type.googleapis.com/google.rpc.ErrorInfo", "reason": "SERVICE_DISABLED", "metadata": {"service": "run.googleapis.com", "consumer": "projects/123456"}}]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "service"}`)
+	}))
+	defer ts.Close()
+
+	oldEnabler, oldDelay := serviceEnabler, serviceEnablePropagationDelay
+	defer func() { serviceEnabler, serviceEnablePropagationDelay = oldEnabler, oldDelay }()
+	var enables []string
+	serviceEnabler = func(_ *Config, service, project string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		enables = append(enables, project+"/"+service)
+		enabled = true
+		return nil
+	}
+	serviceEnablePropagationDelay = 0
+
+	config := &Config{Client: ts.Client(), AutoEnableServices: true}
+	send := func(method string) error {
+		_, err := SendRequest(SendRequestOptions{
+			Config:  config,
+			Method:  method,
+			RawURL:  ts.URL + "/v1/projects/123456/services",
+			Timeout: time.Second,
+		})
+		return err
+	}
+
+	// Reads never enable APIs.
+	if err := send("GET"); err == nil {
+		t.Fatalf("expected GET to fail")
+	}
+	if len(enables) != 0 {
+		t.Fatalf("expected no APIs to be enabled for a GET, got %v", enables)
+	}
+
+	// Only the failed request is retried once the API is enabled.
+	if err := send("POST"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(enables) != 1 || enables[0] != "123456/run.googleapis.com" {
+		t.Errorf("expected run.googleapis.com to be enabled once, got %v", enables)
+	}
+	if requests["POST"] != 2 || requests["GET"] != 1 {
+		t.Errorf("expected 2 POSTs and 1 GET, got %v", requests)
+	}
+
+	// Without auto_enable_services, the error is returned as is.
+	config.AutoEnableServices = false
+	enabled = false
+	if err := send("POST"); err == nil {
+		t.Errorf("expected POST to fail without auto_enable_services")
+	}
+	if len(enables) != 1 {
+		t.Errorf("expected no more APIs to be enabled, got %v", enables)
+	}
+}
@@ -0,0 +1,453 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/error_details.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+// Types of the google.rpc error details rendered by TranslateApiError, see
+// https://cloud.google.com/apis/design/errors#error_details.
+const (
+	errorInfoType           = "type.googleapis.com/google.rpc.ErrorInfo"
+	quotaFailureType        = "type.googleapis.com/google.rpc.QuotaFailure"
+	preconditionFailureType = "type.googleapis.com/google.rpc.PreconditionFailure"
+	badRequestType          = "type.googleapis.com/google.rpc.BadRequest"
+	helpType                = "type.googleapis.com/google.rpc.Help"
+	localizedMessageType    = "type.googleapis.com/google.rpc.LocalizedMessage"
+)
+
+// ApiErrorDetails are the google.rpc error details of a Google API error.
+type ApiErrorDetails struct {
+	ErrorInfo              []ErrorInfo
+	QuotaViolations        []QuotaViolation
+	PreconditionViolations []PreconditionViolation
+	FieldViolations        []FieldViolation
+	Links                  []HelpLink
+	LocalizedMessages      []string
+	// Other holds details of any other type.
+	Other []interface{}
+}
+
+type ErrorInfo struct {
+	Reason   string            `json:"reason"`
+	Domain   string            `json:"domain"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+type QuotaViolation struct {
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+type PreconditionViolation struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+// FieldViolation describes an invalid field of a request. Field is the
+// path of the field in the API, e.g. "eventFilters[0].value".
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+type HelpLink struct {
+	Description string `json:"description"`
+	Url         string `json:"url"`
+}
+
+// ParseApiErrorDetails sorts the details of a googleapi.Error by type.
+func ParseApiErrorDetails(details []interface{}) ApiErrorDetails {
+	var d ApiErrorDetails
+	for _, detail := range details {
+		m, ok := detail.(map[string]interface{})
+		if !ok {
+			d.Other = append(d.Other, detail)
+			continue
+		}
+		var err error
+		switch m["@type"] {
+		case errorInfoType:
+			var info ErrorInfo
+			err = remarshal(m, &info)
+			d.ErrorInfo = append(d.ErrorInfo, info)
+		case quotaFailureType:
+			var failure struct{ Violations []QuotaViolation }
+			err = remarshal(m, &failure)
+			d.QuotaViolations = append(d.QuotaViolations, failure.Violations...)
+		case preconditionFailureType:
+			var failure struct{ Violations []PreconditionViolation }
+			err = remarshal(m, &failure)
+			d.PreconditionViolations = append(d.PreconditionViolations, failure.Violations...)
+		case badRequestType:
+			var badRequest struct{ FieldViolations []FieldViolation }
+			err = remarshal(m, &badRequest)
+			d.FieldViolations = append(d.FieldViolations, badRequest.FieldViolations...)
+		case helpType:
+			var help struct{ Links []HelpLink }
+			err = remarshal(m, &help)
+			d.Links = append(d.Links, help.Links...)
+		case localizedMessageType:
+			if msg, ok := m["message"].(string); ok {
+				d.LocalizedMessages = append(d.LocalizedMessages, msg)
+			}
+		default:
+			d.Other = append(d.Other, detail)
+		}
+		if err != nil {
+			d.Other = append(d.Other, detail)
+		}
+	}
+	return d
+}
+
+func remarshal(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// render returns the details as one line each. fieldName names fields of
+// field violations; the API field is used if it returns "".
+func (d ApiErrorDetails) render(fieldName func(string) string) []string {
+	var lines []string
+	for _, info := range d.ErrorInfo {
+		line := "Reason: " + info.Reason
+		if info.Domain != "" {
+			line += fmt.Sprintf(" (%s)", info.Domain)
+		}
+		if len(info.Metadata) > 0 {
+			keys := make([]string, 0, len(info.Metadata))
+			for k := range info.Metadata {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for i, k := range keys {
+				keys[i] = k + "=" + info.Metadata[k]
+			}
+			line += ", " + strings.Join(keys, ", ")
+		}
+		lines = append(lines, line)
+	}
+	for _, v := range d.QuotaViolations {
+		lines = append(lines, fmt.Sprintf("Quota exceeded for %q: %s", v.Subject, v.Description))
+	}
+	for _, v := range d.PreconditionViolations {
+		lines = append(lines, fmt.Sprintf("Precondition %s failed for %q: %s", v.Type, v.Subject, v.Description))
+	}
+	for _, v := range d.FieldViolations {
+		name := ""
+		if fieldName != nil {
+			name = fieldName(v.Field)
+		}
+		if name == "" {
+			name = v.Field
+		}
+		lines = append(lines, fmt.Sprintf("Invalid value for %q: %s", name, v.Description))
+	}
+	lines = append(lines, d.LocalizedMessages...)
+	for _, l := range d.Links {
+		lines = append(lines, fmt.Sprintf("See %s: %s", l.Description, l.Url))
+	}
+	for _, other := range d.Other {
+		if b, err := json.Marshal(other); err == nil {
+			lines = append(lines, string(b))
+		}
+	}
+	return lines
+}
+
+// ApiError is a Google API error whose details are rendered readably. It
+// wraps the original error, so its type and code can still be checked.
+type ApiError struct {
+	Details ApiErrorDetails
+	err     error
+	message string
+}
+
+func (e *ApiError) Error() string {
+	return e.message
+}
+
+func (e *ApiError) Unwrap() error {
+	return e.err
+}
+
+// googleapiDetailsHeader precedes the details of a googleapi.Error in its
+// message.
+const googleapiDetailsHeader = "\nDetails:\n"
+
+// TranslateApiError returns err with the google.rpc details of the Google
+// API error it wraps rendered as readable lines rather than JSON. Errors
+// that don't wrap a googleapi.Error with details, including ones that were
+// formatted with %s, are returned unchanged.
+func TranslateApiError(err error) error {
+	return translateApiError(err, nil)
+}
+
+func translateApiError(err error, fieldName func(string) string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := errwrap.GetType(err, &ApiError{}).(*ApiError); ok {
+		return err
+	}
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil || len(gerr.Details) == 0 {
+		return err
+	}
+
+	// The details are rendered between head and tail.
+	msg := err.Error()
+	raw := gerr.Error()
+	i := strings.Index(msg, raw)
+	if i < 0 {
+		return err
+	}
+	withoutDetails := *gerr
+	withoutDetails.Details = nil
+	head := msg[:i] + strings.TrimSuffix(withoutDetails.Error(), "\n")
+	tail := msg[i+len(raw):]
+	if tail != "" && !strings.HasPrefix(tail, "\n") {
+		tail = "\n" + tail
+	}
+
+	parsed := ParseApiErrorDetails(gerr.Details)
+	lines := parsed.render(fieldName)
+	for i, line := range lines {
+		lines[i] = "- " + line
+	}
+	return &ApiError{
+		Details: parsed,
+		err:     err,
+		message: head + googleapiDetailsHeader + strings.Join(lines, "\n") + tail,
+	}
+}
+
+// ApiErrorDiagnostics returns the diagnostics for an error returned by a CRUD
+// function of the resource r named resource. Details of Google API errors
+// are rendered as for TranslateApiError, and an attribute diagnostic is
+// added for each field violation that maps to an attribute of r. Errors
+// caused by a disabled API get a diagnostic explaining how to enable it.
+func ApiErrorDiagnostics(err error, resource string, r *schema.Resource) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	fieldName := func(apiField string) string {
+		return attributePathString(ApiFieldAttributePath(resource, r, apiField))
+	}
+	err = translateApiError(err, fieldName)
+	diags := diag.Diagnostics{{Severity: diag.Error, Summary: err.Error()}}
+	if s, ok := DisabledServiceFromError(err); ok {
+		diags = append(diags, s.diagnostic())
+	}
+
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) {
+		return diags
+	}
+	for _, v := range apiErr.Details.FieldViolations {
+		path := ApiFieldAttributePath(resource, r, v.Field)
+		if path == nil {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid value for %q", attributePathString(path)),
+			Detail:        v.Description,
+			AttributePath: path,
+		})
+	}
+	return diags
+}
+
+// apiFieldSegment matches a segment of an API field path with optional
+// list indexes, e.g. "eventFilters[0]".
+var apiFieldSegment = regexp.MustCompile(`^([A-Za-z0-9_]+)((?:\[\d+\])*)$`)
+
+// ApiFieldAttributePath returns the path of the attribute of r that the API
+// field apiField of a field violation refers to, or nil if there is none.
+// Fields are mapped using the resource's metadata, see
+// registry.ResourceFields, or converted to snake case if there is no
+// mapping. Leading segments the resource doesn't have, such as the name of
+// the request message, are ignored.
+func ApiFieldAttributePath(resource string, r *schema.Resource, apiField string) cty.Path {
+	if r == nil {
+		return nil
+	}
+	var names []string
+	var indexes [][]int
+	for _, segment := range strings.Split(apiField, ".") {
+		m := apiFieldSegment.FindStringSubmatch(segment)
+		if m == nil {
+			// Map keys and the like can't be mapped, refer to their parent.
+			break
+		}
+		names = append(names, m[1])
+		var idx []int
+		for _, i := range strings.Split(strings.Trim(m[2], "[]"), "][") {
+			if n, err := strconv.Atoi(i); err == nil {
+				idx = append(idx, n)
+			}
+		}
+		indexes = append(indexes, idx)
+	}
+
+	fields := registry.ResourceFields(resource)
+	for start := range names {
+		field := attributeForApiField(fields, strings.Join(names[start:], "."))
+		if field == "" {
+			continue
+		}
+		attrs := strings.Split(field, ".")
+		var attrIndexes [][]int
+		// Indexes can only be carried over if the structure is the same.
+		if len(attrs) == len(names)-start {
+			attrIndexes = indexes[start:]
+		}
+		if path := schemaAttributePath(r.SchemaMap(), attrs, attrIndexes); path != nil {
+			return path
+		}
+	}
+	return nil
+}
+
+// attributeForApiField returns the Terraform attribute that apiField maps to
+// according to fields. Segments past the longest mapped prefix of apiField
+// are converted to snake case.
+func attributeForApiField(fields []registry.FieldMetadata, apiField string) string {
+	segments := strings.Split(apiField, ".")
+	for n := len(segments); n > 0; n-- {
+		field := mappedApiField(fields, strings.Join(segments[:n], "."))
+		if field == "" {
+			continue
+		}
+		if n < len(segments) {
+			field += "." + snakeCase(strings.Join(segments[n:], "."))
+		}
+		return field
+	}
+	return snakeCase(apiField)
+}
+
+// mappedApiField returns the attribute apiField is explicitly mapped to in
+// fields, or "" if it isn't.
+func mappedApiField(fields []registry.FieldMetadata, apiField string) string {
+	for _, f := range fields {
+		if f.ApiField == apiField {
+			return f.Field
+		}
+	}
+	// Fields of a nested object are only listed individually.
+	prefix := apiField + "."
+	for _, f := range fields {
+		if !strings.HasPrefix(f.ApiField, prefix) {
+			continue
+		}
+		nested := strings.Count(f.ApiField[len(prefix):], ".") + 1
+		attrs := strings.Split(f.Field, ".")
+		if len(attrs) > nested {
+			return strings.Join(attrs[:len(attrs)-nested], ".")
+		}
+	}
+	return ""
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 && s[i-1] != '.' {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// schemaAttributePath returns the path to the attribute attrs in the schema
+// m, using indexes for list elements where known, or nil if the first
+// attribute doesn't exist. If a nested attribute doesn't exist or can't be
+// addressed, the path to its parent is returned.
+func schemaAttributePath(m map[string]*schema.Schema, attrs []string, indexes [][]int) cty.Path {
+	var path cty.Path
+	var elem int
+	for i, attr := range attrs {
+		s, ok := m[attr]
+		if !ok {
+			break
+		}
+		if i > 0 {
+			path = path.IndexInt(elem)
+		}
+		path = path.GetAttr(attr)
+
+		nested, ok := s.Elem.(*schema.Resource)
+		if !ok || s.Type != schema.TypeList {
+			break
+		}
+		switch {
+		case len(indexes) > i && len(indexes[i]) > 0:
+			elem = indexes[i][0]
+		case s.MaxItems == 1:
+			elem = 0
+		default:
+			// Elements of other lists can't be told apart.
+			return path
+		}
+		m = nested.SchemaMap()
+	}
+	return path
+}
+
+// attributePathString returns path in the dotted form used in Terraform
+// state, e.g. "destination.0.cloud_run_service.0.service".
+func attributePathString(path cty.Path) string {
+	var parts []string
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				i, _ := s.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(i, 10))
+			}
+		}
+	}
+	return strings.Join(parts, ".")
+}
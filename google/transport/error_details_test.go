@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/error_details_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/googleapi"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+func init() {
+	registry.RegisterResourceFields("google_errortest_trigger", []registry.FieldMetadata{
+		{ApiField: "destination.cloudRun.service", Field: "destination.cloud_run_service.service"},
+		{ApiField: "eventFilters.value", Field: "matching_criteria.value"},
+	})
+}
+
+func errorTestResource() *schema.Resource {
+	nested := func(s map[string]*schema.Schema) *schema.Resource { return &schema.Resource{Schema: s} }
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+			"destination": {Type: schema.TypeList, MaxItems: 1, Required: true, Elem: nested(map[string]*schema.Schema{
+				"cloud_run_service": {Type: schema.TypeList, MaxItems: 1, Optional: true, Elem: nested(map[string]*schema.Schema{
+					"service": {Type: schema.TypeString, Required: true},
+				})},
+			})},
+			"matching_criteria": {Type: schema.TypeList, Required: true, Elem: nested(map[string]*schema.Schema{
+				"value": {Type: schema.TypeString, Required: true},
+			})},
+			"service_account": {Type: schema.TypeString, Optional: true},
+			"labels":          {Type: schema.TypeMap, Optional: true},
+		},
+	}
+}
+
+func errorTestApiError() *googleapi.Error {
+	return &googleapi.Error{
+		Code:    400,
+		Message: "Request contains an invalid argument.",
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":    "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason":   "INVALID_ARGUMENT",
+				"domain":   "eventarc.googleapis.com",
+				"metadata": map[string]interface{}{"service": "eventarc.googleapis.com", "method": "CreateTrigger"},
+			},
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.BadRequest",
+				"fieldViolations": []interface{}{
+					map[string]interface{}{"field": "trigger.eventFilters[1].value", "description": "must not be empty"},
+					map[string]interface{}{"field": "destination.cloudRun.service", "description": "service not found"},
+				},
+			},
+			map[string]interface{}{
+				"@type": "type.googleapis.com/google.rpc.Help",
+				"links": []interface{}{map[string]interface{}{"description": "Eventarc docs", "url": "https://cloud.google.com/eventarc/docs"}},
+			},
+			map[string]interface{}{
+				"@type":     "type.googleapis.com/google.rpc.RequestInfo",
+				"requestId": "abc",
+			},
+		},
+	}
+}
+
+func TestParseApiErrorDetails(t *testing.T) {
+	d := ParseApiErrorDetails([]interface{}{
+		map[string]interface{}{
+			"@type":      "type.googleapis.com/google.rpc.QuotaFailure",
+			"violations": []interface{}{map[string]interface{}{"subject": "project:123", "description": "CPUS exceeded"}},
+		},
+		map[string]interface{}{
+			"@type":      "type.googleapis.com/google.rpc.PreconditionFailure",
+			"violations": []interface{}{map[string]interface{}{"type": "TOS", "subject": "project:123", "description": "terms not accepted"}},
+		},
+	})
+	if len(d.QuotaViolations) != 1 || d.QuotaViolations[0].Subject != "project:123" {
+		t.Errorf("unexpected quota violations: %#v", d.QuotaViolations)
+	}
+	if len(d.PreconditionViolations) != 1 || d.PreconditionViolations[0].Type != "TOS" {
+		t.Errorf("unexpected precondition violations: %#v", d.PreconditionViolations)
+	}
+
+	lines := d.render(nil)
+	expected := []string{
+		`Quota exceeded for "project:123": CPUS exceeded`,
+		`Precondition TOS failed for "project:123": terms not accepted`,
+	}
+	if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected rendering:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestTranslateApiError(t *testing.T) {
+	gerr := errorTestApiError()
+	expected := `googleapi: Error 400: Request contains an invalid argument.
+Details:
+- Reason: INVALID_ARGUMENT (eventarc.googleapis.com), method=CreateTrigger, service=eventarc.googleapis.com
+- Invalid value for "trigger.eventFilters[1].value": must not be empty
+- Invalid value for "destination.cloudRun.service": service not found
+- See Eventarc docs: https://cloud.google.com/eventarc/docs
+- {"@type":"type.googleapis.com/google.rpc.RequestInfo","requestId":"abc"}`
+
+	cases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"typed": {
+			err:      gerr,
+			expected: expected,
+		},
+		"wrapped": {
+			err:      errwrap.Wrapf("Error reading Trigger: {{err}}", gerr),
+			expected: "Error reading Trigger: " + expected,
+		},
+		"flattened": {
+			err:      fmt.Errorf("Error creating Trigger: %s", gerr),
+			expected: fmt.Sprintf("Error creating Trigger: %s", gerr),
+		},
+		"wrapped with %w": {
+			err: fmt.Errorf("Error creating Trigger: %w", &googleapi.Error{
+				Code:    403,
+				Message: "denied",
+				Details: []interface{}{map[string]interface{}{"@type": "type.googleapis.com/google.rpc.LocalizedMessage", "message": "Permission denied"}},
+				Errors:  []googleapi.ErrorItem{{Reason: "forbidden", Message: "denied"}},
+			}),
+			expected: "Error creating Trigger: googleapi: Error 403: denied, forbidden\nDetails:\n- Permission denied",
+		},
+		"no details": {
+			err:      fmt.Errorf("Error creating Trigger: %s", &googleapi.Error{Code: 404, Message: "not found"}),
+			expected: "Error creating Trigger: googleapi: Error 404: not found",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := TranslateApiError(tc.err)
+			if err.Error() != tc.expected {
+				t.Errorf("unexpected message:\n%s\nexpected:\n%s", err, tc.expected)
+			}
+			if !errors.Is(err, tc.err) {
+				t.Errorf("expected translated error to wrap the original")
+			}
+		})
+	}
+
+	if !IsGoogleApiErrorWithCode(TranslateApiError(gerr), 400) {
+		t.Errorf("expected translated error to keep its code")
+	}
+}
+
+func TestApiFieldAttributePath(t *testing.T) {
+	r := errorTestResource()
+	cases := map[string]string{
+		"name":                            "name",
+		"trigger.name":                    "name",
+		"destination.cloudRun.service":    "destination.0.cloud_run_service.0.service",
+		"destination.cloudRun":            "destination.0.cloud_run_service",
+		"eventFilters[1].value":           "matching_criteria.1.value",
+		"eventFilters":                    "matching_criteria",
+		"serviceAccount":                  "service_account",
+		"labels[\"env\"]":                 "",
+		"trigger.labels":                  "labels",
+		"updateMask":                      "",
+		"destination.cloudRun.unknownKey": "destination.0.cloud_run_service",
+	}
+	for apiField, expected := range cases {
+		path := ApiFieldAttributePath("google_errortest_trigger", r, apiField)
+		if got := attributePathString(path); got != expected {
+			t.Errorf("%s: expected %q, got %q", apiField, expected, got)
+		}
+	}
+}
+
+func TestApiErrorDiagnostics(t *testing.T) {
+	diags := ApiErrorDiagnostics(fmt.Errorf("Error creating Trigger: %w", errorTestApiError()), "google_errortest_trigger", errorTestResource())
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %d: %#v", len(diags), diags)
+	}
+	if !strings.Contains(diags[0].Summary, `Invalid value for "matching_criteria.1.value": must not be empty`) {
+		t.Errorf("expected summary to name attributes, got:\n%s", diags[0].Summary)
+	}
+	for i, expected := range []string{"matching_criteria.1.value", "destination.0.cloud_run_service.0.service"} {
+		d := diags[i+1]
+		if got := attributePathString(d.AttributePath); got != expected {
+			t.Errorf("expected attribute path %q, got %q", expected, got)
+		}
+	}
+
+	if diags := ApiErrorDiagnostics(nil, "google_errortest_trigger", errorTestResource()); diags != nil {
+		t.Errorf("expected no diagnostics, got %#v", diags)
+	}
+}
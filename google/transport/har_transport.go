@@ -0,0 +1,315 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/har_transport.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/version"
+)
+
+// HarFileEnvVar is the path of a HAR 1.2 file that every request the
+// provider makes is recorded to, for attaching to support cases.
+const HarFileEnvVar = "GOOGLE_TERRAFORM_HAR_FILE"
+
+// HAR 1.2 types, see http://www.softwareishard.com/blog/har-12-spec/. Only
+// the fields the provider can fill in are included.
+type (
+	Har struct {
+		Log HarLog `json:"log"`
+	}
+
+	HarLog struct {
+		Version string      `json:"version"`
+		Creator HarCreator  `json:"creator"`
+		Entries []*HarEntry `json:"entries"`
+	}
+
+	HarCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	HarEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         HarRequest  `json:"request"`
+		Response        HarResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         HarTimings  `json:"timings"`
+		// Error is set if no response was received.
+		Error string `json:"_error,omitempty"`
+	}
+
+	HarRequest struct {
+		Method      string         `json:"method"`
+		Url         string         `json:"url"`
+		HttpVersion string         `json:"httpVersion"`
+		Cookies     []HarNameValue `json:"cookies"`
+		Headers     []HarNameValue `json:"headers"`
+		QueryString []HarNameValue `json:"queryString"`
+		PostData    *HarPostData   `json:"postData,omitempty"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	HarResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HttpVersion string         `json:"httpVersion"`
+		Cookies     []HarNameValue `json:"cookies"`
+		Headers     []HarNameValue `json:"headers"`
+		Content     HarContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	HarNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	HarPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	HarContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text,omitempty"`
+	}
+
+	HarTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+// harRecorder appends entries to a HAR file, keeping the file a valid HAR
+// document after every entry so it is usable even if the provider is killed.
+type harRecorder struct {
+	mu      sync.Mutex
+	f       *os.File
+	entries int
+}
+
+// harTrailer closes the entries array and the document after the last entry.
+const harTrailer = "]}}\n"
+
+var (
+	harRecordersMu sync.Mutex
+	harRecorders   = make(map[string]*harRecorder)
+)
+
+// harRecorderForPath returns the recorder for a HAR file, creating the file
+// if needed. Recorders are shared by all provider instances in the process.
+func harRecorderForPath(path string) (*harRecorder, error) {
+	harRecordersMu.Lock()
+	defer harRecordersMu.Unlock()
+
+	if r, ok := harRecorders[path]; ok {
+		return r, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	header, err := json.Marshal(Har{Log: HarLog{
+		Version: "1.2",
+		Creator: HarCreator{Name: "terraform-provider-google", Version: version.ProviderVersion},
+		Entries: []*HarEntry{},
+	}})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	// Drop the closing "]}}" of the empty document so entries can be added.
+	if _, err := f.Write(append(header[:len(header)-3], harTrailer...)); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	r := &harRecorder{f: f}
+	harRecorders[path] = r
+	return r, nil
+}
+
+func (r *harRecorder) record(entry *HarEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.f.Seek(-int64(len(harTrailer)), io.SeekEnd); err != nil {
+		return err
+	}
+	if r.entries > 0 {
+		b = append([]byte(","), b...)
+	}
+	if _, err := r.f.Write(append(b, harTrailer...)); err != nil {
+		return err
+	}
+	r.entries++
+	return nil
+}
+
+// harTransport records each request and its response to a HAR file, with
+// credentials and sensitive fields redacted as for RequestLoggingRedacted.
+//
+// It should sit above the retry transport so that each request is recorded
+// once, with the final response and the time taken by all attempts.
+type harTransport struct {
+	recorder    *harRecorder
+	baseTransit http.RoundTripper
+}
+
+// NewTransportWithHarRecording returns a transport that records requests and
+// responses to the HAR file at path.
+func NewTransportWithHarRecording(baseTransit http.RoundTripper, path string) (http.RoundTripper, error) {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+	r, err := harRecorderForPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening HAR file %q: %w", path, err)
+	}
+	return &harTransport{recorder: r, baseTransit: baseTransit}, nil
+}
+
+func (t *harTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := &HarEntry{
+		StartedDateTime: time.Now().UTC().Format(time.RFC3339Nano),
+		Request:         harRequest(req),
+	}
+
+	start := time.Now()
+	resp, respErr := t.baseTransit.RoundTrip(req)
+	waited := time.Since(start)
+
+	if respErr != nil {
+		entry.Error = respErr.Error()
+	} else {
+		entry.Response = harResponse(resp)
+	}
+	entry.Time = float64(waited.Microseconds()) / 1000
+	entry.Timings = HarTimings{Wait: entry.Time}
+
+	if err := t.recorder.record(entry); err != nil {
+		log.Printf("[WARN] HAR Transport: Unable to record request: %s", err)
+	}
+	return resp, respErr
+}
+
+func harRequest(req *http.Request) HarRequest {
+	r := HarRequest{
+		Method:      req.Method,
+		Url:         req.URL.String(),
+		HttpVersion: req.Proto,
+		Cookies:     []HarNameValue{},
+		Headers:     harHeaders(req.Header),
+		QueryString: []HarNameValue{},
+		HeadersSize: -1,
+		BodySize:    0,
+	}
+	if r.HttpVersion == "" {
+		r.HttpVersion = "HTTP/1.1"
+	}
+	for k, vs := range req.URL.Query() {
+		for _, v := range vs {
+			r.QueryString = append(r.QueryString, HarNameValue{Name: k, Value: v})
+		}
+	}
+	sort.Slice(r.QueryString, func(i, j int) bool { return r.QueryString[i].Name < r.QueryString[j].Name })
+
+	// Read a copy of the body so the original can still be sent.
+	copied, err := copyHttpRequest(req)
+	if err != nil || copied.Body == nil || copied.Body == http.NoBody {
+		return r
+	}
+	defer copied.Body.Close()
+	body, err := io.ReadAll(copied.Body)
+	if err != nil {
+		return r
+	}
+	r.BodySize = len(body)
+	r.PostData = &HarPostData{
+		MimeType: req.Header.Get("Content-Type"),
+		Text:     harBody(body),
+	}
+	return r
+}
+
+func harResponse(resp *http.Response) HarResponse {
+	r := HarResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HttpVersion: resp.Proto,
+		Cookies:     []HarNameValue{},
+		Headers:     harHeaders(resp.Header),
+		Content:     HarContent{MimeType: resp.Header.Get("Content-Type")},
+		HeadersSize: -1,
+	}
+	body, err := peekResponseBody(resp)
+	if err != nil {
+		log.Printf("[WARN] HAR Transport: Unable to read response body: %s", err)
+	}
+	r.BodySize = len(body)
+	r.Content.Size = len(body)
+	r.Content.Text = harBody(body)
+	return r
+}
+
+// harBody returns the text recorded for a body. JSON bodies are recorded
+// with sensitive fields redacted; other bodies aren't recorded.
+func harBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return ""
+	}
+	b, err := json.Marshal(redactJson(v))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func harHeaders(h http.Header) []HarNameValue {
+	headers := []HarNameValue{}
+	for k, v := range loggedHeaders(h) {
+		headers = append(headers, HarNameValue{Name: k, Value: v})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/har_transport_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestHarTransport_recordsRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"name": "k", "privateKeyData": "c2VjcmV0"}`)
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "requests.har")
+	rt, err := NewTransportWithHarRecording(ts.Client().Transport, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: rt}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest("POST", ts.URL+"/v1/projects/p/serviceAccounts/sa/keys?alt=json", strings.NewReader(`{"keyAlgorithm": "KEY_ALG_RSA_2048"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer ya29.secret-token")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if !strings.Contains(string(body), "c2VjcmV0") {
+			t.Fatalf("expected the caller to receive the full response, got %s", body)
+		}
+
+		// The file is a valid HAR document after every request.
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var har Har
+		if err := json.Unmarshal(b, &har); err != nil {
+			t.Fatalf("expected a valid HAR file, got error %v: %s", err, b)
+		}
+		if len(har.Log.Entries) != i+1 {
+			t.Fatalf("expected %d entries, got %d", i+1, len(har.Log.Entries))
+		}
+		if strings.Contains(string(b), "ya29.secret-token") || strings.Contains(string(b), "c2VjcmV0") {
+			t.Fatalf("expected credentials and sensitive fields to be redacted: %s", b)
+		}
+
+		e := har.Log.Entries[i]
+		if e.Request.Method != "POST" || e.Response.Status != 200 {
+			t.Errorf("unexpected entry: %+v", e)
+		}
+		if e.Request.PostData == nil || !strings.Contains(e.Request.PostData.Text, "KEY_ALG_RSA_2048") {
+			t.Errorf("expected request body to be recorded, got %+v", e.Request.PostData)
+		}
+		if len(e.Request.QueryString) != 1 || e.Request.QueryString[0].Name != "alt" {
+			t.Errorf("expected query string to be recorded, got %+v", e.Request.QueryString)
+		}
+	}
+}
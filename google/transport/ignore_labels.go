@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/ignore_labels.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import "strings"
+
+// IgnoreLabels holds the provider `ignore_labels` block, naming label and
+// annotation keys that are managed outside of Terraform.
+type IgnoreLabels struct {
+	// Keys are ignored label keys.
+	Keys []string
+	// KeyPrefixes are prefixes of ignored label keys.
+	KeyPrefixes []string
+}
+
+// ExpandProviderIgnoreLabels converts the provider `ignore_labels` block, or
+// returns nil if it isn't set.
+func ExpandProviderIgnoreLabels(v interface{}) *IgnoreLabels {
+	l, _ := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	cfgV := l[0].(map[string]interface{})
+
+	i := &IgnoreLabels{}
+	if keys, ok := cfgV["keys"].([]interface{}); ok {
+		for _, k := range keys {
+			i.Keys = append(i.Keys, k.(string))
+		}
+	}
+	if prefixes, ok := cfgV["key_prefixes"].([]interface{}); ok {
+		for _, p := range prefixes {
+			i.KeyPrefixes = append(i.KeyPrefixes, p.(string))
+		}
+	}
+	return i
+}
+
+// Ignored returns whether the label or annotation key is ignored.
+func (i *IgnoreLabels) Ignored(key string) bool {
+	if i == nil {
+		return false
+	}
+	for _, k := range i.Keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, p := range i.KeyPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
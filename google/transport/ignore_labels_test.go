@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/ignore_labels_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import "testing"
+
+func TestIgnoreLabels(t *testing.T) {
+	i := ExpandProviderIgnoreLabels([]interface{}{map[string]interface{}{
+		"keys":         []interface{}{"managed-by-cnrm"},
+		"key_prefixes": []interface{}{"goog-"},
+	}})
+
+	cases := map[string]bool{
+		"managed-by-cnrm":            true,
+		"managed-by-cnrm-2":          false,
+		"goog-dataproc-cluster-name": true,
+		"goog":                       false,
+		"env":                        false,
+	}
+	for key, expected := range cases {
+		if got := i.Ignored(key); got != expected {
+			t.Errorf("expected Ignored(%q) to be %t, got %t", key, expected, got)
+		}
+	}
+
+	if ExpandProviderIgnoreLabels([]interface{}{}).Ignored("goog-x") {
+		t.Errorf("expected no keys to be ignored without an ignore_labels block")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/label_policy.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+const (
+	LabelPolicyModeWarn  = "warn"
+	LabelPolicyModeError = "error"
+)
+
+// LabelPolicyModes are the values accepted by the `mode` of the provider's
+// `label_policy` block.
+var LabelPolicyModes = []string{LabelPolicyModeWarn, LabelPolicyModeError}
+
+// LabelPolicy holds the provider `label_policy` block, which resources with
+// `effective_labels` are checked against at plan time.
+type LabelPolicy struct {
+	// RequiredKeys are the label keys every resource must have.
+	RequiredKeys []string
+	// AllowedValues are the patterns the whole values of label keys must
+	// match, keyed by label key.
+	AllowedValues map[string]*regexp.Regexp
+	// Mode is LabelPolicyModeWarn to only warn about violations, or
+	// LabelPolicyModeError to fail the plan.
+	Mode string
+}
+
+// ExpandProviderLabelPolicy converts the provider `label_policy` block, or
+// returns nil if it isn't set.
+func ExpandProviderLabelPolicy(v interface{}) (*LabelPolicy, error) {
+	l, _ := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	cfgV := l[0].(map[string]interface{})
+
+	p := &LabelPolicy{
+		AllowedValues: make(map[string]*regexp.Regexp),
+		Mode:          LabelPolicyModeError,
+	}
+	if mode, _ := cfgV["mode"].(string); mode != "" {
+		p.Mode = mode
+	}
+	if keys, ok := cfgV["required_keys"].([]interface{}); ok {
+		for _, k := range keys {
+			p.RequiredKeys = append(p.RequiredKeys, k.(string))
+		}
+	}
+	if allowed, ok := cfgV["allowed_values"].(map[string]interface{}); ok {
+		for k, pattern := range allowed {
+			re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern))
+			if err != nil {
+				return nil, fmt.Errorf("invalid label_policy allowed_values pattern for %q: %s", k, err)
+			}
+			p.AllowedValues[k] = re
+		}
+	}
+	return p, nil
+}
+
+// Violations returns how labels violate the policy, sorted by label key.
+func (p *LabelPolicy) Violations(labels map[string]string) []string {
+	if p == nil {
+		return nil
+	}
+	var violations []string
+	for _, k := range p.RequiredKeys {
+		if _, ok := labels[k]; !ok {
+			violations = append(violations, fmt.Sprintf("required label %q is missing", k))
+		}
+	}
+
+	keys := make([]string, 0, len(p.AllowedValues))
+	for k := range p.AllowedValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, ok := labels[k]
+		if ok && !p.AllowedValues[k].MatchString(v) {
+			violations = append(violations, fmt.Sprintf("label %q has value %q, which isn't allowed by %s", k, v, p.AllowedValues[k]))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/label_policy_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLabelPolicy(t *testing.T) {
+	p, err := ExpandProviderLabelPolicy([]interface{}{map[string]interface{}{
+		"required_keys":  []interface{}{"cost-center", "team"},
+		"allowed_values": map[string]interface{}{"env": "prod|dev"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Mode != LabelPolicyModeError {
+		t.Errorf("expected violations to be errors by default, got mode %q", p.Mode)
+	}
+
+	cases := map[string]struct {
+		labels   map[string]string
+		expected []string
+	}{
+		"compliant": {
+			labels: map[string]string{"cost-center": "123", "team": "data", "env": "prod"},
+		},
+		"missing key": {
+			labels:   map[string]string{"team": "data"},
+			expected: []string{`required label "cost-center" is missing`},
+		},
+		"value not allowed": {
+			labels:   map[string]string{"cost-center": "123", "team": "data", "env": "production"},
+			expected: []string{`label "env" has value "production", which isn't allowed by ^(?:prod|dev)$`},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if violations := p.Violations(tc.labels); !reflect.DeepEqual(violations, tc.expected) {
+				t.Errorf("expected violations %q, got %q", tc.expected, violations)
+			}
+		})
+	}
+
+	if _, err := ExpandProviderLabelPolicy([]interface{}{map[string]interface{}{
+		"allowed_values": map[string]interface{}{"env": "("},
+	}}); err == nil {
+		t.Errorf("expected an invalid pattern to be rejected")
+	}
+	if p, err := ExpandProviderLabelPolicy([]interface{}{}); p != nil || err != nil {
+		t.Errorf("expected no policy when label_policy isn't set, got %v, %v", p, err)
+	}
+}
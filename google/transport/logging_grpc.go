@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/logging_grpc.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type loggedGrpcMessage struct {
+	Id         uint64      `json:"id"`
+	Method     string      `json:"method"`
+	Code       string      `json:"code,omitempty"`
+	DurationMs int64       `json:"duration_ms,omitempty"`
+	Payload    interface{} `json:"payload,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// grpcLoggingUnaryInterceptor logs each unary gRPC call the same way
+// loggingTransport logs HTTP requests.
+func grpcLoggingUnaryInterceptor(mode string) grpc.UnaryClientInterceptor {
+	if mode == "" {
+		mode = RequestLoggingRedacted
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !logging.IsDebugOrHigher() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		id := atomic.AddUint64(&loggedRequestId, 1)
+		logGrpcMessage("Request", loggedGrpcMessage{Id: id, Method: method, Payload: loggedGrpcPayload(req, mode)})
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		msg := loggedGrpcMessage{
+			Id:         id,
+			Method:     method,
+			Code:       status.Code(err).String(),
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			msg.Error = err.Error()
+		} else {
+			msg.Payload = loggedGrpcPayload(reply, mode)
+		}
+		logGrpcMessage("Response", msg)
+		return err
+	}
+}
+
+// grpcLoggingStreamInterceptor logs each message sent and received on a
+// streaming gRPC call.
+func grpcLoggingStreamInterceptor(mode string) grpc.StreamClientInterceptor {
+	if mode == "" {
+		mode = RequestLoggingRedacted
+	}
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil || !logging.IsDebugOrHigher() {
+			return stream, err
+		}
+		return &loggingClientStream{
+			ClientStream: stream,
+			id:           atomic.AddUint64(&loggedRequestId, 1),
+			method:       method,
+			mode:         mode,
+		}, nil
+	}
+}
+
+type loggingClientStream struct {
+	grpc.ClientStream
+	id     uint64
+	method string
+	mode   string
+}
+
+func (s *loggingClientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	msg := loggedGrpcMessage{Id: s.id, Method: s.method, Payload: loggedGrpcPayload(m, s.mode)}
+	if err != nil {
+		msg.Error = err.Error()
+	}
+	logGrpcMessage("Request", msg)
+	return err
+}
+
+func (s *loggingClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		return err
+	}
+	msg := loggedGrpcMessage{Id: s.id, Method: s.method, Code: status.Code(err).String()}
+	if err != nil {
+		msg.Error = err.Error()
+	} else {
+		msg.Payload = loggedGrpcPayload(m, s.mode)
+	}
+	logGrpcMessage("Response", msg)
+	return err
+}
+
+func loggedGrpcPayload(m interface{}, mode string) interface{} {
+	if mode == RequestLoggingMetadata || m == nil {
+		return nil
+	}
+	var b []byte
+	var err error
+	if pm, ok := m.(proto.Message); ok {
+		b, err = protojson.Marshal(pm)
+	} else {
+		b, err = json.Marshal(m)
+	}
+	if err != nil {
+		return nil
+	}
+	if mode == RequestLoggingFull {
+		return json.RawMessage(b)
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return redactJson(v)
+}
+
+func logGrpcMessage(kind string, msg loggedGrpcMessage) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("[ERROR] gRPC %s error: %s", kind, err)
+		return
+	}
+	log.Printf("[DEBUG] Google gRPC %s: %s", kind, b)
+}
@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/logging_transport.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+const (
+	// RequestLoggingFull logs request and response bodies as-is.
+	RequestLoggingFull = "full"
+	// RequestLoggingRedacted logs request and response bodies with sensitive
+	// fields replaced.
+	RequestLoggingRedacted = "redacted"
+	// RequestLoggingMetadata logs only the method, URL, status and timing of
+	// each request.
+	RequestLoggingMetadata = "metadata"
+
+	redactedValue = "REDACTED"
+)
+
+var RequestLoggingModes = []string{RequestLoggingFull, RequestLoggingRedacted, RequestLoggingMetadata}
+
+// redactedHeaders are never logged, regardless of the logging mode.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Goog-Api-Key",
+}
+
+// defaultRedactedFields are API fields known to hold secrets that aren't
+// covered by a Sensitive or WriteOnly field in a resource schema.
+var defaultRedactedFields = []string{
+	"accessToken",
+	"apiKey",
+	"clientSecret",
+	"idToken",
+	"password",
+	"privateKey",
+	"privateKeyData",
+	"refreshToken",
+	"secret",
+	"secretData",
+	"token",
+}
+
+var (
+	redactedFieldsMu sync.RWMutex
+	redactedFields   = normalizedFieldSet(defaultRedactedFields)
+)
+
+// RegisterSensitiveFields adds field names whose values are redacted from
+// logged request and response bodies. Names are matched regardless of case
+// and of whether they are written in snake_case or camelCase, so that schema
+// fields match the API fields they are sent as.
+func RegisterSensitiveFields(names ...string) {
+	redactedFieldsMu.Lock()
+	defer redactedFieldsMu.Unlock()
+	for _, name := range names {
+		redactedFields[normalizeFieldName(name)] = struct{}{}
+	}
+}
+
+func isRedactedField(name string) bool {
+	redactedFieldsMu.RLock()
+	defer redactedFieldsMu.RUnlock()
+	_, ok := redactedFields[normalizeFieldName(name)]
+	return ok
+}
+
+func normalizedFieldSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[normalizeFieldName(name)] = struct{}{}
+	}
+	return set
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// loggingTransport logs each request and response as a single line of JSON
+// at DEBUG level, redacting credentials and, depending on the mode, sensitive
+// fields or bodies entirely.
+type loggingTransport struct {
+	name        string
+	mode        string
+	baseTransit http.RoundTripper
+}
+
+var loggedRequestId uint64
+
+// NewTransportWithLogging returns a transport that logs requests and responses
+// according to mode, one of RequestLoggingModes. An unset mode defaults to
+// RequestLoggingRedacted.
+func NewTransportWithLogging(name string, baseTransit http.RoundTripper, mode string) http.RoundTripper {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+	if mode == "" {
+		mode = RequestLoggingRedacted
+	}
+	return &loggingTransport{name: name, mode: mode, baseTransit: baseTransit}
+}
+
+type loggedHttpMessage struct {
+	Id         uint64            `json:"id"`
+	Method     string            `json:"method"`
+	Url        string            `json:"url"`
+	StatusCode int               `json:"status_code,omitempty"`
+	DurationMs int64             `json:"duration_ms,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       interface{}       `json:"body,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !logging.IsDebugOrHigher() {
+		return t.baseTransit.RoundTrip(req)
+	}
+
+	id := atomic.AddUint64(&loggedRequestId, 1)
+	msg := loggedHttpMessage{
+		Id:     id,
+		Method: req.Method,
+		Url:    req.URL.String(),
+	}
+	if t.mode != RequestLoggingMetadata {
+		msg.Headers = loggedHeaders(req.Header)
+		body, err := peekRequestBody(req)
+		if err != nil {
+			log.Printf("[ERROR] %s API Request error: %s", t.name, err)
+		}
+		msg.Body = t.loggedBody(body, req.Header.Get("Content-Type"))
+	}
+	t.log("Request", msg)
+
+	start := time.Now()
+	resp, err := t.baseTransit.RoundTrip(req)
+
+	msg = loggedHttpMessage{
+		Id:         id,
+		Method:     req.Method,
+		Url:        req.URL.String(),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		msg.Error = err.Error()
+		t.log("Response", msg)
+		return resp, err
+	}
+	msg.StatusCode = resp.StatusCode
+	if t.mode != RequestLoggingMetadata {
+		msg.Headers = loggedHeaders(resp.Header)
+		body, err := peekResponseBody(resp)
+		if err != nil {
+			log.Printf("[ERROR] %s API Response error: %s", t.name, err)
+		}
+		msg.Body = t.loggedBody(body, resp.Header.Get("Content-Type"))
+	}
+	t.log("Response", msg)
+
+	return resp, nil
+}
+
+func (t *loggingTransport) log(kind string, msg loggedHttpMessage) {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("[ERROR] %s API %s error: %s", t.name, kind, err)
+		return
+	}
+	log.Printf("[DEBUG] %s API %s: %s", t.name, kind, b)
+}
+
+// loggedBody returns the value logged for a body. JSON bodies are embedded as
+// JSON, with sensitive fields redacted in redacted mode. Other bodies are only
+// logged in full mode.
+func (t *loggingTransport) loggedBody(body []byte, contentType string) interface{} {
+	if len(body) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		if t.mode == RequestLoggingFull {
+			return string(body)
+		}
+		return fmt.Sprintf("<%d bytes of %q content>", len(body), contentType)
+	}
+	if t.mode == RequestLoggingFull {
+		return json.RawMessage(body)
+	}
+	return redactJson(v)
+}
+
+// redactJson replaces the values of sensitive fields in a decoded JSON value.
+func redactJson(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if isRedactedField(k) {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redactJson(val)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJson(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func loggedHeaders(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(h))
+	for k, v := range h {
+		headers[k] = strings.Join(v, ", ")
+	}
+	for _, k := range redactedHeaders {
+		if _, ok := headers[http.CanonicalHeaderKey(k)]; ok {
+			headers[http.CanonicalHeaderKey(k)] = redactedValue
+		}
+	}
+	return headers
+}
+
+// peekRequestBody returns a copy of the request body, leaving it unread.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+// peekResponseBody returns a copy of the response body, leaving it unread.
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	return b, err
+}
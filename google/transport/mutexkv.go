@@ -17,33 +17,96 @@
 package transport
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
 )
 
+// MutexWaitLogThreshold is how long a caller may wait for a lock before the
+// holders and waiters of all locks are logged, and how often they are logged
+// again while it keeps waiting.
+var MutexWaitLogThreshold = 5 * time.Minute
+
+// maxMutexReaders is the weight of an exclusive lock, so that it excludes
+// any number of shared locks.
+const maxMutexReaders = 1 << 30
+
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
+//
+// Each mutex records who holds and waits for it, so that a hanging apply can
+// be traced back to the resource holding the lock.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.RWMutex
+	store map[string]*keyMutex
+}
+
+type keyMutex struct {
+	sem *semaphore.Weighted
+	// holders and waiters are guarded by MutexKV.lock.
+	holders []*mutexHolder
+	waiters []*mutexHolder
+}
+
+// mutexHolder describes a caller holding or waiting for a lock.
+type mutexHolder struct {
+	name      string
+	exclusive bool
+	since     time.Time
+}
+
+func (h *mutexHolder) weight() int64 {
+	if h.exclusive {
+		return maxMutexReaders
+	}
+	return 1
+}
+
+func (h *mutexHolder) String() string {
+	mode := "shared"
+	if h.exclusive {
+		mode = "exclusive"
+	}
+	return fmt.Sprintf("%s (%s, %s)", h.name, mode, time.Since(h.since).Round(time.Second))
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
+	m.wait(key, callerName(), true)
 	log.Printf("[DEBUG] Locked %q", key)
 }
 
+// LockWithContext locks the mutex for the given key, giving up once ctx is
+// done, e.g. when the resource's timeout is reached or Terraform is
+// interrupted. holder describes the caller, such as a resource address, in
+// logs of long waits. Caller is responsible for calling Unlock for the same
+// key if no error is returned.
+func (m *MutexKV) LockWithContext(ctx context.Context, key, holder string) error {
+	log.Printf("[DEBUG] Locking %q for %s", key, holder)
+	if _, err := m.acquire(ctx, key, holder, true); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Locked %q for %s", key, holder)
+	return nil
+}
+
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	m.release(key, nil, true)
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
@@ -51,24 +114,148 @@ func (m *MutexKV) Unlock(key string) {
 // for the same key
 func (m *MutexKV) RLock(key string) {
 	log.Printf("[DEBUG] RLocking %q", key)
-	m.get(key).RLock()
+	m.wait(key, callerName(), false)
 	log.Printf("[DEBUG] RLocked %q", key)
 }
 
+// RLockWithContext acquires a read-lock on the mutex for the given key like
+// LockWithContext. Caller is responsible for calling RUnlock for the same key
+// if no error is returned.
+func (m *MutexKV) RLockWithContext(ctx context.Context, key, holder string) error {
+	log.Printf("[DEBUG] RLocking %q for %s", key, holder)
+	if _, err := m.acquire(ctx, key, holder, false); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] RLocked %q for %s", key, holder)
+	return nil
+}
+
 // Releases a read-lock on the mutex for the given key. Caller must have called RLock for the same key first
 func (m *MutexKV) RUnlock(key string) {
 	log.Printf("[DEBUG] RUnlocking %q", key)
-	m.get(key).RUnlock()
+	m.release(key, nil, false)
 	log.Printf("[DEBUG] RUnlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.RWMutex {
+// wait waits for the mutex for key for as long as it takes, like acquire.
+func (m *MutexKV) wait(key, holder string, exclusive bool) {
+	// Acquiring never fails with a context that is never done.
+	_, _ = m.acquire(context.Background(), key, holder, exclusive)
+}
+
+// acquire waits for the mutex for key, logging all holders and waiters if
+// it waits for longer than MutexWaitLogThreshold.
+func (m *MutexKV) acquire(ctx context.Context, key, holder string, exclusive bool) (*mutexHolder, error) {
+	h := &mutexHolder{name: holder, exclusive: exclusive, since: time.Now()}
+
+	m.lock.Lock()
+	km := m.get(key)
+	km.waiters = append(km.waiters, h)
+	m.lock.Unlock()
+
+	done := make(chan struct{})
+	go m.logLongWait(key, h, done)
+	err := km.sem.Acquire(ctx, h.weight())
+	close(done)
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
+	km.waiters = removeMutexHolder(km.waiters, h)
+	if err != nil {
+		return nil, fmt.Errorf("%s gave up waiting for lock %q after %s: %w", holder, key, time.Since(h.since).Round(time.Second), err)
+	}
+	h.since = time.Now()
+	km.holders = append(km.holders, h)
+	return h, nil
+}
+
+// release unlocks the mutex for key held by h or, if h is nil, by the
+// longest standing holder of the given kind.
+func (m *MutexKV) release(key string, h *mutexHolder, exclusive bool) {
+	m.lock.Lock()
+	km := m.get(key)
+	if h == nil {
+		for _, held := range km.holders {
+			if held.exclusive == exclusive {
+				h = held
+				break
+			}
+		}
+	}
+	if h == nil {
+		m.lock.Unlock()
+		panic(fmt.Sprintf("unlock of unlocked mutex %q", key))
+	}
+	km.holders = removeMutexHolder(km.holders, h)
+	m.lock.Unlock()
+
+	km.sem.Release(h.weight())
+}
+
+func (m *MutexKV) logLongWait(key string, h *mutexHolder, done <-chan struct{}) {
+	ticker := time.NewTicker(MutexWaitLogThreshold)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			log.Printf("[WARN] %s has been waiting %s for lock %q. Current locks:\n%s",
+				h.name, time.Since(h.since).Round(time.Second), key, m.dump())
+		}
+	}
+}
+
+// dump describes the holders and waiters of every mutex in use.
+func (m *MutexKV) dump() string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var lines []string
+	for key, km := range m.store {
+		if len(km.holders) == 0 && len(km.waiters) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %q held by [%s], waited on by [%s]", key, joinMutexHolders(km.holders), joinMutexHolders(km.waiters)))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+func joinMutexHolders(hs []*mutexHolder) string {
+	s := make([]string, len(hs))
+	for i, h := range hs {
+		s[i] = h.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func removeMutexHolder(hs []*mutexHolder, h *mutexHolder) []*mutexHolder {
+	for i, held := range hs {
+		if held == h {
+			return append(hs[:i], hs[i+1:]...)
+		}
+	}
+	return hs
+}
+
+// callerName returns the name of the function that called the caller of
+// the MutexKV method, used as the holder of locks taken without one.
+func callerName() string {
+	pc, _, _, ok := runtime.Caller(2)
+	if !ok {
+		return "unknown"
+	}
+	name := runtime.FuncForPC(pc).Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status. The
+// store must be locked.
+func (m *MutexKV) get(key string) *keyMutex {
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.RWMutex{}
+		mutex = &keyMutex{sem: semaphore.NewWeighted(maxMutexReaders)}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -77,7 +264,7 @@ func (m *MutexKV) get(key string) *sync.RWMutex {
 // Returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.RWMutex),
+		store: make(map[string]*keyMutex),
 	}
 }
 
@@ -90,3 +277,21 @@ func LockedCall(lockKey string, f func() error) error {
 
 	return f()
 }
+
+// LockedCallWithContext calls f while holding the lock for lockKey, giving up
+// waiting for the lock once ctx is done. See MutexKV.LockWithContext.
+func LockedCallWithContext(ctx context.Context, lockKey, holder string, f func() error) error {
+	log.Printf("[DEBUG] Locking %q for %s", lockKey, holder)
+	h, err := MutexStore.acquire(ctx, lockKey, holder, true)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Locked %q for %s", lockKey, holder)
+	defer func() {
+		log.Printf("[DEBUG] Unlocking %q", lockKey)
+		MutexStore.release(lockKey, h, true)
+		log.Printf("[DEBUG] Unlocked %q", lockKey)
+	}()
+
+	return f()
+}
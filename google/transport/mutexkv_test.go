@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/mutexkv_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKV_LockWithContext_timeout(t *testing.T) {
+	m := NewMutexKV()
+	if err := m.LockWithContext(context.Background(), "key", "google_a.first"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := m.LockWithContext(ctx, "key", "google_a.second")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "google_a.second") {
+		t.Errorf("expected error to name the waiter, got %q", err)
+	}
+
+	// The abandoned wait must not leave the key locked once released.
+	m.Unlock("key")
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := m.LockWithContext(ctx, "key", "google_a.third"); err != nil {
+		t.Fatalf("expected lock after unlock, got %s", err)
+	}
+}
+
+func TestMutexKV_readWrite(t *testing.T) {
+	m := NewMutexKV()
+	m.RLock("key")
+	m.RLock("key")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := m.LockWithContext(ctx, "key", "writer"); err == nil {
+		t.Fatalf("expected exclusive lock to wait for readers")
+	}
+
+	m.RUnlock("key")
+	m.RUnlock("key")
+	m.Lock("key")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := m.RLockWithContext(ctx, "key", "reader"); err == nil {
+		t.Fatalf("expected shared lock to wait for writer")
+	}
+	m.Unlock("key")
+}
+
+func TestMutexKV_dump(t *testing.T) {
+	m := NewMutexKV()
+	if err := m.LockWithContext(context.Background(), "key", "google_a.holder"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	waiting := make(chan error)
+	go func() {
+		waiting <- m.LockWithContext(context.Background(), "key", "google_a.waiter")
+	}()
+
+	// The waiter is registered before it starts waiting.
+	var dump string
+	for i := 0; i < 100; i++ {
+		dump = m.dump()
+		if strings.Contains(dump, "google_a.waiter") {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !strings.Contains(dump, "held by [google_a.holder (exclusive") || !strings.Contains(dump, "waited on by [google_a.waiter (exclusive") {
+		t.Fatalf("unexpected dump: %q", dump)
+	}
+
+	m.Unlock("key")
+	if err := <-waiting; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if dump := m.dump(); !strings.Contains(dump, "held by [google_a.waiter") || strings.Contains(dump, "google_a.holder") {
+		t.Fatalf("unexpected dump after handover: %q", dump)
+	}
+}
+
+func TestMutexKV_lockDefaultsHolderToCaller(t *testing.T) {
+	m := NewMutexKV()
+	m.Lock("key")
+	defer m.Unlock("key")
+
+	if dump := m.dump(); !strings.Contains(dump, "TestMutexKV_lockDefaultsHolderToCaller") {
+		t.Fatalf("expected holder to be the calling function, got %q", dump)
+	}
+}
+
+func TestLockedCallWithContext(t *testing.T) {
+	called := false
+	err := LockedCallWithContext(context.Background(), "mutexkv-test", "google_a.b", func() error {
+		called = true
+		if dump := MutexStore.dump(); !strings.Contains(dump, "google_a.b") {
+			t.Errorf("expected lock to be held by google_a.b, got %q", dump)
+		}
+		return nil
+	})
+	if err != nil || !called {
+		t.Fatalf("expected f to be called without error, got %v", err)
+	}
+	if dump := MutexStore.dump(); strings.Contains(dump, "mutexkv-test") {
+		t.Fatalf("expected lock to be released, got %q", dump)
+	}
+}
+
+func TestMutexKV_lockWaitsForUnlock(t *testing.T) {
+	mkv := NewMutexKV()
+	mkv.Lock("foo")
+
+	locked := make(chan struct{})
+	go func() {
+		mkv.Lock("foo")
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected Lock to wait for the mutex to be unlocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mkv.Unlock("foo")
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("expected Lock to succeed once the mutex was unlocked")
+	}
+	mkv.Unlock("foo")
+}
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/operation_limiter.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// operationLimiter caps the number of in-flight long-running operations per
+// project, see Config.MaxConcurrentOperations.
+type operationLimiter struct {
+	max        int
+	mu         sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func newOperationLimiter(max int) *operationLimiter {
+	return &operationLimiter{
+		max:        max,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+func (l *operationLimiter) semaphore(project string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sem, ok := l.semaphores[project]
+	if !ok {
+		sem = make(chan struct{}, l.max)
+		l.semaphores[project] = sem
+	}
+	return sem
+}
+
+func (l *operationLimiter) acquire(ctx context.Context, project string) error {
+	sem := l.semaphore(project)
+	select {
+	case sem <- struct{}{}:
+		return nil
+	default:
+	}
+
+	log.Printf("[DEBUG] %d operations in flight in project %q, waiting for one to finish", l.max, project)
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for an operation slot in project %q: %w", project, ctx.Err())
+	}
+}
+
+func (l *operationLimiter) release(project string) {
+	<-l.semaphore(project)
+}
+
+// operationSlots are the slots held by a single resource function, at most
+// one per project, see Config.WithOperationSlots. A function that starts
+// several operations in a project one after the other reuses its slot, so it
+// never waits on itself.
+type operationSlots struct {
+	limiter *operationLimiter
+	mu      sync.Mutex
+	held    map[string]bool
+}
+
+// hold makes sure a slot is held for project, waiting for one if needed, and
+// reports whether it had to be acquired.
+func (s *operationSlots) hold(ctx context.Context, project string) (bool, error) {
+	s.mu.Lock()
+	held := s.held[project]
+	s.mu.Unlock()
+	if held {
+		return false, nil
+	}
+
+	if err := s.limiter.acquire(ctx, project); err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.held[project] {
+		// Acquired concurrently by another request of the same function.
+		s.limiter.release(project)
+		return false, nil
+	}
+	s.held[project] = true
+	return true, nil
+}
+
+func (s *operationSlots) release(project string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.held[project] {
+		delete(s.held, project)
+		s.limiter.release(project)
+	}
+}
+
+func (s *operationSlots) releaseAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for project := range s.held {
+		s.limiter.release(project)
+	}
+	s.held = make(map[string]bool)
+}
+
+// WithOperationSlots returns a shallow copy of c for a single resource
+// function, through which at most one operation slot per project is held when
+// max_concurrent_operations is set, and a function releasing the slots still
+// held, to call once the resource function returns. c is returned as is if
+// max_concurrent_operations isn't set.
+func (c *Config) WithOperationSlots() (*Config, func()) {
+	if c.operationLimiter == nil {
+		return c, func() {}
+	}
+	copied := *c
+	copied.operationSlots = &operationSlots{
+		limiter: c.operationLimiter,
+		held:    make(map[string]bool),
+	}
+	return &copied, copied.operationSlots.releaseAll
+}
+
+// HoldOperationSlot makes sure a max_concurrent_operations slot is held for
+// project while an operation in it is waited on, waiting for one if needed,
+// e.g. if the operation was started through a client library rather than
+// SendRequest. The returned function releases the slot and must be called
+// once the operation is done.
+func (c *Config) HoldOperationSlot(project string) (func(), error) {
+	s := c.operationSlots
+	if s == nil || project == "" {
+		return func() {}, nil
+	}
+	if _, err := s.hold(c.interruptContext(), project); err != nil {
+		return nil, err
+	}
+	return func() { s.release(project) }, nil
+}
+
+// interruptContext is the context waiting, e.g. for an operation slot, is
+// stopped by, which is done when Terraform is interrupted.
+func (c *Config) interruptContext() context.Context {
+	if c.Context == nil {
+		return context.Background()
+	}
+	return c.Context
+}
+
+// startsOperation reports whether the request made with opt may start a
+// long-running operation in opt.Project, and so needs an operation slot.
+// Requests to operations themselves, such as to cancel them, don't.
+func startsOperation(opt SendRequestOptions) bool {
+	switch opt.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return opt.Project != "" && !isOperationUrl(opt.RawURL)
+}
+
+// sendOperationRequest sends a request that may start a long-running
+// operation once a slot is held for opt.Project. The slot is kept if the
+// response is an operation that is still running, until the operation has
+// been waited on or the resource function returns.
+func (s *operationSlots) sendOperationRequest(ctx context.Context, opt SendRequestOptions) (map[string]interface{}, error) {
+	acquired, err := s.hold(ctx, opt.Project)
+	if err != nil {
+		return nil, err
+	}
+	res, err := sendRequest(opt)
+	if acquired && (err != nil || !isRunningOperation(res)) {
+		s.release(opt.Project)
+	}
+	return res, err
+}
+
+// isRunningOperation reports whether res is a long-running operation that
+// isn't done yet.
+func isRunningOperation(res map[string]interface{}) bool {
+	isOperation, done := operationState(res)
+	return isOperation && !done
+}
+
+// operationState reports whether res is a long-running operation, and if so
+// whether it's done.
+func operationState(res map[string]interface{}) (bool, bool) {
+	name, _ := res["name"].(string)
+	if name == "" {
+		return false, false
+	}
+	kind, _ := res["kind"].(string)
+	_, hasOperationType := res["operationType"]
+	isOperation := strings.HasSuffix(kind, "#operation") ||
+		strings.HasPrefix(name, "operations/") ||
+		strings.Contains(name, "/operations/") ||
+		hasOperationType
+	if !isOperation {
+		return false, false
+	}
+	done, _ := res["done"].(bool)
+	status, _ := res["status"].(string)
+	return true, done || status == "DONE"
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/operation_limiter_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStartsOperation(t *testing.T) {
+	cases := []struct {
+		method, project, url string
+		expected             bool
+	}{
+		{"POST", "p1", "https://compute.googleapis.com/compute/v1/projects/p1/zones/z/instances", true},
+		{"DELETE", "p2", "https://alloydb.googleapis.com/v1/projects/p2/locations/l/clusters/c", true},
+		{"GET", "p1", "https://compute.googleapis.com/compute/v1/projects/p1/zones/z/instances", false},
+		{"POST", "", "https://storage.googleapis.com/storage/v1/b", false},
+		{"POST", "p3", "https://alloydb.googleapis.com/v1/projects/p3/locations/l/operations/op:cancel", false},
+		{"POST", "p4", "https://compute.googleapis.com/compute/v1/projects/p4/zones/z/operations/op/wait", false},
+	}
+
+	for _, tc := range cases {
+		opt := SendRequestOptions{Method: tc.method, Project: tc.project, RawURL: tc.url}
+		if got := startsOperation(opt); got != tc.expected {
+			t.Errorf("%s %s: expected %t, got %t", tc.method, tc.url, tc.expected, got)
+		}
+	}
+}
+
+func TestIsRunningOperation(t *testing.T) {
+	cases := map[string]struct {
+		res      map[string]interface{}
+		expected bool
+	}{
+		"running compute operation": {map[string]interface{}{"kind": "compute#operation", "name": "operation-1", "status": "RUNNING"}, true},
+		"done compute operation":    {map[string]interface{}{"kind": "compute#operation", "name": "operation-1", "status": "DONE"}, false},
+		"running AIP-151 operation": {map[string]interface{}{"name": "projects/p/locations/l/operations/op"}, true},
+		"done AIP-151 operation":    {map[string]interface{}{"name": "projects/p/locations/l/operations/op", "done": true}, false},
+		"resource":                  {map[string]interface{}{"name": "projects/p/locations/l/clusters/c"}, false},
+		"empty response":            {nil, false},
+	}
+	for tn, tc := range cases {
+		if got := isRunningOperation(tc.res); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.expected, got)
+		}
+	}
+}
+
+func TestOperationSlots_holdsSlotForRunningOperation(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/sync/"):
+			fmt.Fprint(w, `{"name": "resource"}`)
+		case strings.HasSuffix(r.URL.Path, ":cancel"):
+			fmt.Fprint(w, `{}`)
+		default:
+			fmt.Fprint(w, `{"kind": "compute#operation", "name": "operation-1", "status": "RUNNING"}`)
+		}
+	}))
+	defer ts.Close()
+
+	base := &Config{
+		Client:           ts.Client(),
+		Context:          context.Background(),
+		operationLimiter: newOperationLimiter(1),
+	}
+	post := func(c *Config, path string) error {
+		_, err := SendRequest(SendRequestOptions{
+			Config:  c,
+			Method:  "POST",
+			Project: strings.Split(strings.TrimPrefix(path, "/v1/projects/"), "/")[0],
+			RawURL:  ts.URL + path,
+			Timeout: time.Second,
+		})
+		return err
+	}
+
+	first, releaseFirst := base.WithOperationSlots()
+	second, releaseSecond := base.WithOperationSlots()
+	defer releaseSecond()
+
+	// Responses that aren't operations don't hold on to the slot.
+	for i := 0; i < 3; i++ {
+		if err := post(first, "/v1/projects/p/sync/r"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := post(second, "/v1/projects/p/sync/r"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := post(first, "/v1/projects/p/instances"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The function holding the slot keeps using it, including to cancel its
+	// operation.
+	if err := post(first, "/v1/projects/p/instances"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := post(first, "/v1/projects/p/operations/operation-1:cancel"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The only slot for project p is held by the running operation.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	second.Context = ctx
+	if err := post(second, "/v1/projects/p/instances"); err == nil {
+		t.Fatalf("expected request to wait for the in-flight operation")
+	}
+	second.Context = context.Background()
+
+	// Other projects have their own slots.
+	if err := post(second, "/v1/projects/other/instances"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	releaseFirst()
+	if err := post(second, "/v1/projects/p/instances"); err != nil {
+		t.Fatalf("expected slot to be released, got: %v", err)
+	}
+}
+
+func TestConfig_HoldOperationSlot(t *testing.T) {
+	base := &Config{operationLimiter: newOperationLimiter(1)}
+	first, releaseFirst := base.WithOperationSlots()
+	defer releaseFirst()
+	second, releaseSecond := base.WithOperationSlots()
+	defer releaseSecond()
+
+	release, err := first.HoldOperationSlot("p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Holding a slot that's already held doesn't wait.
+	if _, err := first.HoldOperationSlot("p"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	second.Context = ctx
+	if _, err := second.HoldOperationSlot("p"); err == nil {
+		t.Fatalf("expected to wait for the held slot")
+	}
+
+	release()
+	release()
+	second.Context = context.Background()
+	if _, err := second.HoldOperationSlot("p"); err != nil {
+		t.Fatalf("expected slot to be released, got: %v", err)
+	}
+
+	// Without max_concurrent_operations, there are no slots to hold.
+	unlimited, releaseUnlimited := (&Config{}).WithOperationSlots()
+	defer releaseUnlimited()
+	if _, err := unlimited.HoldOperationSlot("p"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
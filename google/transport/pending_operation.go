@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/pending_operation.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PendingOperation is a long-running operation that a resource was still
+// waiting on when its apply failed, e.g. because it timed out. It is kept in
+// the resource's private state so that the next refresh or apply can resume
+// waiting on the operation rather than starting it again.
+type PendingOperation struct {
+	// Kind is the name of the tpgresource.OperationKind of the operation.
+	Kind    string `json:"kind,omitempty"`
+	Name    string `json:"name"`
+	Url     string `json:"url"`
+	BaseUrl string `json:"base_url,omitempty"`
+	Project string `json:"project,omitempty"`
+	// ResourceId is the ID of the resource when the operation was waited on.
+	ResourceId string `json:"resource_id,omitempty"`
+}
+
+// OperationRecorder records the operation a resource function is waiting on
+// until it's done, see WithOperationRecorder.
+type OperationRecorder struct {
+	resourceId func() string
+
+	mu      sync.Mutex
+	pending *PendingOperation
+}
+
+// NewOperationRecorder returns a recorder that notes resourceId() alongside
+// operations, as resources set their ID before waiting on their creation.
+func NewOperationRecorder(resourceId func() string) *OperationRecorder {
+	return &OperationRecorder{resourceId: resourceId}
+}
+
+// Pending returns the operation that is being waited on, or that waiting on
+// stopped before it was done, or nil.
+func (r *OperationRecorder) Pending() *PendingOperation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.pending
+}
+
+// Record notes that p is being waited on. It does nothing if r is nil.
+func (r *OperationRecorder) Record(p *PendingOperation) {
+	if r == nil || p == nil {
+		return
+	}
+	recorded := *p
+	recorded.ResourceId = r.resourceId()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pending = &recorded
+}
+
+// Done notes that the operation named name is done, or was cancelled, so
+// there's nothing left to wait on. It does nothing if r is nil.
+func (r *OperationRecorder) Done(name string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pending != nil && r.pending.Name == name {
+		r.pending = nil
+	}
+}
+
+func isOperationUrl(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(u.Path, "/operations/")
+}
+
+// WithOperationRecorder returns a shallow copy of c whose operation waits
+// are recorded to r, see tpgresource.ResumableWaiter.
+func (c *Config) WithOperationRecorder(r *OperationRecorder) *Config {
+	copied := *c
+	copied.operationRecorder = r
+	return &copied
+}
+
+// OperationRecorder returns the recorder operation waits are recorded to, or
+// nil.
+func (c *Config) OperationRecorder() *OperationRecorder {
+	return c.operationRecorder
+}
+
+// ErrCancelNotSupported is returned when an API doesn't support cancelling an
+// operation.
+var ErrCancelNotSupported = errors.New("the API doesn't support cancelling this operation")
+
+// CancelOperation asks the API to cancel an AIP-151 operation, returning
+// ErrCancelNotSupported if the API doesn't implement operations.cancel.
+func CancelOperation(config *Config, p *PendingOperation, userAgent string) error {
+	u, err := url.Parse(p.Url)
+	if err != nil {
+		return err
+	}
+	u.Path += ":cancel"
+	_, err = SendRequest(SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   p.Project,
+		RawURL:    u.String(),
+		UserAgent: userAgent,
+		Body:      map[string]any{},
+		Timeout:   time.Minute,
+	})
+	if IsGoogleApiErrorWithCode(err, http.StatusNotImplemented) {
+		return ErrCancelNotSupported
+	}
+	return err
+}
+
+// LogOperationCancel logs the outcome of cancelling the operation named
+// name because Terraform was interrupted.
+func LogOperationCancel(name string, err error) {
+	switch {
+	case errors.Is(err, ErrCancelNotSupported):
+		log.Printf("[WARN] Operation %s can't be cancelled through the API, so it keeps running even though Terraform stopped waiting on it", name)
+	case err != nil:
+		log.Printf("[WARN] Unable to cancel operation %s, so it may keep running even though Terraform stopped waiting on it: %s", name, err)
+	default:
+		log.Printf("[INFO] Cancelled operation %s as Terraform was interrupted", name)
+	}
+}
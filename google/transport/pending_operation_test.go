@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/pending_operation_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOperationRecorder(t *testing.T) {
+	id := ""
+	r := NewOperationRecorder(func() string { return id })
+
+	id = "projects/p/locations/l/channels/c"
+	r.Record(&PendingOperation{Kind: "aip", Name: "operations/op", Url: "https://example.com/v1/operations/op", Project: "p"})
+	expected := PendingOperation{
+		Kind:       "aip",
+		Name:       "operations/op",
+		Url:        "https://example.com/v1/operations/op",
+		Project:    "p",
+		ResourceId: "projects/p/locations/l/channels/c",
+	}
+	if p := r.Pending(); p == nil || *p != expected {
+		t.Fatalf("expected pending operation %+v, got %+v", expected, p)
+	}
+
+	r.Done("operations/other")
+	if p := r.Pending(); p == nil {
+		t.Fatalf("expected the operation to be pending until it's done")
+	}
+	r.Done("operations/op")
+	if p := r.Pending(); p != nil {
+		t.Errorf("expected no pending operation once done, got %+v", p)
+	}
+
+	// Waits made without a recorder aren't recorded.
+	var unset *OperationRecorder
+	unset.Record(&expected)
+	unset.Done(expected.Name)
+	if (&Config{}).OperationRecorder() != nil {
+		t.Errorf("expected no recorder")
+	}
+}
+
+func TestCancelOperation(t *testing.T) {
+	var cancelled []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		cancelled = append(cancelled, r.URL.Path)
+		if r.URL.Path == "/v1/operations/unsupported:cancel" {
+			w.WriteHeader(http.StatusNotImplemented)
+			fmt.Fprint(w, `{"error": {"code": 501, "message": "Method not implemented"}}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer ts.Close()
+
+	config := &Config{Client: ts.Client()}
+	if err := CancelOperation(config, &PendingOperation{Url: ts.URL + "/v1/operations/op"}, ""); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := CancelOperation(config, &PendingOperation{Url: ts.URL + "/v1/operations/unsupported"}, ""); !errors.Is(err, ErrCancelNotSupported) {
+		t.Errorf("expected ErrCancelNotSupported, got %v", err)
+	}
+	if len(cancelled) != 2 || cancelled[0] != "/v1/operations/op:cancel" {
+		t.Errorf("unexpected cancel requests %v", cancelled)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/product_overrides.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+// ProductOverride holds settings from the provider `product_overrides` block
+// that replace the provider-wide values for a single product. Zero values
+// leave the provider-wide value in place.
+type ProductOverride struct {
+	// RequestTimeout replaces Config.RequestTimeout for requests to the
+	// product's base path.
+	RequestTimeout time.Duration
+	// PollInterval replaces Config.PollInterval for the product's operations.
+	PollInterval time.Duration
+	// CreateTimeout, ReadTimeout, UpdateTimeout and DeleteTimeout replace the
+	// default timeouts of the product's resources, which still apply when
+	// unset and are overridden by a resource's `timeouts` block.
+	CreateTimeout time.Duration
+	ReadTimeout   time.Duration
+	UpdateTimeout time.Duration
+	DeleteTimeout time.Duration
+}
+
+// ExpandProviderProductOverrides converts the provider `product_overrides`
+// blocks into overrides keyed by registered product name, e.g. "dns".
+func ExpandProviderProductOverrides(v interface{}) (map[string]*ProductOverride, error) {
+	overrides := make(map[string]*ProductOverride)
+	if v == nil {
+		return overrides, nil
+	}
+
+	for _, raw := range v.([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		cfgV := raw.(map[string]interface{})
+		product := cfgV["product"].(string)
+		if _, ok := registry.LookupProduct(product); !ok {
+			return nil, fmt.Errorf("unknown product %q in product_overrides, expected a product name such as %q", product, "compute")
+		}
+		if _, ok := overrides[product]; ok {
+			return nil, fmt.Errorf("product %q is overridden more than once in product_overrides", product)
+		}
+
+		o := &ProductOverride{}
+		for key, d := range map[string]*time.Duration{
+			"request_timeout": &o.RequestTimeout,
+			"poll_interval":   &o.PollInterval,
+			"create_timeout":  &o.CreateTimeout,
+			"read_timeout":    &o.ReadTimeout,
+			"update_timeout":  &o.UpdateTimeout,
+			"delete_timeout":  &o.DeleteTimeout,
+		} {
+			durationV, ok := cfgV[key]
+			if !ok || durationV == "" {
+				continue
+			}
+			duration, err := time.ParseDuration(durationV.(string))
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse duration from product_overrides %q value for %q: %q", key, product, durationV)
+			}
+			*d = duration
+		}
+		overrides[product] = o
+	}
+
+	return overrides, nil
+}
+
+// ProductPollInterval returns the interval operations of the named product
+// are polled at.
+func (c *Config) ProductPollInterval(product string) time.Duration {
+	if o, ok := c.ProductOverrides[product]; ok && o.PollInterval > 0 {
+		return o.PollInterval
+	}
+	return c.PollInterval
+}
+
+// productRequestTimeouts returns the request timeouts overridden for
+// products, keyed by product name, and the base path of each.
+func (c *Config) productRequestTimeouts() (map[string]time.Duration, map[string]string) {
+	timeouts := make(map[string]time.Duration)
+	basePaths := make(map[string]string)
+	for name, o := range c.ProductOverrides {
+		p, ok := registry.LookupProduct(name)
+		if !ok || o.RequestTimeout <= 0 {
+			continue
+		}
+		timeouts[name] = o.RequestTimeout
+		basePaths[name] = BaseUrl(p, c)
+	}
+	return timeouts, basePaths
+}
+
+// productTimeoutTransport limits the time a request and the reading of its
+// response may take, using a per-product timeout where one is configured.
+// It replaces http.Client.Timeout, which can't vary between requests.
+type productTimeoutTransport struct {
+	defaultTimeout time.Duration
+	timeouts       []*productTimeout
+	baseTransit    http.RoundTripper
+}
+
+type productTimeout struct {
+	product  string
+	basePath string
+	pattern  *regexp.Regexp
+	timeout  time.Duration
+}
+
+// NewTransportWithProductTimeouts returns a transport that times out requests
+// to each product's base path after the configured duration, and other
+// requests after defaultTimeout. timeouts and basePaths are keyed by product
+// name.
+func NewTransportWithProductTimeouts(baseTransit http.RoundTripper, defaultTimeout time.Duration, timeouts map[string]time.Duration, basePaths map[string]string) http.RoundTripper {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+
+	t := &productTimeoutTransport{defaultTimeout: defaultTimeout, baseTransit: baseTransit}
+	for product, timeout := range timeouts {
+		basePath := basePaths[product]
+		if basePath == "" || timeout <= 0 {
+			continue
+		}
+		t.timeouts = append(t.timeouts, &productTimeout{
+			product:  product,
+			basePath: basePath,
+			pattern:  basePathPattern(basePath),
+			timeout:  timeout,
+		})
+	}
+
+	// Prefer the most specific base path when several products share a host.
+	sort.Slice(t.timeouts, func(i, j int) bool {
+		if len(t.timeouts[i].basePath) != len(t.timeouts[j].basePath) {
+			return len(t.timeouts[i].basePath) > len(t.timeouts[j].basePath)
+		}
+		return t.timeouts[i].product < t.timeouts[j].product
+	})
+	return t
+}
+
+func (t *productTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	timeout := t.timeoutFor(req)
+	if timeout <= 0 {
+		return t.baseTransit.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	resp, err := t.baseTransit.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The timeout covers reading the body, as it does for http.Client.
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *productTimeoutTransport) timeoutFor(req *http.Request) time.Duration {
+	if req.URL == nil {
+		return t.defaultTimeout
+	}
+	u := req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
+	for _, pt := range t.timeouts {
+		if pt.pattern.MatchString(u) {
+			return pt.timeout
+		}
+	}
+	return t.defaultTimeout
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/product_overrides_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+func init() {
+	registry.Product{
+		Name:                 "producttest",
+		BaseUrl:              "https://producttest.googleapis.com/v1/",
+		CustomEndpointField:  "producttest_custom_endpoint",
+		CustomEndpointEnvVar: "GOOGLE_PRODUCTTEST_CUSTOM_ENDPOINT",
+	}.Register()
+}
+
+func TestExpandProviderProductOverrides(t *testing.T) {
+	overrides, err := ExpandProviderProductOverrides([]interface{}{
+		map[string]interface{}{
+			"product":         "producttest",
+			"request_timeout": "30s",
+			"poll_interval":   "2s",
+			"create_timeout":  "1h",
+			"read_timeout":    "",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	o := overrides["producttest"]
+	if o == nil {
+		t.Fatalf("expected an override for producttest, got %v", overrides)
+	}
+	if o.RequestTimeout != 30*time.Second || o.PollInterval != 2*time.Second || o.CreateTimeout != time.Hour || o.ReadTimeout != 0 {
+		t.Errorf("unexpected override: %+v", o)
+	}
+
+	cases := map[string][]interface{}{
+		"unknown product": {
+			map[string]interface{}{"product": "notaproduct"},
+		},
+		"duplicate product": {
+			map[string]interface{}{"product": "producttest"},
+			map[string]interface{}{"product": "producttest"},
+		},
+		"invalid duration": {
+			map[string]interface{}{"product": "producttest", "poll_interval": "soon"},
+		},
+	}
+	for tn, v := range cases {
+		if _, err := ExpandProviderProductOverrides(v); err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+	}
+}
+
+func TestConfig_ProductPollInterval(t *testing.T) {
+	c := &Config{
+		PollInterval: 10 * time.Second,
+		ProductOverrides: map[string]*ProductOverride{
+			"producttest": {PollInterval: 2 * time.Second},
+			"compute":     {RequestTimeout: time.Minute},
+		},
+	}
+	if got := c.ProductPollInterval("producttest"); got != 2*time.Second {
+		t.Errorf("expected producttest to be polled every 2s, got %s", got)
+	}
+	if got := c.ProductPollInterval("compute"); got != 10*time.Second {
+		t.Errorf("expected compute to be polled every 10s, got %s", got)
+	}
+}
+
+func TestProductTimeoutTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/slow/") {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithProductTimeouts(client.Transport, time.Minute, map[string]time.Duration{
+		"producttest": 50 * time.Millisecond,
+	}, map[string]string{
+		"producttest": ts.URL + "/slow/",
+	})
+
+	_, err := client.Get(ts.URL + "/slow/projects/p")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected request to producttest to time out, got %v", err)
+	}
+
+	resp, err := client.Get(ts.URL + "/fast/projects/p")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
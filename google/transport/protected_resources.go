@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/protected_resources.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+)
+
+// AllowProtectedDeletionEnvVar is the environment variable that, set to
+// true, allows deleting resources of the provider's `protected_resource_types`.
+const AllowProtectedDeletionEnvVar = "GOOGLE_ALLOW_PROTECTED_DELETION"
+
+// CheckProtectedDeletion returns an error if resources of resourceType are in
+// the provider's `protected_resource_types`, unless
+// AllowProtectedDeletionEnvVar is set to true.
+func (c *Config) CheckProtectedDeletion(resourceType, id string) error {
+	if c == nil || !slices.Contains(c.ProtectedResourceTypes, resourceType) {
+		return nil
+	}
+	if allow, _ := strconv.ParseBool(os.Getenv(AllowProtectedDeletionEnvVar)); allow {
+		return nil
+	}
+	return fmt.Errorf("refusing to delete %s %q, as %s is in the provider's protected_resource_types. Set %s=true to allow deleting it", resourceType, id, resourceType, AllowProtectedDeletionEnvVar)
+}
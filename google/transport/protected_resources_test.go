@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/protected_resources_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import "testing"
+
+func TestCheckProtectedDeletion(t *testing.T) {
+	config := &Config{ProtectedResourceTypes: []string{"google_sql_database_instance"}}
+
+	t.Setenv(AllowProtectedDeletionEnvVar, "")
+	if err := config.CheckProtectedDeletion("google_sql_database_instance", "db"); err == nil {
+		t.Errorf("expected deleting a protected resource type to fail")
+	}
+	if err := config.CheckProtectedDeletion("google_compute_instance", "vm"); err != nil {
+		t.Errorf("unexpected error deleting an unprotected resource type: %s", err)
+	}
+
+	t.Setenv(AllowProtectedDeletionEnvVar, "true")
+	if err := config.CheckProtectedDeletion("google_sql_database_instance", "db"); err != nil {
+		t.Errorf("expected %s to allow deleting a protected resource type, got %s", AllowProtectedDeletionEnvVar, err)
+	}
+}
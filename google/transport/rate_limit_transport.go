@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/rate_limit_transport.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitTransport throttles requests client-side with a token bucket per
+// API product, so that large applies stay under per-minute quotas instead of
+// relying on retries once the quota is exhausted.
+//
+// It should sit below the retry transport so every attempt is throttled.
+type rateLimitTransport struct {
+	limiters    []*productRateLimiter
+	baseTransit http.RoundTripper
+}
+
+type productRateLimiter struct {
+	product  string
+	basePath string
+	pattern  *regexp.Regexp
+	limiter  *rate.Limiter
+}
+
+// NewTransportWithRateLimits returns a transport that limits requests to each
+// product's base path to the configured number of requests per second.
+// limits and basePaths are keyed by product name, e.g. "compute".
+func NewTransportWithRateLimits(baseTransit http.RoundTripper, limits map[string]float64, basePaths map[string]string) http.RoundTripper {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+
+	t := &rateLimitTransport{baseTransit: baseTransit}
+	for product, rps := range limits {
+		basePath := basePaths[product]
+		if basePath == "" || rps <= 0 {
+			continue
+		}
+		// Allow short bursts of up to one second's worth of requests.
+		burst := int(math.Max(1, math.Ceil(rps)))
+		t.limiters = append(t.limiters, &productRateLimiter{
+			product:  product,
+			basePath: basePath,
+			pattern:  basePathPattern(basePath),
+			limiter:  rate.NewLimiter(rate.Limit(rps), burst),
+		})
+	}
+
+	// Prefer the most specific base path when several products share a host,
+	// e.g. iambeta (iam.googleapis.com/v1/) and iam2 (iam.googleapis.com/v2/).
+	sort.Slice(t.limiters, func(i, j int) bool {
+		if len(t.limiters[i].basePath) != len(t.limiters[j].basePath) {
+			return len(t.limiters[i].basePath) > len(t.limiters[j].basePath)
+		}
+		return t.limiters[i].product < t.limiters[j].product
+	})
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if l := t.limiterFor(req); l != nil {
+		if l.limiter.Tokens() < 1 {
+			log.Printf("[DEBUG] Rate Limit Transport: Throttling request to %s product", l.product)
+		}
+		if err := l.limiter.Wait(req.Context()); err != nil {
+			return nil, fmt.Errorf("waiting for %s rate limit: %w", l.product, err)
+		}
+	}
+	return t.baseTransit.RoundTrip(req)
+}
+
+func (t *rateLimitTransport) limiterFor(req *http.Request) *productRateLimiter {
+	if req.URL == nil {
+		return nil
+	}
+	u := req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
+	for _, l := range t.limiters {
+		if l.pattern.MatchString(u) {
+			return l
+		}
+	}
+	return nil
+}
+
+var basePathTemplateRegex = regexp.MustCompile(`{{[^}]+}}`)
+
+// basePathPattern converts a base path that may contain templating directives
+// such as https://{{location}}-run.googleapis.com/ into a prefix regex.
+func basePathPattern(basePath string) *regexp.Regexp {
+	parts := basePathTemplateRegex.Split(basePath, -1)
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, `[^/]+`))
+}
+
+// productBasePaths returns the base path in use for each named product,
+// taking custom endpoints into account.
+func (c *Config) productBasePaths(products []string) map[string]string {
+	basePaths := make(map[string]string, len(products))
+	for _, name := range products {
+		if p, ok := registry.LookupProduct(name); ok {
+			basePaths[name] = BaseUrl(p, c)
+		}
+	}
+	return basePaths
+}
@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/rate_limit_transport_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransport_limiterFor(t *testing.T) {
+	rt := NewTransportWithRateLimits(http.DefaultTransport, map[string]float64{
+		"iambeta":  1,
+		"iam2":     1,
+		"cloudrun": 1,
+	}, map[string]string{
+		"iambeta":  "https://iam.googleapis.com/v1/",
+		"iam2":     "https://iam.googleapis.com/v2/",
+		"cloudrun": "https://{{location}}-run.googleapis.com/",
+	}).(*rateLimitTransport)
+
+	cases := map[string]string{
+		"https://iam.googleapis.com/v1/projects/p/serviceAccounts":              "iambeta",
+		"https://iam.googleapis.com/v2/policies/foo":                            "iam2",
+		"https://us-central1-run.googleapis.com/apis/serving.knative.dev/v1/ns": "cloudrun",
+		"https://compute.googleapis.com/compute/v1/projects/p":                  "",
+	}
+
+	for u, expected := range cases {
+		req, err := http.NewRequest("GET", u, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		l := rt.limiterFor(req)
+		product := ""
+		if l != nil {
+			product = l.product
+		}
+		if product != expected {
+			t.Errorf("expected %s to be limited by %q, got %q", u, expected, product)
+		}
+	}
+}
+
+func TestRateLimitTransport_throttles(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithRateLimits(client.Transport, map[string]float64{
+		"compute": 10,
+	}, map[string]string{
+		"compute": ts.URL + "/compute/v1/",
+	})
+
+	start := time.Now()
+	// The first 10 requests use the burst, the next 5 wait for new tokens.
+	for i := 0; i < 15; i++ {
+		resp, err := client.Get(ts.URL + "/compute/v1/projects/p")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected requests to be throttled, took %s", elapsed)
+	}
+
+	// Requests to other products are not throttled.
+	start = time.Now()
+	for i := 0; i < 15; i++ {
+		resp, err := client.Get(ts.URL + "/other/v1/projects/p")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed > 400*time.Millisecond {
+		t.Fatalf("expected requests not to be throttled, took %s", elapsed)
+	}
+}
+
+func TestConfig_productBasePaths(t *testing.T) {
+	c := &Config{
+		CustomEndpoints: map[string]string{
+			"producttest_custom_endpoint": "https://producttest.example.com/v1/",
+		},
+	}
+	basePaths := c.productBasePaths([]string{"producttest", "notaproduct"})
+	if len(basePaths) != 1 || basePaths["producttest"] != "https://producttest.example.com/v1/" {
+		t.Errorf("unexpected base paths: %v", basePaths)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/request_coalescer.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultRequestCacheTTL is how long a GET response is reused for. It is kept
+// short so that resources polling for a state change see fresh results after
+// at most one extra poll.
+var DefaultRequestCacheTTL = 5 * time.Second
+
+// requestCoalescer de-duplicates identical GET requests made through
+// SendRequest while planning and refreshing. Concurrent requests share a
+// single call, and successful responses are reused for a short time. Cached
+// responses are invalidated by any mutating request to the same resource,
+// one of its parents or one of its children, see
+// NewTransportWithRequestCacheInvalidation, and all of them are dropped
+// whenever an operation is seen to be done.
+//
+// Requests made by creates, updates and deletes aren't coalesced, see
+// Config.WithoutRequestCoalescing, so that a resource never reads back a
+// state from before its own change.
+type requestCoalescer struct {
+	group singleflight.Group
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*cachedResponse
+	// generation is incremented on every invalidation so that responses to
+	// requests in flight during a mutation aren't cached.
+	generation uint64
+}
+
+type cachedResponse struct {
+	path    string
+	expires time.Time
+	body    map[string]interface{}
+}
+
+func newRequestCoalescer(ttl time.Duration) *requestCoalescer {
+	return &requestCoalescer{
+		ttl:   ttl,
+		cache: make(map[string]*cachedResponse),
+	}
+}
+
+// canCoalesce reports whether the response to a request can be shared with
+// other callers. Requests with custom headers or retry predicates are sent
+// as-is, as the caller may expect different behaviour to other callers.
+func canCoalesce(opt SendRequestOptions) bool {
+	return opt.Method == http.MethodGet &&
+		opt.Body == nil &&
+		len(opt.Headers) == 0 &&
+		len(opt.ErrorRetryPredicates) == 0 &&
+		len(opt.ErrorAbortPredicates) == 0 &&
+		!strings.Contains(opt.RawURL, "/operations/")
+}
+
+// WithoutRequestCoalescing returns a shallow copy of c whose GET requests
+// made through SendRequest are always sent, for use by creates, updates and
+// deletes.
+func (c *Config) WithoutRequestCoalescing() *Config {
+	copied := *c
+	copied.noCoalescing = true
+	return &copied
+}
+
+// coalesceKey identifies requests that return the same response: the URL
+// and the project billed for the request, if any.
+func coalesceKey(opt SendRequestOptions) string {
+	billingProject := ""
+	if opt.Config.UserProjectOverride {
+		billingProject = opt.Project
+	}
+	return billingProject + " " + opt.RawURL
+}
+
+func (c *requestCoalescer) do(key, rawURL string, f func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	c.mu.Lock()
+	if cached, ok := c.cache[key]; ok {
+		if time.Now().Before(cached.expires) {
+			c.mu.Unlock()
+			log.Printf("[DEBUG] Reusing cached response for GET %s", rawURL)
+			return copyJsonMap(cached.body), nil
+		}
+		delete(c.cache, key)
+	}
+	c.mu.Unlock()
+
+	v, err, shared := c.group.Do(key, func() (interface{}, error) {
+		c.mu.Lock()
+		generation := c.generation
+		c.mu.Unlock()
+
+		body, err := f()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if c.generation == generation {
+			c.cache[key] = &cachedResponse{
+				path:    resourcePath(rawURL),
+				expires: time.Now().Add(c.ttl),
+				body:    body,
+			}
+		}
+		c.mu.Unlock()
+		return body, nil
+	})
+	if shared {
+		log.Printf("[DEBUG] Shared response for concurrent GET %s", rawURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Callers may modify the response, so each gets its own copy.
+	return copyJsonMap(v.(map[string]interface{})), nil
+}
+
+// invalidateAll drops all cached responses, as the resources an operation
+// changed can't always be told from the operation.
+func (c *requestCoalescer) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.cache = make(map[string]*cachedResponse)
+}
+
+// invalidate drops cached responses for the resource at rawURL, its parents
+// and its children.
+func (c *requestCoalescer) invalidate(rawURL string) {
+	path := resourcePath(rawURL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key, cached := range c.cache {
+		if isPathPrefix(cached.path, path) || isPathPrefix(path, cached.path) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// resourcePath returns the host and path of the resource a URL refers to,
+// without any custom method such as ":setIamPolicy".
+func resourcePath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	p := strings.TrimSuffix(u.Path, "/")
+	if i := strings.LastIndex(p, "/"); i >= 0 {
+		if j := strings.Index(p[i:], ":"); j >= 0 {
+			p = p[:i+j]
+		}
+	}
+	return u.Host + p
+}
+
+func isPathPrefix(prefix, path string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func copyJsonMap(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return copyJsonValue(m).(map[string]interface{})
+}
+
+func copyJsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			copied[k] = copyJsonValue(val)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, val := range v {
+			copied[i] = copyJsonValue(val)
+		}
+		return copied
+	default:
+		return v
+	}
+}
+
+// requestCacheInvalidationTransport invalidates cached GET responses when a
+// mutating request is made, whether through SendRequest or a client library.
+type requestCacheInvalidationTransport struct {
+	coalescer   *requestCoalescer
+	baseTransit http.RoundTripper
+}
+
+// NewTransportWithRequestCacheInvalidation returns a transport that drops
+// responses cached by the coalescer for any resource a mutating request is
+// made to.
+func NewTransportWithRequestCacheInvalidation(baseTransit http.RoundTripper, c *requestCoalescer) http.RoundTripper {
+	if baseTransit == nil {
+		baseTransit = http.DefaultTransport
+	}
+	return &requestCacheInvalidationTransport{coalescer: c, baseTransit: baseTransit}
+}
+
+func (t *requestCacheInvalidationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.baseTransit.RoundTrip(req)
+	}
+
+	// Invalidate both before and after, so that neither responses cached
+	// before the request nor those fetched while it was in flight are used.
+	t.coalescer.invalidate(req.URL.String())
+	resp, err := t.baseTransit.RoundTrip(req)
+	t.coalescer.invalidate(req.URL.String())
+	return resp, err
+}
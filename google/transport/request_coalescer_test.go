@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/request_coalescer_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func setUpCoalescingTest(t *testing.T, ttl time.Duration) (*httptest.Server, *Config, *int32) {
+	var gets int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/operations/") {
+			fmt.Fprint(w, `{"name": "operations/op", "done": true}`)
+			return
+		}
+		if r.Method == http.MethodGet {
+			n := atomic.AddInt32(&gets, 1)
+			// Give concurrent requests time to pile up.
+			time.Sleep(50 * time.Millisecond)
+			fmt.Fprintf(w, `{"name": "n", "generation": %d, "labels": {"a": "b"}}`, n)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+
+	config := &Config{Client: ts.Client()}
+	config.requestCoalescer = newRequestCoalescer(ttl)
+	config.Client.Transport = NewTransportWithRequestCacheInvalidation(config.Client.Transport, config.requestCoalescer)
+	return ts, config, &gets
+}
+
+func TestSendRequest_coalescesConcurrentGets(t *testing.T) {
+	ts, config, gets := setUpCoalescingTest(t, time.Minute)
+	defer ts.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := SendRequest(SendRequestOptions{Config: config, Method: "GET", RawURL: ts.URL + "/v1/projects/p/networks/n"})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			// Each caller gets its own copy of the response.
+			res["labels"].(map[string]interface{})["a"] = "modified"
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(gets); n != 1 {
+		t.Fatalf("expected concurrent GETs to be coalesced into 1 request, got %d", n)
+	}
+
+	res, err := SendRequest(SendRequestOptions{Config: config, Method: "GET", RawURL: ts.URL + "/v1/projects/p/networks/n"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := atomic.LoadInt32(gets); n != 1 {
+		t.Fatalf("expected cached response to be reused, got %d requests", n)
+	}
+	if got := res["labels"].(map[string]interface{})["a"]; got != "b" {
+		t.Fatalf("expected cached response to be unaffected by callers, got %v", got)
+	}
+}
+
+func TestSendRequest_invalidatesOnMutation(t *testing.T) {
+	ts, config, gets := setUpCoalescingTest(t, time.Minute)
+	defer ts.Close()
+
+	get := func(path string) {
+		if _, err := SendRequest(SendRequestOptions{Config: config, Method: "GET", RawURL: ts.URL + path}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	mutate := func(method, path string) {
+		if _, err := SendRequest(SendRequestOptions{Config: config, Method: method, RawURL: ts.URL + path, Body: map[string]any{}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	get("/v1/projects/p/networks/n")
+	get("/v1/projects/p/networks/other")
+
+	// A custom method on the resource invalidates it, but not its siblings.
+	mutate("POST", "/v1/projects/p/networks/n:addPeering")
+	get("/v1/projects/p/networks/n")
+	get("/v1/projects/p/networks/other")
+	if n := atomic.LoadInt32(gets); n != 3 {
+		t.Fatalf("expected only the mutated resource to be fetched again, got %d requests", n)
+	}
+
+	// Mutating a parent invalidates its children.
+	mutate("DELETE", "/v1/projects/p")
+	get("/v1/projects/p/networks/n")
+	get("/v1/projects/p/networks/other")
+	if n := atomic.LoadInt32(gets); n != 5 {
+		t.Fatalf("expected children of the mutated resource to be fetched again, got %d requests", n)
+	}
+}
+
+func TestSendRequest_cacheExpires(t *testing.T) {
+	ts, config, gets := setUpCoalescingTest(t, 10*time.Millisecond)
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		if _, err := SendRequest(SendRequestOptions{Config: config, Method: "GET", RawURL: ts.URL + "/v1/projects/p"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if n := atomic.LoadInt32(gets); n != 2 {
+		t.Fatalf("expected expired response to be fetched again, got %d requests", n)
+	}
+}
+
+func TestSendRequest_notCoalescedWithoutRequestCoalescing(t *testing.T) {
+	ts, config, gets := setUpCoalescingTest(t, time.Minute)
+	defer ts.Close()
+
+	if _, err := SendRequest(SendRequestOptions{Config: config, Method: "GET", RawURL: ts.URL + "/v1/projects/p"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	uncoalesced := config.WithoutRequestCoalescing()
+	for i := 0; i < 2; i++ {
+		if _, err := SendRequest(SendRequestOptions{Config: uncoalesced, Method: "GET", RawURL: ts.URL + "/v1/projects/p"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(gets); n != 3 {
+		t.Fatalf("expected every GET to be sent, got %d requests", n)
+	}
+}
+
+func TestSendRequest_invalidatesOnDoneOperation(t *testing.T) {
+	ts, config, gets := setUpCoalescingTest(t, time.Minute)
+	defer ts.Close()
+
+	get := func(c *Config, path string) {
+		if _, err := SendRequest(SendRequestOptions{Config: c, Method: "GET", RawURL: ts.URL + path}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	get(config, "/v1/projects/p/instances/i")
+	// The operation is polled by a create, whose own GETs aren't coalesced,
+	// but it still drops the responses cached while planning.
+	get(config.WithoutRequestCoalescing(), "/v1/projects/p/operations/op")
+	get(config, "/v1/projects/p/instances/i")
+	if n := atomic.LoadInt32(gets); n != 2 {
+		t.Fatalf("expected cached response to be dropped once the operation was done, got %d requests", n)
+	}
+}
+
+func TestResourcePath(t *testing.T) {
+	cases := map[string]string{
+		"https://compute.googleapis.com/compute/v1/projects/p/global/networks/n?alt=json": "compute.googleapis.com/compute/v1/projects/p/global/networks/n",
+		"https://example.googleapis.com/v1/projects/p/secrets/s:setIamPolicy":             "example.googleapis.com/v1/projects/p/secrets/s",
+		"https://example.googleapis.com/v1/projects/p/":                                   "example.googleapis.com/v1/projects/p",
+	}
+	for u, expected := range cases {
+		if got := resourcePath(u); got != expected {
+			t.Errorf("expected %s to have resource path %s, got %s", u, expected, got)
+		}
+	}
+}
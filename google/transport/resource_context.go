@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/resource_context.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+)
+
+// resourceContext is the Context of a Config used by a resource function. It
+// is done when the provider's Context is, i.e. when Terraform is
+// interrupted, and also carries the values of the context of the resource
+// function, such as its tflog logger.
+type resourceContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c *resourceContext) Value(key any) any {
+	if v := c.values.Value(key); v != nil {
+		return v
+	}
+	return c.Context.Value(key)
+}
+
+// WithResourceContext returns a shallow copy of c whose Context also carries
+// the values of ctx, the context of a resource function, so that operation
+// waiters can log to it. The copy's Context isn't done when ctx is, as
+// waiters enforce resource timeouts themselves.
+func (c *Config) WithResourceContext(ctx context.Context) *Config {
+	copied := *c
+	if c.Context != nil {
+		copied.Context = &resourceContext{Context: c.Context, values: ctx}
+	}
+	return &copied
+}
+
+// LogContext returns the context of the resource function that ctx, a
+// Config's Context, was made for by WithResourceContext, to log to with
+// tflog, or nil if there is none.
+func LogContext(ctx context.Context) context.Context {
+	if rc, ok := ctx.(*resourceContext); ok {
+		return rc.values
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/resource_context_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"testing"
+)
+
+type testContextKey struct{}
+
+func TestConfigWithResourceContext(t *testing.T) {
+	stop, interrupt := context.WithCancel(context.WithValue(context.Background(), testContextKey{}, "provider"))
+	config := &Config{Context: stop}
+	if LogContext(config.Context) != nil {
+		t.Errorf("expected the provider's context not to have a log context")
+	}
+
+	resourceCtx, cancel := context.WithCancel(context.WithValue(context.Background(), testContextKey{}, "resource"))
+	copied := config.WithResourceContext(resourceCtx)
+	if v := copied.Context.Value(testContextKey{}); v != "resource" {
+		t.Errorf("expected the values of the resource function's context, got %v", v)
+	}
+	if LogContext(copied.Context) != resourceCtx {
+		t.Errorf("expected the resource function's context to be logged to")
+	}
+
+	cancel()
+	if copied.Context.Err() != nil {
+		t.Errorf("expected the context not to be done with the resource function's context")
+	}
+	interrupt()
+	if copied.Context.Err() == nil {
+		t.Errorf("expected the context to be done when Terraform is interrupted")
+	}
+}
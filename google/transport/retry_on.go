@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/retry_on.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+
+	"github.com/hashicorp/terraform-provider-google/google/registry"
+)
+
+// RetryOnRule is an additional retryable error declared in the provider
+// `retry_on` block, so that newly flaky API errors can be retried without
+// waiting for a provider release. An error is retried if it matches every
+// condition that is set.
+type RetryOnRule struct {
+	// Code is the HTTP status code of the error.
+	Code int
+	// Reason is a reason from the error's google.rpc.ErrorInfo details or
+	// from its list of errors.
+	Reason string
+	// Message matches the error message.
+	Message *regexp.Regexp
+	// Product limits the rule to requests to the named product's base path.
+	Product string
+
+	// basePath matches URLs of the product's base path, see
+	// Config.compileRetryOnRules.
+	basePath *regexp.Regexp
+}
+
+// ExpandProviderRetryOn converts the provider `retry_on` blocks into rules.
+func ExpandProviderRetryOn(v interface{}) ([]*RetryOnRule, error) {
+	var rules []*RetryOnRule
+	if v == nil {
+		return rules, nil
+	}
+
+	for i, raw := range v.([]interface{}) {
+		if raw == nil {
+			return nil, fmt.Errorf("retry_on block %d must set at least one of code, reason or message_regex", i)
+		}
+		cfgV := raw.(map[string]interface{})
+		r := &RetryOnRule{}
+		if code, ok := cfgV["code"]; ok {
+			r.Code = code.(int)
+		}
+		if reason, ok := cfgV["reason"]; ok {
+			r.Reason = reason.(string)
+		}
+		if message, ok := cfgV["message_regex"]; ok && message != "" {
+			re, err := regexp.Compile(message.(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid message_regex %q in retry_on block %d: %s", message, i, err)
+			}
+			r.Message = re
+		}
+		if r.Code == 0 && r.Reason == "" && r.Message == nil {
+			return nil, fmt.Errorf("retry_on block %d must set at least one of code, reason or message_regex", i)
+		}
+		if product, ok := cfgV["product"]; ok && product != "" {
+			if _, ok := registry.LookupProduct(product.(string)); !ok {
+				return nil, fmt.Errorf("unknown product %q in retry_on block %d, expected a product name such as %q", product, i, "compute")
+			}
+			r.Product = product.(string)
+		}
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// Predicate returns a retry predicate matching errors described by the rule.
+// It doesn't take Product into account.
+func (r *RetryOnRule) Predicate() RetryErrorPredicateFunc {
+	return func(err error) (bool, string) {
+		gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+		if (r.Code != 0 || r.Reason != "") && (!ok || gerr == nil) {
+			return false, ""
+		}
+		if r.Code != 0 && gerr.Code != r.Code {
+			return false, ""
+		}
+		if r.Reason != "" && !hasErrorReason(gerr, r.Reason) {
+			return false, ""
+		}
+		if r.Message != nil && !r.Message.MatchString(err.Error()) {
+			return false, ""
+		}
+		return true, fmt.Sprintf("matched retry_on rule %s", r)
+	}
+}
+
+func (r *RetryOnRule) String() string {
+	var conditions []string
+	if r.Code != 0 {
+		conditions = append(conditions, fmt.Sprintf("code = %d", r.Code))
+	}
+	if r.Reason != "" {
+		conditions = append(conditions, fmt.Sprintf("reason = %q", r.Reason))
+	}
+	if r.Message != nil {
+		conditions = append(conditions, fmt.Sprintf("message_regex = %q", r.Message))
+	}
+	if r.Product != "" {
+		conditions = append(conditions, fmt.Sprintf("product = %q", r.Product))
+	}
+	return "{" + strings.Join(conditions, ", ") + "}"
+}
+
+// hasErrorReason reports whether reason is the reason of a
+// google.rpc.ErrorInfo detail of the error, or of one of its errors.
+func hasErrorReason(gerr *googleapi.Error, reason string) bool {
+	for _, e := range gerr.Errors {
+		if e.Reason == reason {
+			return true
+		}
+	}
+	for _, d := range gerr.Details {
+		data, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dType, ok := data["@type"].(string)
+		if ok && strings.Contains(dType, "ErrorInfo") && data["reason"] == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// compileRetryOnRules resolves the base path of the product each rule is
+// limited to.
+func (c *Config) compileRetryOnRules() {
+	for _, r := range c.RetryOn {
+		if r.Product == "" {
+			continue
+		}
+		if p, ok := registry.LookupProduct(r.Product); ok {
+			r.basePath = basePathPattern(BaseUrl(p, c))
+		}
+	}
+}
+
+// retryOnPredicates returns the predicates of the rules that apply to a
+// request to rawURL.
+func retryOnPredicates(rules []*RetryOnRule, rawURL string) []RetryErrorPredicateFunc {
+	var predicates []RetryErrorPredicateFunc
+	for _, r := range rules {
+		if r.Product != "" && (r.basePath == nil || !r.basePath.MatchString(rawURL)) {
+			continue
+		}
+		predicates = append(predicates, r.Predicate())
+	}
+	return predicates
+}
+
+// WithRetryOnPredicates returns predicates followed by those of the
+// configured retry_on rules that apply to a request to rawURL. predicates
+// isn't modified.
+func (c *Config) WithRetryOnPredicates(predicates []RetryErrorPredicateFunc, rawURL string) []RetryErrorPredicateFunc {
+	retryOn := retryOnPredicates(c.RetryOn, rawURL)
+	if len(retryOn) == 0 {
+		return predicates
+	}
+	return append(predicates[:len(predicates):len(predicates)], retryOn...)
+}
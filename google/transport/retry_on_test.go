@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/retry_on_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+)
+
+func TestExpandProviderRetryOn(t *testing.T) {
+	rules, err := ExpandProviderRetryOn([]interface{}{
+		map[string]interface{}{
+			"code":          400,
+			"reason":        "resourceNotReady",
+			"message_regex": "",
+			"product":       "producttest",
+		},
+		map[string]interface{}{
+			"code":          0,
+			"reason":        "",
+			"message_regex": "try again",
+			"product":       "",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if got := rules[0].String(); got != `{code = 400, reason = "resourceNotReady", product = "producttest"}` {
+		t.Errorf("unexpected first rule: %s", got)
+	}
+	if got := rules[1].String(); got != `{message_regex = "try again"}` {
+		t.Errorf("unexpected second rule: %s", got)
+	}
+
+	cases := map[string]map[string]interface{}{
+		"no conditions":   {"code": 0, "reason": "", "message_regex": "", "product": "producttest"},
+		"invalid regex":   {"message_regex": "("},
+		"unknown product": {"code": 503, "product": "notaproduct"},
+	}
+	for tn, v := range cases {
+		if _, err := ExpandProviderRetryOn([]interface{}{v}); err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+	}
+}
+
+func TestRetryOnRule_Predicate(t *testing.T) {
+	notReady := &googleapi.Error{
+		Code:    400,
+		Message: "The resource is not ready",
+		Errors:  []googleapi.ErrorItem{{Reason: "resourceNotReady"}},
+	}
+	withErrorInfo := &googleapi.Error{
+		Code: 409,
+		Details: []interface{}{
+			map[string]interface{}{
+				"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+				"reason": "CONCURRENT_UPDATE",
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		Rule     *RetryOnRule
+		Err      error
+		Expected bool
+	}{
+		"code matches": {
+			Rule:     &RetryOnRule{Code: 400},
+			Err:      notReady,
+			Expected: true,
+		},
+		"code doesn't match": {
+			Rule: &RetryOnRule{Code: 409},
+			Err:  notReady,
+		},
+		"reason in errors matches": {
+			Rule:     &RetryOnRule{Code: 400, Reason: "resourceNotReady"},
+			Err:      notReady,
+			Expected: true,
+		},
+		"reason in ErrorInfo matches": {
+			Rule:     &RetryOnRule{Reason: "CONCURRENT_UPDATE"},
+			Err:      withErrorInfo,
+			Expected: true,
+		},
+		"reason doesn't match": {
+			Rule: &RetryOnRule{Reason: "CONCURRENT_UPDATE"},
+			Err:  notReady,
+		},
+		"message matches": {
+			Rule:     &RetryOnRule{Message: regexp.MustCompile("not ready")},
+			Err:      notReady,
+			Expected: true,
+		},
+		"message matches other errors": {
+			Rule:     &RetryOnRule{Message: regexp.MustCompile("not ready")},
+			Err:      errors.New("backend not ready"),
+			Expected: true,
+		},
+		"code doesn't match other errors": {
+			Rule: &RetryOnRule{Code: 400},
+			Err:  errors.New("backend not ready"),
+		},
+		"code matches wrapped errors": {
+			Rule:     &RetryOnRule{Code: 400},
+			Err:      errwrap.Wrapf("Error creating resource: {{err}}", notReady),
+			Expected: true,
+		},
+		"reason matches wrapped errors": {
+			Rule:     &RetryOnRule{Reason: "CONCURRENT_UPDATE"},
+			Err:      fmt.Errorf("Error updating resource: %w", withErrorInfo),
+			Expected: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got, _ := tc.Rule.Predicate()(tc.Err); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestRetryOnPredicates_product(t *testing.T) {
+	c := &Config{
+		RetryOn: []*RetryOnRule{
+			{Code: 400, Product: "producttest"},
+			{Code: 409},
+		},
+	}
+	c.compileRetryOnRules()
+
+	if got := len(retryOnPredicates(c.RetryOn, "https://producttest.googleapis.com/v1/projects/p")); got != 2 {
+		t.Errorf("expected both rules to apply to producttest, got %d", got)
+	}
+	if got := len(retryOnPredicates(c.RetryOn, "https://compute.googleapis.com/compute/v1/projects/p")); got != 1 {
+		t.Errorf("expected one rule to apply to compute, got %d", got)
+	}
+}
+
+func TestRetryTransport_RetryOn(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": 400, "message": "The resource is not ready"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = &retryTransport{
+		internal: http.DefaultTransport,
+		retryOn:  []*RetryOnRule{{Code: 400, Message: regexp.MustCompile("not ready")}},
+	}
+
+	resp, err := client.Get(ts.URL)
+	testRetryTransport_checkSuccess(t, resp, err)
+	if requests != 2 {
+		t.Errorf("expected the request to be retried once, got %d requests", requests)
+	}
+}
+
+func TestRetry_RetryOn(t *testing.T) {
+	attempts := 0
+	err := Retry(RetryOptions{
+		RetryFunc: func() error {
+			attempts++
+			if attempts == 1 {
+				return &googleapi.Error{Code: 400, Message: "The resource is not ready"}
+			}
+			return nil
+		},
+		Timeout: 30 * time.Second,
+		RetryOn: []*RetryOnRule{
+			{Code: 400, Message: regexp.MustCompile("not ready")},
+			// Rules limited to a product don't apply without a request URL.
+			{Code: 409, Product: "producttest"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected the error to be retried, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+	err = Retry(RetryOptions{
+		RetryFunc: func() error {
+			attempts++
+			return &googleapi.Error{Code: 409, Message: "conflict"}
+		},
+		Timeout: 30 * time.Second,
+		RetryOn: []*RetryOnRule{{Code: 409, Product: "producttest"}},
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("expected a single failed attempt, got %d attempts and error %v", attempts, err)
+	}
+}
+
+func TestClientWithAdditionalRetries_RetryOn(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": 400, "message": "The resource is not ready"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	c := &Config{
+		Client:  ts.Client(),
+		RetryOn: []*RetryOnRule{{Code: 400, Message: regexp.MustCompile("not ready")}},
+	}
+	c.compileRetryOnRules()
+
+	resp, err := ClientWithAdditionalRetries(c).Get(ts.URL)
+	testRetryTransport_checkSuccess(t, resp, err)
+	if requests != 2 {
+		t.Errorf("expected the request to be retried once, got %d requests", requests)
+	}
+}
@@ -17,6 +17,7 @@
 package transport
 
 import (
+	"context"
 	"net/url"
 	"testing"
 	"time"
@@ -226,3 +227,55 @@ func TestRetryWithPolling_retriedAndFailed(t *testing.T) {
 		t.Errorf("expected error function to be called exactly twice, but was called %d times", retryCount)
 	}
 }
+
+func TestRetryWithBackoff_retriesUntilDeadline(t *testing.T) {
+	var calls []time.Time
+	start := time.Now()
+	err := Retry(RetryOptions{
+		RetryFunc: func() error {
+			calls = append(calls, time.Now())
+			return &googleapi.Error{Code: 503}
+		},
+		Timeout: 700 * time.Millisecond,
+		RetryConfig: &RetryConfig{
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     time.Minute,
+		},
+	})
+	if err == nil || err.(*googleapi.Error).Code != 503 {
+		t.Errorf("unexpected error %v", err)
+	}
+	// Attempts at 0 and 500ms; the next wait of 1s is shortened so that a
+	// last attempt is made at the 700ms deadline.
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(calls))
+	}
+	if last := calls[2].Sub(start); last < 650*time.Millisecond {
+		t.Errorf("expected the last attempt at the deadline, got it after %s", last)
+	}
+}
+
+func TestRetryWithBackoff_stopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	start := time.Now()
+	err := Retry(RetryOptions{
+		RetryFunc: func() error {
+			calls++
+			cancel()
+			return &googleapi.Error{Code: 503}
+		},
+		Timeout:     time.Minute,
+		RetryConfig: DefaultRetryConfig(),
+		Context:     ctx,
+	})
+	if err == nil || err.(*googleapi.Error).Code != 503 {
+		t.Errorf("unexpected error %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single attempt, got %d", calls)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("expected to stop right away, took %s", elapsed)
+	}
+}
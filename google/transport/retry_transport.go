@@ -37,7 +37,7 @@
 //	c.clientCompute, err = compute.NewService(ctx, option.WithHTTPClient(client))
 //	...
 //	// If API needs custom additional retry predicates:
-//	sqlAdminHttpClient := ClientWithAdditionalRetries(client, retryTransport,
+//	sqlAdminHttpClient := ClientWithAdditionalRetries(config,
 //			isTemporarySqlError1,
 //			isTemporarySqlError2)
 //	c.clientSqlAdmin, err = compute.NewService(ctx, option.WithHTTPClient(sqlAdminHttpClient))
@@ -52,29 +52,67 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/api/googleapi"
 )
 
 const defaultRetryTransportTimeoutSec = 90
 
-// NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors
-func NewTransportWithDefaultRetries(t http.RoundTripper) *retryTransport {
+const DefaultRetryInitialBackoff = 500 * time.Millisecond
+const DefaultRetryMaxBackoff = 30 * time.Second
+const DefaultRetryJitterFactor = 0.2
+
+// MaxServerRetryDelay caps the delay a server can ask for through Retry-After
+// or RetryInfo, so that a bad value can't stall a request indefinitely.
+const MaxServerRetryDelay = 5 * time.Minute
+
+// RetryConfig controls how long we wait between attempts when retrying a
+// request. A MaxAttempts of 0 means the number of attempts is only bounded
+// by the request timeout.
+type RetryConfig struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	JitterFactor   float64
+}
+
+// DefaultRetryConfig returns the retry settings used when the provider
+// `retry` block is not set.
+func DefaultRetryConfig() *RetryConfig {
+	return &RetryConfig{
+		InitialBackoff: DefaultRetryInitialBackoff,
+		MaxBackoff:     DefaultRetryMaxBackoff,
+		JitterFactor:   DefaultRetryJitterFactor,
+	}
+}
+
+// NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors.
+// If cfg is nil, DefaultRetryConfig is used.
+func NewTransportWithDefaultRetries(t http.RoundTripper, cfg *RetryConfig) *retryTransport {
 	return &retryTransport{
 		retryPredicates: defaultErrorRetryPredicates,
+		retryConfig:     cfg,
 		internal:        t,
 	}
 }
 
-// Helper method to create a shallow copy of an HTTP client with a shallow-copied retryTransport
+// Helper method to create a shallow copy of config's HTTP client with a shallow-copied retryTransport
 // s.t. the base HTTP transport is the same (i.e. client connection pools are shared, retryPredicates are different)
-func ClientWithAdditionalRetries(baseClient *http.Client, predicates ...RetryErrorPredicateFunc) *http.Client {
-	copied := *baseClient
-	baseRetryTransport := NewTransportWithDefaultRetries(baseClient.Transport)
+// The provider's retry configuration and retry_on rules apply to the added retries as well.
+func ClientWithAdditionalRetries(config *Config, predicates ...RetryErrorPredicateFunc) *http.Client {
+	copied := *config.Client
+	baseRetryTransport := NewTransportWithDefaultRetries(config.Client.Transport, config.RetryConfig)
+	baseRetryTransport.retryOn = config.RetryOn
 	copied.Transport = baseRetryTransport.WithAddedPredicates(predicates...)
 	return &copied
 }
@@ -83,19 +121,28 @@ func ClientWithAdditionalRetries(baseClient *http.Client, predicates ...RetryErr
 // predicates but same wrapped http.RoundTripper
 func (t *retryTransport) WithAddedPredicates(predicates ...RetryErrorPredicateFunc) *retryTransport {
 	copyT := *t
-	copyT.retryPredicates = append(t.retryPredicates, predicates...)
+	// Clip so that copies never share the appended predicates.
+	copyT.retryPredicates = append(t.retryPredicates[:len(t.retryPredicates):len(t.retryPredicates)], predicates...)
 	return &copyT
 }
 
 type retryTransport struct {
 	retryPredicates []RetryErrorPredicateFunc
-	internal        http.RoundTripper
+	retryConfig     *RetryConfig
+	// retryOn are the provider's retry_on rules, added to retryPredicates
+	// for the requests they apply to.
+	retryOn  []*RetryOnRule
+	internal http.RoundTripper
 }
 
 // RoundTrip implements the RoundTripper interface method.
 // It retries the given HTTP request based on the retry predicates
 // registered under the retryTransport.
 func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, respErr error) {
+	if len(t.retryOn) > 0 {
+		t = t.WithAddedPredicates(retryOnPredicates(t.retryOn, req.URL.String())...)
+	}
+
 	// Set timeout to default value.
 	ctx := req.Context()
 	var ccancel context.CancelFunc
@@ -109,9 +156,12 @@ func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, resp
 		}()
 	}
 
+	cfg := t.retryConfig
+	if cfg == nil {
+		cfg = DefaultRetryConfig()
+	}
 	attempts := 0
-	backoff := time.Millisecond * 500
-	nextBackoff := time.Millisecond * 500
+	backoff := newRetryBackoff(cfg)
 
 	// VCR depends on the original request body being consumed, so
 	// consume here. Since this won't affect the request itself,
@@ -143,7 +193,13 @@ Retry:
 			log.Printf("[DEBUG] Retry Transport: request attempt %d", attempts)
 		}
 		// Do the wrapped Roundtrip. This is one request in the retry loop.
-		resp, respErr = t.internal.RoundTrip(newRequest)
+		attemptCtx, span := StartSpan(req.Context(), "HTTP "+req.Method,
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", req.URL.Scheme+"://"+req.URL.Host+req.URL.Path),
+			attribute.Int("http.request.resend_count", attempts),
+		)
+		resp, respErr = t.internal.RoundTrip(newRequest.WithContext(attemptCtx))
+		endAttemptSpan(span, resp, respErr)
 		attempts++
 
 		retryErr := t.checkForRetryableError(resp, respErr)
@@ -159,21 +215,28 @@ Retry:
 			}
 			break Retry
 		}
+		if cfg.MaxAttempts > 0 && attempts >= cfg.MaxAttempts {
+			log.Printf("[DEBUG] Retry Transport: Stopping retries, reached the maximum of %d attempts: %s", cfg.MaxAttempts, retryErr.Err)
+			break Retry
+		}
+
+		// Server-provided delays take precedence over our own backoff.
+		wait, ok := serverRetryDelay(resp, retryErr.Err)
+		if ok {
+			log.Printf("[DEBUG] Retry Transport: Server requested a retry delay of %s", wait)
+		} else {
+			wait = backoff.Next()
+		}
 
-		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
+		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", wait)
 		select {
 		case <-ctx.Done():
 			if attempts > 1 {
 				log.Printf("[DEBUG] Retry Transport: Stopping retries, context done: %v", ctx.Err())
 			}
 			break Retry
-		case <-time.After(backoff):
-			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", backoff)
-
-			// Fibonnaci backoff - 0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ...
-			lastBackoff := backoff
-			backoff = backoff + nextBackoff
-			nextBackoff = lastBackoff
+		case <-time.After(wait):
+			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", wait)
 			continue
 		}
 	}
@@ -184,6 +247,18 @@ Retry:
 	return resp, respErr
 }
 
+// endAttemptSpan ends the span for a single request attempt, marking it as
+// failed if the request errored or the server returned an error status.
+func endAttemptSpan(span trace.Span, resp *http.Response, respErr error) {
+	if respErr == nil && resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 400 {
+			respErr = fmt.Errorf("HTTP %d", resp.StatusCode)
+		}
+	}
+	EndSpan(span, respErr)
+}
+
 // copyHttpRequest provides an copy of the given HTTP request for one RoundTrip.
 // If the request has a non-empty body (io.ReadCloser), the body is deep copied
 // so it can be consumed.
@@ -240,3 +315,109 @@ func (t *retryTransport) checkForRetryableError(resp *http.Response, respErr err
 	}
 	return retry.NonRetryableError(errToCheck)
 }
+
+// retryBackoff produces a Fibonacci backoff sequence starting at
+// InitialBackoff (0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ... with the default),
+// capped at MaxBackoff and randomized by JitterFactor so that parallel
+// Terraform runs don't retry in lock-step.
+type retryBackoff struct {
+	cfg     *RetryConfig
+	current time.Duration
+	next    time.Duration
+}
+
+func newRetryBackoff(cfg *RetryConfig) *retryBackoff {
+	if cfg == nil {
+		cfg = DefaultRetryConfig()
+	}
+	initial := cfg.InitialBackoff
+	if initial <= 0 {
+		initial = DefaultRetryInitialBackoff
+	}
+	return &retryBackoff{
+		cfg:     cfg,
+		current: initial,
+		next:    initial,
+	}
+}
+
+// Next returns the duration to wait before the next attempt and advances the
+// backoff sequence.
+func (b *retryBackoff) Next() time.Duration {
+	wait := b.current
+	if b.cfg.MaxBackoff > 0 && wait > b.cfg.MaxBackoff {
+		wait = b.cfg.MaxBackoff
+	} else {
+		lastBackoff := b.current
+		b.current = b.current + b.next
+		b.next = lastBackoff
+	}
+
+	if b.cfg.JitterFactor > 0 {
+		// Randomize within [wait * (1 - JitterFactor), wait * (1 + JitterFactor)]
+		delta := b.cfg.JitterFactor * float64(wait)
+		wait = time.Duration(float64(wait) - delta + rand.Float64()*2*delta)
+	}
+	return wait
+}
+
+// serverRetryDelay returns the delay the server asked us to wait before
+// retrying, either through a Retry-After header or a google.rpc.RetryInfo
+// error detail, capped at MaxServerRetryDelay.
+func serverRetryDelay(resp *http.Response, err error) (time.Duration, bool) {
+	delay, ok := uncappedServerRetryDelay(resp, err)
+	if ok && delay > MaxServerRetryDelay {
+		delay = MaxServerRetryDelay
+	}
+	return delay, ok
+}
+
+func uncappedServerRetryDelay(resp *http.Response, err error) (time.Duration, bool) {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay, true
+		}
+	}
+
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return 0, false
+	}
+	if delay, ok := parseRetryAfter(gerr.Header.Get("Retry-After")); ok {
+		return delay, true
+	}
+	for _, d := range gerr.Details {
+		data, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dType, ok := data["@type"].(string)
+		if !ok || !strings.Contains(dType, "RetryInfo") {
+			continue
+		}
+		if v, ok := data["retryDelay"].(string); ok {
+			if delay, err := time.ParseDuration(v); err == nil && delay >= 0 {
+				return delay, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
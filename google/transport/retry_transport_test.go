@@ -185,6 +185,142 @@ func TestRetryTransport_DoesNotRetryEmptyGetBody(t *testing.T) {
 	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
 }
 
+func TestRetryTransport_StopsAfterMaxAttempts(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(testRetryTransportCodeRetry)
+		if _, err := w.Write([]byte(fmt.Sprintf("Code: %d", testRetryTransportCodeRetry))); err != nil {
+			t.Errorf("[ERROR] unable to write to response writer: %v", err)
+		}
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = &retryTransport{
+		internal:        http.DefaultTransport,
+		retryPredicates: []RetryErrorPredicateFunc{testRetryTransportRetryPredicate},
+		retryConfig: &RetryConfig{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(ts.URL)
+	testRetryTransport_checkFailedWhileRetrying(t, resp, err)
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	var firstReqTime, secondReqTime time.Time
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if firstReqTime.IsZero() {
+			firstReqTime = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(testRetryTransportCodeRetry)
+			return
+		}
+		secondReqTime = time.Now()
+		w.WriteHeader(testRetryTransportCodeSuccess)
+	}))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = &retryTransport{
+		internal:        http.DefaultTransport,
+		retryPredicates: []RetryErrorPredicateFunc{testRetryTransportRetryPredicate},
+		retryConfig: &RetryConfig{
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(ts.URL)
+	testRetryTransport_checkSuccess(t, resp, err)
+	if waited := secondReqTime.Sub(firstReqTime); waited < time.Second {
+		t.Fatalf("expected to wait at least 1s as requested by Retry-After, waited %s", waited)
+	}
+}
+
+func TestServerRetryDelay(t *testing.T) {
+	cases := map[string]struct {
+		Resp          *http.Response
+		Err           error
+		ExpectedDelay time.Duration
+		ExpectedOk    bool
+	}{
+		"no response or error": {},
+		"Retry-After header in seconds": {
+			Resp:          &http.Response{Header: http.Header{"Retry-After": []string{"7"}}},
+			ExpectedDelay: 7 * time.Second,
+			ExpectedOk:    true,
+		},
+		"Retry-After header above the cap": {
+			Resp:          &http.Response{Header: http.Header{"Retry-After": []string{"86400"}}},
+			ExpectedDelay: MaxServerRetryDelay,
+			ExpectedOk:    true,
+		},
+		"invalid Retry-After header": {
+			Resp: &http.Response{Header: http.Header{"Retry-After": []string{"soon"}}},
+		},
+		"RetryInfo error detail": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "1.500s",
+					},
+				},
+			},
+			ExpectedDelay: 1500 * time.Millisecond,
+			ExpectedOk:    true,
+		},
+		"other error detail": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+						"reason": "RATE_LIMIT_EXCEEDED",
+					},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			delay, ok := serverRetryDelay(tc.Resp, tc.Err)
+			if ok != tc.ExpectedOk {
+				t.Fatalf("expected ok to be %t, got %t", tc.ExpectedOk, ok)
+			}
+			if delay != tc.ExpectedDelay {
+				t.Fatalf("expected delay %s, got %s", tc.ExpectedDelay, delay)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff_CappedWithJitter(t *testing.T) {
+	b := newRetryBackoff(&RetryConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     4 * time.Second,
+		JitterFactor:   0.5,
+	})
+
+	// Fibonacci sequence 1, 2, 3, 4 (capped), 4 ...
+	for i, base := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second, 4 * time.Second} {
+		wait := b.Next()
+		if wait < base/2 || wait > base+base/2 {
+			t.Fatalf("attempt %d: expected wait within 50%% of %s, got %s", i, base, wait)
+		}
+	}
+}
+
 // handlers
 func testRetryTransportHandler_noRetries(t *testing.T, code int) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -17,6 +17,7 @@
 package transport
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -30,12 +31,25 @@ type RetryOptions struct {
 	PollInterval         time.Duration
 	ErrorRetryPredicates []RetryErrorPredicateFunc
 	ErrorAbortPredicates []RetryErrorPredicateFunc
+	// RetryConfig, if set, replaces the default backoff between attempts.
+	// It is ignored when PollInterval is set.
+	RetryConfig *RetryConfig
+	// Context, if set, stops retries early when it is done. It is only
+	// used together with RetryConfig.
+	Context context.Context
+	// RetryOn are the provider's retry_on rules, whose errors are retried
+	// as well. Rules limited to a product don't apply, as they're matched
+	// against the URL of a request, see Config.WithRetryOnPredicates.
+	RetryOn []*RetryOnRule
 }
 
 func Retry(opt RetryOptions) error {
 	if opt.Timeout == 0 {
 		opt.Timeout = 1 * time.Minute
 	}
+	if retryOn := retryOnPredicates(opt.RetryOn, ""); len(retryOn) > 0 {
+		opt.ErrorRetryPredicates = append(opt.ErrorRetryPredicates[:len(opt.ErrorRetryPredicates):len(opt.ErrorRetryPredicates)], retryOn...)
+	}
 
 	if opt.PollInterval != 0 {
 		refreshFunc := func() (interface{}, string, error) {
@@ -68,6 +82,10 @@ func Retry(opt RetryOptions) error {
 		return err
 	}
 
+	if opt.RetryConfig != nil {
+		return retryWithBackoff(opt)
+	}
+
 	return retry.Retry(opt.Timeout, func() *retry.RetryError {
 		err := opt.RetryFunc()
 		if err == nil {
@@ -80,6 +98,56 @@ func Retry(opt RetryOptions) error {
 	})
 }
 
+// retryWithBackoff retries opt.RetryFunc until it succeeds, returns a
+// non-retryable error, opt.Context is done or opt.Timeout elapses, waiting
+// between attempts according to opt.RetryConfig. The last wait is shortened
+// so that a final attempt is made at the deadline. Like retry.Retry, the last
+// error is returned on timeout.
+func retryWithBackoff(opt RetryOptions) error {
+	ctx := opt.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	backoff := newRetryBackoff(opt.RetryConfig)
+	deadline := time.Now().Add(opt.Timeout)
+	attempts := 0
+	for {
+		err := opt.RetryFunc()
+		attempts++
+		if err == nil {
+			return nil
+		}
+		if !IsRetryableError(err, opt.ErrorRetryPredicates, opt.ErrorAbortPredicates) {
+			return err
+		}
+		if opt.RetryConfig.MaxAttempts > 0 && attempts >= opt.RetryConfig.MaxAttempts {
+			log.Printf("[DEBUG] Stopping retries, reached the maximum of %d attempts", opt.RetryConfig.MaxAttempts)
+			return err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return err
+		}
+		wait, ok := serverRetryDelay(nil, err)
+		if !ok {
+			wait = backoff.Next()
+		}
+		if wait > remaining {
+			wait = remaining
+		}
+		log.Printf("[DEBUG] Waiting %s before retrying", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Printf("[DEBUG] Stopping retries, context done: %v", ctx.Err())
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
 func IsRetryableError(topErr error, retryPredicates, abortPredicates []RetryErrorPredicateFunc) bool {
 	if topErr == nil {
 		return false
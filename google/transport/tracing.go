@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/tracing.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// TracesExporterEnvVar selects the OpenTelemetry exporter, following the
+	// OpenTelemetry convention. Only "otlp" is supported; the endpoint and
+	// headers are read from the standard OTEL_EXPORTER_OTLP_* variables.
+	TracesExporterEnvVar = "OTEL_TRACES_EXPORTER"
+
+	// TracesFileEnvVar is the path of a file that spans are written to as
+	// JSON, one object per span.
+	TracesFileEnvVar = "GOOGLE_TERRAFORM_TRACES_FILE"
+
+	tracerName = "github.com/hashicorp/terraform-provider-google"
+)
+
+// tracerProvider is a no-op until InitTracing is called with tracing enabled.
+var tracerProvider trace.TracerProvider = noop.NewTracerProvider()
+
+// TracingEnabled reports whether OpenTelemetry tracing has been requested
+// through the environment.
+func TracingEnabled() bool {
+	return os.Getenv(TracesFileEnvVar) != "" || strings.EqualFold(os.Getenv(TracesExporterEnvVar), "otlp")
+}
+
+// InitTracing sets up the exporters requested through the environment. The
+// returned function flushes any pending spans and must be called before the
+// provider exits. If tracing is not enabled, it does nothing.
+func InitTracing(ctx context.Context, serviceVersion string) (func(context.Context) error, error) {
+	noShutdown := func(context.Context) error { return nil }
+	if !TracingEnabled() {
+		return noShutdown, nil
+	}
+
+	res := resource.NewSchemaless(
+		attribute.String("service.name", "terraform-provider-google"),
+		attribute.String("service.version", serviceVersion),
+	)
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+
+	var closers []func() error
+	if path := os.Getenv(TracesFileEnvVar); path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return noShutdown, fmt.Errorf("opening %s: %w", TracesFileEnvVar, err)
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return noShutdown, fmt.Errorf("creating trace file exporter: %w", err)
+		}
+		// Write spans as they end so the file is usable even if the provider
+		// is killed before it can shut down.
+		opts = append(opts, sdktrace.WithSyncer(exp))
+		closers = append(closers, f.Close)
+	}
+
+	if strings.EqualFold(os.Getenv(TracesExporterEnvVar), "otlp") {
+		exp, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return noShutdown, fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	tracerProvider = tp
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		for _, c := range closers {
+			err = errors.Join(err, c())
+		}
+		return err
+	}, nil
+}
+
+// StartSpan starts a span as a child of any span in ctx.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return tracerProvider.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err, if any, on span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// WithTraceContext returns a shallow copy of c whose HTTP requests made
+// through SendRequest are traced as children of the span in ctx.
+func (c *Config) WithTraceContext(ctx context.Context) *Config {
+	copied := *c
+	copied.traceContext = ctx
+	return &copied
+}
+
+// TraceContext returns the context set by WithTraceContext, whose span the
+// HTTP requests made through SendRequest are traced as children of, or nil.
+func (c *Config) TraceContext() context.Context {
+	if c == nil {
+		return nil
+	}
+	return c.traceContext
+}
+
+// requestContext returns the context HTTP requests should be made with: the
+// provider's stop context, so that requests and their retries stop when
+// Terraform is interrupted, carrying the span of the trace context. Only the
+// span is carried over so that requests aren't cancelled along with the
+// context of the calling resource function.
+func (c *Config) requestContext() context.Context {
+	if c == nil {
+		return context.Background()
+	}
+	ctx := c.interruptContext()
+	if c.traceContext == nil {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, trace.SpanFromContext(c.traceContext))
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// ----------------------------------------------------------------------------
+//
+//	***     AUTO GENERATED CODE    ***    Type: Handwritten     ***
+//
+// ----------------------------------------------------------------------------
+//
+//	This code is generated by Magic Modules using the following:
+//
+//	Source file: https://github.com/GoogleCloudPlatform/magic-modules/tree/main/mmv1/third_party/terraform/transport/tracing_test.go
+//
+//	DO NOT EDIT this file directly. Any changes made to this file will be
+//	overwritten during the next generation cycle.
+//
+// ----------------------------------------------------------------------------
+package transport
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setUpTestTracing(t *testing.T) *tracetest.InMemoryExporter {
+	exp := tracetest.NewInMemoryExporter()
+	prev := tracerProvider
+	tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	t.Cleanup(func() { tracerProvider = prev })
+	return exp
+}
+
+func TestRetryTransport_SpanPerAttempt(t *testing.T) {
+	exp := setUpTestTracing(t)
+
+	attempts := 0
+	ts, client := setUpRetryTransportServerClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(testRetryTransportCodeRetry)
+			return
+		}
+		w.WriteHeader(testRetryTransportCodeSuccess)
+	}))
+	defer ts.Close()
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	req, err := http.NewRequestWithContext(ctx, "GET", ts.URL+"/compute/v1/projects/p", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	parent.End()
+
+	var spans []sdktrace.ReadOnlySpan
+	for _, s := range exp.GetSpans().Snapshots() {
+		if s.Name() == "HTTP GET" {
+			spans = append(spans, s)
+		}
+	}
+	if len(spans) != 3 {
+		t.Fatalf("expected a span per attempt, got %d", len(spans))
+	}
+	for i, s := range spans {
+		if s.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("expected attempt %d to be a child of the request's span", i)
+		}
+	}
+	if got := spans[0].Status().Code.String(); got != "Error" {
+		t.Errorf("expected first attempt to be marked as failed, got %s", got)
+	}
+	if got := spans[2].Status().Code.String(); got != "Unset" {
+		t.Errorf("expected last attempt to succeed, got %s", got)
+	}
+}
+
+func TestConfig_requestContext(t *testing.T) {
+	setUpTestTracing(t)
+
+	config := &Config{}
+	if span := trace.SpanFromContext(config.requestContext()); span.SpanContext().IsValid() {
+		t.Fatalf("expected no span without a trace context")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, span := StartSpan(ctx, "resource")
+	defer span.End()
+	traced := config.WithTraceContext(ctx)
+	cancel()
+
+	reqCtx := traced.requestContext()
+	if got := trace.SpanFromContext(reqCtx).SpanContext().SpanID(); got != span.SpanContext().SpanID() {
+		t.Errorf("expected request context to carry the resource span")
+	}
+	if reqCtx.Err() != nil {
+		t.Errorf("expected request context not to be cancelled with the resource context")
+	}
+	if config.traceContext != nil {
+		t.Errorf("expected WithTraceContext not to modify the original config")
+	}
+
+	stopCtx, stop := context.WithCancel(context.Background())
+	traced = (&Config{Context: stopCtx}).WithTraceContext(ctx)
+	reqCtx = traced.requestContext()
+	stop()
+	if reqCtx.Err() == nil {
+		t.Errorf("expected request context to be cancelled with the provider's stop context")
+	}
+	if got := trace.SpanFromContext(reqCtx).SpanContext().SpanID(); got != span.SpanContext().SpanID() {
+		t.Errorf("expected request context to carry the resource span with a stop context")
+	}
+}
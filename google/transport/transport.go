@@ -50,6 +50,30 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("client is nil for request to %s", opt.RawURL)
 	}
 
+	// Identical GETs, e.g. for a parent resource read by many resources
+	// during plan, share a single request.
+	c := opt.Config.requestCoalescer
+	if c != nil && !opt.Config.noCoalescing && canCoalesce(opt) {
+		return c.do(coalesceKey(opt), opt.RawURL, func() (map[string]interface{}, error) {
+			return sendRequest(opt)
+		})
+	}
+	var res map[string]interface{}
+	var err error
+	if s := opt.Config.operationSlots; s != nil && startsOperation(opt) {
+		res, err = s.sendOperationRequest(opt.Config.interruptContext(), opt)
+	} else {
+		res, err = sendRequest(opt)
+	}
+	if c != nil && err == nil && opt.Method == http.MethodGet {
+		if isOperation, done := operationState(res); isOperation && done {
+			c.invalidateAll()
+		}
+	}
+	return res, err
+}
+
+func sendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 	reqHeaders := opt.Headers
 	if reqHeaders == nil {
 		reqHeaders = make(http.Header)
@@ -73,8 +97,9 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 		opt.Timeout = DefaultRequestTimeout
 	}
 
+	ctx := opt.Config.requestContext()
 	var res *http.Response
-	err := Retry(RetryOptions{
+	retryOpts := RetryOptions{
 		RetryFunc: func() error {
 			var buf bytes.Buffer
 			if opt.Body != nil {
@@ -88,7 +113,7 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 			if err != nil {
 				return err
 			}
-			req, err := http.NewRequest(opt.Method, u, &buf)
+			req, err := http.NewRequestWithContext(ctx, opt.Method, u, &buf)
 			if err != nil {
 				return err
 			}
@@ -107,9 +132,15 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 			return nil
 		},
 		Timeout:              opt.Timeout,
-		ErrorRetryPredicates: opt.ErrorRetryPredicates,
+		ErrorRetryPredicates: opt.Config.WithRetryOnPredicates(opt.ErrorRetryPredicates, opt.RawURL),
 		ErrorAbortPredicates: opt.ErrorAbortPredicates,
-	})
+		RetryConfig:          opt.Config.RetryConfig,
+		Context:              ctx,
+	}
+	err := Retry(retryOpts)
+	if err != nil && opt.Config.enableDisabledService(opt.Config.interruptContext(), opt, err) {
+		err = Retry(retryOpts)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +201,7 @@ func HandleNotFoundError(err error, d *schema.ResourceData, resource string) err
 	}
 
 	return errwrap.Wrapf(
-		fmt.Sprintf("Error when reading or editing %s: {{err}}", resource), err)
+		fmt.Sprintf("Error when reading or editing %s: {{err}}", resource), TranslateApiError(err))
 }
 
 func HandleDataSourceNotFoundError(err error, d *schema.ResourceData, resource, url string) error {
@@ -179,7 +210,7 @@ func HandleDataSourceNotFoundError(err error, d *schema.ResourceData, resource,
 	}
 
 	return errwrap.Wrapf(
-		fmt.Sprintf("Error when reading or editing %s: {{err}}", resource), err)
+		fmt.Sprintf("Error when reading or editing %s: {{err}}", resource), TranslateApiError(err))
 }
 
 func HandleListGoogleApiError(err error, url string) error {
@@ -207,12 +238,7 @@ func IsApiNotEnabledError(err error) bool {
 	if gerr.Code != 403 {
 		return false
 	}
-	for _, e := range gerr.Errors {
-		if e.Reason == "accessNotConfigured" {
-			return true
-		}
-	}
-	return false
+	return hasErrorReason(gerr, "accessNotConfigured") || hasErrorReason(gerr, "SERVICE_DISABLED")
 }
 
 type ListPagesOptions struct {
@@ -28,6 +28,8 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google/google/fwprovider"
 	"github.com/hashicorp/terraform-provider-google/google/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/version"
 )
 
 func main() {
@@ -36,12 +38,18 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// opt in OpenTelemetry tracing, see transport_tpg.TracingEnabled
+	shutdownTracing, err := transport_tpg.InitTracing(context.Background(), version.ProviderVersion)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
 	// primary is the SDKv2 implementation of the provider
 	primary := provider.Provider()
 
 	providers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider, // sdk provider
-		providerserver.NewProtocol5(fwprovider.New(primary)), // framework provider
+		func() tfprotov5.ProviderServer { return provider.NewGRPCProviderServer(primary) }, // sdk provider
+		providerserver.NewProtocol5(fwprovider.New(primary)),                               // framework provider
 	}
 
 	// use the muxer
@@ -62,6 +70,10 @@ func main() {
 		serveOpts...,
 	)
 
+	if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+		log.Printf("[WARN] Error flushing traces: %s", shutdownErr)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}